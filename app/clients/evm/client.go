@@ -26,16 +26,24 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
-	"github.com/limechain/hedera-eth-bridge-validator/app/model/retry"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"math/big"
 	"time"
 )
 
-// Used as a maximum amount of retries that need to be done when executing
-// RetryBlockNumber, RetryFilterLogs
-const executionRetries = 10
+const (
+	// Used as a maximum amount of retries that need to be done when executing
+	// RetryBlockNumber, RetryFilterLogs
+	executionRetries = 10
+	// Used as a pause between the retries of RetryBlockNumber, RetryFilterLogs
+	retryInterval = time.Second
+
+	defaultQuorum              = 1
+	defaultRequestTimeout      = 5 * time.Second
+	defaultHealthCheckInterval = 15 * time.Second
+	defaultMaxBlockLag         = uint64(10)
+)
 
 // Client EVM JSON RPC Client
 type Client struct {
@@ -44,26 +52,76 @@ type Client struct {
 	logger *log.Entry
 }
 
-// NewClient creates new instance of an EVM client
-func NewClient(c config.Evm) *Client {
-	logger := config.GetLoggerFor(fmt.Sprintf("EVM Client"))
+// NewClient creates new instance of an EVM client, connected to all the configured JSON RPC endpoints of the network
+func NewClient(chainId uint64, c config.Evm, prometheusService service.Prometheus) *Client {
+	logger := config.GetLoggerFor(fmt.Sprintf("EVM Client [%d]", chainId))
 	if c.BlockConfirmations < 1 {
 		logger.Fatalf("BlockConfirmations should be a positive number")
 	}
 
-	var client client.Core
-	client, err := ethclient.Dial(c.NodeUrl)
-	if err != nil {
-		logger.Fatalf("Failed to initialize Client. Error [%s]", err)
+	nodeUrls := endpoints(c)
+	if len(nodeUrls) == 0 {
+		logger.Fatalf("At least one node URL should be configured")
+	}
+
+	quorum := c.RpcQuorum
+	if quorum == 0 {
+		quorum = defaultQuorum
+	}
+	if quorum < 0 || quorum > len(nodeUrls) {
+		logger.Fatalf("Quorum [%d] should be between 1 and the number of node URLs [%d]", quorum, len(nodeUrls))
+	}
+
+	requestTimeout := c.RpcRequestTimeout * time.Second
+	if requestTimeout == 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	healthCheckInterval := c.RpcHealthCheck * time.Second
+	if healthCheckInterval == 0 {
+		healthCheckInterval = defaultHealthCheckInterval
+	}
+
+	maxBlockLag := c.RpcMaxBlockLag
+	if maxBlockLag == 0 {
+		maxBlockLag = defaultMaxBlockLag
+	}
+
+	providers := make([]*provider, len(nodeUrls))
+	for i, nodeUrl := range nodeUrls {
+		client, err := ethclient.Dial(nodeUrl)
+		if err != nil {
+			logger.Fatalf("Failed to initialize Client for endpoint [%s]. Error [%s]", endpointName(nodeUrl), err)
+		}
+		providers[i] = newProvider(chainId, i, nodeUrl, client, prometheusService)
+	}
+
+	failover := newFailover(providers, quorum, requestTimeout, maxBlockLag, logger)
+	if len(providers) > 1 {
+		go failover.monitor(healthCheckInterval)
 	}
 
 	return &Client{
 		c,
-		client,
+		failover,
 		logger,
 	}
 }
 
+// endpoints returns the configured node URLs, starting with node_url
+func endpoints(c config.Evm) []string {
+	var nodeUrls []string
+	seen := make(map[string]bool)
+	for _, nodeUrl := range append([]string{c.NodeUrl}, c.NodeUrls...) {
+		if nodeUrl == "" || seen[nodeUrl] {
+			continue
+		}
+		seen[nodeUrl] = true
+		nodeUrls = append(nodeUrls, nodeUrl)
+	}
+	return nodeUrls
+}
+
 func (ec *Client) ChainID(ctx context.Context) (*big.Int, error) {
 	return ec.Core.ChainID(ctx)
 }
@@ -161,65 +219,53 @@ func (ec Client) BlockConfirmations() uint64 {
 }
 
 // RetryBlockNumber returns the most recent block number
-// Uses a retry mechanism in case the filter query is stuck
-func (ec Client) RetryBlockNumber() (uint64, error) {
-	blockNumberFunc := func() <-chan retry.Result {
-		r := make(chan retry.Result)
-		go func() {
-			defer close(r)
-
-			block, err := ec.BlockNumber(context.Background())
-			r <- retry.Result{
-				Value: block,
-				Error: err,
-			}
-		}()
-
-		return r
-	}
-
-	result, err := service.Retry(blockNumberFunc, executionRetries)
+// Retries in case all the endpoints fail or time out
+func (ec Client) RetryBlockNumber(ctx context.Context) (uint64, error) {
+	var block uint64
+	err := ec.retry(ctx, func() (err error) {
+		block, err = ec.BlockNumber(ctx)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 
-	block, ok := result.(uint64)
-	if !ok {
-		return 0, errors.New(fmt.Sprintf("failed to cast block [%v]", result))
-	}
-
 	return block, nil
 }
 
 // RetryFilterLogs returns the logs from the input query
-// Uses a retry mechanism in case the filter query is stuck
-func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
-	filterLogsFunc := func() <-chan retry.Result {
-		r := make(chan retry.Result)
-		go func() {
-			defer close(r)
-
-			logs, err := ec.FilterLogs(context.Background(), query)
-			r <- retry.Result{
-				Value: logs,
-				Error: err,
-			}
-		}()
-
-		return r
-	}
-
-	result, err := service.Retry(filterLogsFunc, executionRetries)
+// Retries in case all the endpoints fail or time out
+func (ec Client) RetryFilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	err := ec.retry(ctx, func() (err error) {
+		logs, err = ec.FilterLogs(ctx, query)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	logs, ok := result.([]types.Log)
-	if !ok {
-		return nil, errors.New(fmt.Sprintf("failed to cast logs [%v]", result))
+	return logs, nil
+}
+
+// retry executes the given function up to executionRetries times, while it fails with errors caused by the endpoints
+func (ec Client) retry(ctx context.Context, execute func() error) error {
+	var err error
+	for i := 1; i <= executionRetries; i++ {
+		err = execute()
+		if !isEndpointFailure(ctx, err) {
+			return err
+		}
+
+		ec.logger.Warnf("Function execution failed. [%d/%d] tries. Error: [%s]", i, executionRetries, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
 	}
 
-	return logs, nil
+	return errors.New(fmt.Sprintf("too many retries, last error: [%s]", err))
 }
 
 func (ec *Client) WaitForConfirmations(raw types.Log) error {
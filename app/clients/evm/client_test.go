@@ -252,3 +252,24 @@ func Test_WaitForConfirmations_BlockNumberFails(t *testing.T) {
 	assert.NotNil(t, err)
 	mocks.MEVMCoreClient.AssertNotCalled(t, "TransactionReceipt", context.Background(), mock.Anything)
 }
+
+func Test_RetryFilterLogs(t *testing.T) {
+	setup()
+	mocks.MEVMCoreClient.On("FilterLogs", context.Background(), ethereum.FilterQuery{}).Return([]types.Log{{Index: 1}}, nil)
+
+	logs, err := c.RetryFilterLogs(context.Background(), ethereum.FilterQuery{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{{Index: 1}}, logs)
+}
+
+func Test_RetryFilterLogs_JsonRpcErrorNotRetried(t *testing.T) {
+	setup()
+	mocks.MEVMCoreClient.On("FilterLogs", context.Background(), ethereum.FilterQuery{}).Return(nil, jsonRpcError{})
+
+	logs, err := c.RetryFilterLogs(context.Background(), ethereum.FilterQuery{})
+
+	assert.Nil(t, logs)
+	assert.Equal(t, jsonRpcError{}, err)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "FilterLogs", 1)
+}
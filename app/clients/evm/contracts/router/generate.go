@@ -0,0 +1,21 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package router contains the Go binding of the bridge router contract. The binding is generated by abigen v1.10.8
+// from diamond-router.abi, so change the ABI and regenerate the binding instead of editing it.
+package router
+
+//go:generate abigen --abi diamond-router.abi --pkg router --type Router --out diamond-router.go
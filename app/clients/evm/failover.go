@@ -0,0 +1,291 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// failover is a client.Core, which spreads the calls over several JSON RPC endpoints of the same EVM network.
+// Calls are sent to the best scored endpoint and fail over to the next one if the endpoint is unreachable,
+// times out or responds with a server error. Block numbers are requested from all endpoints and compared.
+type failover struct {
+	providers      []*provider
+	quorum         int
+	requestTimeout time.Duration
+	maxBlockLag    uint64
+	logger         *log.Entry
+}
+
+func newFailover(providers []*provider, quorum int, requestTimeout time.Duration, maxBlockLag uint64, logger *log.Entry) *failover {
+	return &failover{
+		providers:      providers,
+		quorum:         quorum,
+		requestTimeout: requestTimeout,
+		maxBlockLag:    maxBlockLag,
+		logger:         logger,
+	}
+}
+
+// monitor requests the block number from all endpoints on the given interval, so that the score of
+// endpoints, which are not being called, is kept up to date and failed endpoints are given the chance to recover
+func (f *failover) monitor(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		_, err := f.BlockNumber(context.Background())
+		if err != nil {
+			f.logger.Errorf("Health check failed. Error: [%s]", err)
+		}
+	}
+}
+
+// ordered returns the endpoints from the best to the worst scored one
+func (f *failover) ordered() []*provider {
+	providers := make([]*provider, len(f.providers))
+	copy(providers, f.providers)
+
+	sort.SliceStable(providers, func(i, j int) bool {
+		return providers[i].score() < providers[j].score()
+	})
+	return providers
+}
+
+// execute executes the given call on the endpoints, ordered by their score, until it succeeds
+// or fails with an error, which is not caused by the endpoint itself
+func (f *failover) execute(ctx context.Context, method string, call func(ctx context.Context, c client.Core) error) error {
+	var err error
+	for _, p := range f.ordered() {
+		err = f.attempt(ctx, p, call)
+		if !isEndpointFailure(ctx, err) {
+			return err
+		}
+		f.logger.Warnf("[%s] failed on endpoint [%s]. Error: [%s]", method, p.name, err)
+	}
+
+	return err
+}
+
+// attempt executes the given call on the given endpoint and updates the score of the endpoint
+func (f *failover) attempt(ctx context.Context, p *provider, call func(ctx context.Context, c client.Core) error) error {
+	requestCtx, cancel := context.WithTimeout(ctx, f.requestTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := call(requestCtx, p.client)
+	if isEndpointFailure(ctx, err) {
+		p.recordFailure(err, f.logger)
+	} else if ctx.Err() == nil {
+		p.recordSuccess(time.Since(start), f.logger)
+	}
+
+	return err
+}
+
+// isEndpointFailure returns whether the given error is caused by the endpoint, and the call may succeed on another one.
+// Errors returned by the node through JSON RPC are considered a valid response.
+func isEndpointFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// BlockNumber requests the most recent block number from all endpoints. Endpoints lagging more than
+// maxBlockLag blocks behind the most recent block are not called until they catch up.
+// Returns the most recent block, reached by at least quorum endpoints.
+func (f *failover) BlockNumber(ctx context.Context) (uint64, error) {
+	type result struct {
+		provider *provider
+		block    uint64
+		err      error
+	}
+
+	results := make(chan result, len(f.providers))
+	for _, p := range f.providers {
+		go func(p *provider) {
+			var block uint64
+			err := f.attempt(ctx, p, func(ctx context.Context, c client.Core) (err error) {
+				block, err = c.BlockNumber(ctx)
+				return err
+			})
+			results <- result{provider: p, block: block, err: err}
+		}(p)
+	}
+
+	var responded []result
+	for range f.providers {
+		r := <-results
+		if r.err == nil {
+			responded = append(responded, r)
+		}
+	}
+
+	if len(responded) < f.quorum {
+		return 0, errors.New(fmt.Sprintf("block number quorum not reached, [%d] of the required [%d] endpoints responded", len(responded), f.quorum))
+	}
+
+	sort.Slice(responded, func(i, j int) bool {
+		return responded[i].block > responded[j].block
+	})
+
+	latest := responded[0].block
+	for _, r := range responded {
+		r.provider.recordBlockNumber(r.block, latest-r.block > f.maxBlockLag, f.logger)
+	}
+
+	return responded[f.quorum-1].block, nil
+}
+
+func (f *failover) ChainID(ctx context.Context) (chain *big.Int, err error) {
+	err = f.execute(ctx, "ChainID", func(ctx context.Context, c client.Core) error {
+		chain, err = c.ChainID(ctx)
+		return err
+	})
+	return chain, err
+}
+
+func (f *failover) BlockByNumber(ctx context.Context, number *big.Int) (block *types.Block, err error) {
+	err = f.execute(ctx, "BlockByNumber", func(ctx context.Context, c client.Core) error {
+		block, err = c.BlockByNumber(ctx, number)
+		return err
+	})
+	return block, err
+}
+
+func (f *failover) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = f.execute(ctx, "HeaderByNumber", func(ctx context.Context, c client.Core) error {
+		header, err = c.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (f *failover) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = f.execute(ctx, "TransactionByHash", func(ctx context.Context, c client.Core) error {
+		tx, isPending, err = c.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, isPending, err
+}
+
+func (f *failover) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = f.execute(ctx, "TransactionReceipt", func(ctx context.Context, c client.Core) error {
+		receipt, err = c.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (f *failover) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = f.execute(ctx, "CodeAt", func(ctx context.Context, c client.Core) error {
+		code, err = c.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (f *failover) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = f.execute(ctx, "CallContract", func(ctx context.Context, c client.Core) error {
+		result, err = c.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (f *failover) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = f.execute(ctx, "PendingCodeAt", func(ctx context.Context, c client.Core) error {
+		code, err = c.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (f *failover) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = f.execute(ctx, "PendingNonceAt", func(ctx context.Context, c client.Core) error {
+		nonce, err = c.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (f *failover) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = f.execute(ctx, "SuggestGasPrice", func(ctx context.Context, c client.Core) error {
+		price, err = c.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (f *failover) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = f.execute(ctx, "SuggestGasTipCap", func(ctx context.Context, c client.Core) error {
+		tip, err = c.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+func (f *failover) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = f.execute(ctx, "EstimateGas", func(ctx context.Context, c client.Core) error {
+		gas, err = c.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction submits the signed transaction. Submitting it to another endpoint after a failure is safe,
+// as nodes reject transactions, which are already known
+func (f *failover) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return f.execute(ctx, "SendTransaction", func(ctx context.Context, c client.Core) error {
+		return c.SendTransaction(ctx, tx)
+	})
+}
+
+func (f *failover) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = f.execute(ctx, "FilterLogs", func(ctx context.Context, c client.Core) error {
+		logs, err = c.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes through the best scored endpoint. The subscription is not moved to
+// another endpoint if the endpoint fails afterwards.
+func (f *failover) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	for _, p := range f.ordered() {
+		sub, err = p.client.SubscribeFilterLogs(ctx, query, ch)
+		if !isEndpointFailure(ctx, err) {
+			return sub, err
+		}
+		p.recordFailure(err, f.logger)
+		f.logger.Warnf("[SubscribeFilterLogs] failed on endpoint [%s]. Error: [%s]", p.name, err)
+	}
+
+	return nil, err
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	evm_client "github.com/limechain/hedera-eth-bridge-validator/test/mocks/evm-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+	"time"
+)
+
+type jsonRpcError struct{}
+
+func (e jsonRpcError) Error() string  { return "execution reverted" }
+func (e jsonRpcError) ErrorCode() int { return 3 }
+
+func setupFailover(quorum int, cores ...*evm_client.MockEVMCoreClient) *failover {
+	providers := make([]*provider, len(cores))
+	for i, core := range cores {
+		providers[i] = newProvider(1, i, "https://node.example.com/v3/api-key", core, nil)
+	}
+	return newFailover(providers, quorum, time.Second, 10, config.GetLoggerFor("EVM Client"))
+}
+
+func Test_Failover_FailsOver(t *testing.T) {
+	primary, secondary := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary, secondary)
+	primary.On("ChainID", mock.Anything).Return(nil, errors.New("connection refused"))
+	secondary.On("ChainID", mock.Anything).Return(big.NewInt(1), nil)
+
+	chain, err := f.ChainID(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), chain)
+	assert.False(t, f.providers[0].healthy)
+	assert.Equal(t, f.providers[1], f.ordered()[0])
+}
+
+func Test_Failover_AllFail(t *testing.T) {
+	primary, secondary := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary, secondary)
+	expectedErr := errors.New("connection refused")
+	primary.On("ChainID", mock.Anything).Return(nil, expectedErr)
+	secondary.On("ChainID", mock.Anything).Return(nil, expectedErr)
+
+	chain, err := f.ChainID(context.Background())
+
+	assert.Nil(t, chain)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Failover_JsonRpcErrorNotFailedOver(t *testing.T) {
+	primary, secondary := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary, secondary)
+	primary.On("CallContract", mock.Anything, ethereum.CallMsg{}, (*big.Int)(nil)).Return(nil, jsonRpcError{})
+
+	result, err := f.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+
+	assert.Nil(t, result)
+	assert.Equal(t, jsonRpcError{}, err)
+	assert.True(t, f.providers[0].healthy)
+	secondary.AssertNotCalled(t, "CallContract", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Failover_NotFoundNotFailedOver(t *testing.T) {
+	primary, secondary := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary, secondary)
+	primary.On("FilterLogs", mock.Anything, ethereum.FilterQuery{}).Return(nil, ethereum.NotFound)
+
+	logs, err := f.FilterLogs(context.Background(), ethereum.FilterQuery{})
+
+	assert.Nil(t, logs)
+	assert.Equal(t, ethereum.NotFound, err)
+	assert.True(t, f.providers[0].healthy)
+	secondary.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+}
+
+func Test_Failover_FilterLogs(t *testing.T) {
+	primary := &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary)
+	primary.On("FilterLogs", mock.Anything, ethereum.FilterQuery{}).Return([]types.Log{{Index: 1}}, nil)
+
+	logs, err := f.FilterLogs(context.Background(), ethereum.FilterQuery{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Log{{Index: 1}}, logs)
+	assert.NotZero(t, f.providers[0].latency)
+}
+
+func Test_Failover_BlockNumberQuorum(t *testing.T) {
+	first, second, lagging := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(2, first, second, lagging)
+	first.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+	second.On("BlockNumber", mock.Anything).Return(uint64(98), nil)
+	lagging.On("BlockNumber", mock.Anything).Return(uint64(80), nil)
+
+	block, err := f.BlockNumber(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(98), block)
+	assert.False(t, f.providers[0].lagging)
+	assert.False(t, f.providers[1].lagging)
+	assert.True(t, f.providers[2].lagging)
+	assert.Equal(t, f.providers[2], f.ordered()[2])
+}
+
+func Test_Failover_BlockNumberQuorumNotReached(t *testing.T) {
+	first, second := &evm_client.MockEVMCoreClient{}, &evm_client.MockEVMCoreClient{}
+	f := setupFailover(2, first, second)
+	first.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+	second.On("BlockNumber", mock.Anything).Return(nil, errors.New("connection refused"))
+
+	block, err := f.BlockNumber(context.Background())
+
+	assert.NotNil(t, err)
+	assert.Zero(t, block)
+	assert.False(t, f.providers[1].healthy)
+}
+
+func Test_Failover_Recovers(t *testing.T) {
+	primary := &evm_client.MockEVMCoreClient{}
+	f := setupFailover(1, primary)
+	f.providers[0].recordFailure(errors.New("connection refused"), f.logger)
+	primary.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+
+	_, err := f.BlockNumber(context.Background())
+
+	assert.Nil(t, err)
+	assert.True(t, f.providers[0].healthy)
+}
+
+func Test_Endpoints(t *testing.T) {
+	nodeUrls := endpoints(config.Evm{
+		NodeUrl:  "https://first.example.com",
+		NodeUrls: []string{"https://second.example.com", "https://first.example.com"},
+	})
+
+	assert.Equal(t, []string{"https://first.example.com", "https://second.example.com"}, nodeUrls)
+}
+
+func Test_EndpointName(t *testing.T) {
+	assert.Equal(t, "mainnet.infura.io", endpointName("wss://mainnet.infura.io/ws/v3/api-key"))
+	assert.Equal(t, "unknown", endpointName("not a url"))
+}
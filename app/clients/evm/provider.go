@@ -0,0 +1,176 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm
+
+import (
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// Weight of the newest observation in the moving averages of the latency and error rate
+	scoreSmoothing = 0.3
+	// Added to the score of endpoints, which have failed or lag behind, so that they are called last
+	unavailablePenalty = time.Hour
+)
+
+// provider is a single JSON RPC endpoint of an EVM network
+type provider struct {
+	name   string
+	client client.Core
+
+	mu          sync.RWMutex
+	healthy     bool
+	lagging     bool
+	latency     time.Duration
+	errorRate   float64
+	blockNumber uint64
+
+	healthyGauge     prometheus.Gauge
+	latencyGauge     prometheus.Gauge
+	blockNumberGauge prometheus.Gauge
+	errorsCounter    prometheus.Counter
+}
+
+func newProvider(chainId uint64, index int, nodeUrl string, client client.Core, prometheusService service.Prometheus) *provider {
+	p := &provider{
+		name:    endpointName(nodeUrl),
+		client:  client,
+		healthy: true,
+	}
+
+	if prometheusService != nil && prometheusService.GetIsMonitoringEnabled() {
+		name := fmt.Sprintf("%s%d_%d", constants.EvmRpcMetricsNamePrefix, chainId, index)
+		labels := prometheus.Labels{constants.EvmRpcMetricLabelKey: p.name}
+		p.healthyGauge = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name:        name + constants.EvmRpcHealthyNameSuffix,
+			Help:        constants.EvmRpcHealthyHelp,
+			ConstLabels: labels,
+		})
+		p.latencyGauge = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name:        name + constants.EvmRpcLatencyNameSuffix,
+			Help:        constants.EvmRpcLatencyHelp,
+			ConstLabels: labels,
+		})
+		p.blockNumberGauge = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name:        name + constants.EvmRpcBlockNumberNameSuffix,
+			Help:        constants.EvmRpcBlockNumberHelp,
+			ConstLabels: labels,
+		})
+		p.errorsCounter = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name:        name + constants.EvmRpcErrorsNameSuffix,
+			Help:        constants.EvmRpcErrorsHelp,
+			ConstLabels: labels,
+		})
+		p.healthyGauge.Set(1)
+	}
+
+	return p
+}
+
+// endpointName returns the host of the given endpoint, leaving out the path and query,
+// which commonly hold the API key of the endpoint
+func endpointName(nodeUrl string) string {
+	parsed, err := url.Parse(nodeUrl)
+	if err != nil || parsed.Host == "" {
+		return "unknown"
+	}
+	return parsed.Host
+}
+
+// score returns the score of the endpoint, based on its latency and error rate. Lower is better.
+func (p *provider) score() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	score := time.Duration(float64(p.latency) * (1 + 10*p.errorRate))
+	if !p.healthy || p.lagging {
+		score += unavailablePenalty
+	}
+	return score
+}
+
+func (p *provider) recordSuccess(latency time.Duration, logger *log.Entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.latency == 0 {
+		p.latency = latency
+	} else {
+		p.latency = time.Duration(scoreSmoothing*float64(latency) + (1-scoreSmoothing)*float64(p.latency))
+	}
+	p.errorRate = (1 - scoreSmoothing) * p.errorRate
+	if !p.healthy {
+		logger.Infof("Endpoint [%s] recovered.", p.name)
+	}
+	p.healthy = true
+
+	p.updateMetrics()
+}
+
+func (p *provider) recordFailure(err error, logger *log.Entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.errorRate = scoreSmoothing + (1-scoreSmoothing)*p.errorRate
+	if p.healthy {
+		logger.Warnf("Endpoint [%s] is unhealthy. Error: [%s]", p.name, err)
+	}
+	p.healthy = false
+
+	if p.errorsCounter != nil {
+		p.errorsCounter.Inc()
+	}
+	p.updateMetrics()
+}
+
+func (p *provider) recordBlockNumber(blockNumber uint64, lagging bool, logger *log.Entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if lagging && !p.lagging {
+		logger.Warnf("Endpoint [%s] lags behind at block [%d].", p.name, blockNumber)
+	} else if !lagging && p.lagging {
+		logger.Infof("Endpoint [%s] caught up at block [%d].", p.name, blockNumber)
+	}
+	p.blockNumber = blockNumber
+	p.lagging = lagging
+
+	p.updateMetrics()
+}
+
+// updateMetrics must be called while holding the lock of the provider
+func (p *provider) updateMetrics() {
+	if p.healthyGauge == nil {
+		return
+	}
+
+	healthy := 0.0
+	if p.healthy && !p.lagging {
+		healthy = 1
+	}
+	p.healthyGauge.Set(healthy)
+	p.latencyGauge.Set(p.latency.Seconds())
+	p.blockNumberGauge.Set(float64(p.blockNumber))
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
@@ -30,8 +31,9 @@ type Node struct {
 	client *hedera.Client
 }
 
-// NewNodeClient creates new instance of hedera.Client based on the provided client configuration
-func NewNodeClient(config config.Hedera) *Node {
+// NewNodeClient creates new instance of hedera.Client based on the provided client configuration.
+// The operator key is decrypted from its keystore, if one is configured
+func NewNodeClient(config config.Hedera, keystoreConfig config.Keystore) *Node {
 	var client *hedera.Client
 	switch config.Network {
 	case "mainnet":
@@ -58,9 +60,9 @@ func NewNodeClient(config config.Hedera) *Node {
 		log.Fatalf("Invalid Operator AccountId provided: [%s]", config.Operator.AccountId)
 	}
 
-	privateKey, err := hedera.PrivateKeyFromString(config.Operator.PrivateKey)
+	privateKey, err := operatorKey(config.Operator, keystoreConfig)
 	if err != nil {
-		log.Fatalf("Invalid Operator PrivateKey provided: [%s]", config.Operator.PrivateKey)
+		log.Fatalf("Invalid Operator PrivateKey provided. Error: [%s]", err)
 	}
 
 	client.SetOperator(accID, privateKey)
@@ -68,6 +70,18 @@ func NewNodeClient(config config.Hedera) *Node {
 	return &Node{client}
 }
 
+func operatorKey(operator config.Operator, keystoreConfig config.Keystore) (hedera.PrivateKey, error) {
+	if operator.Keystore == "" {
+		return hedera.PrivateKeyFromString(operator.PrivateKey)
+	}
+
+	passphrase, err := keystoreConfig.GetPassphrase()
+	if err != nil {
+		return hedera.PrivateKey{}, err
+	}
+	return keystore.ReadHedera(operator.Keystore, passphrase)
+}
+
 // GetClient returns the hedera.Client
 func (hc Node) GetClient() *hedera.Client {
 	return hc.client
@@ -108,6 +122,40 @@ func (hc Node) SubmitScheduledTokenBurnTransaction(tokenID hedera.TokenID, amoun
 	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
 }
 
+// SubmitScheduledNftMintTransaction creates an NFT mint transaction with the given metadata and submits it as a scheduled mint transaction
+func (hc Node) SubmitScheduledNftMintTransaction(tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	nftMintTx := hedera.NewTokenMintTransaction().SetTokenID(tokenID).SetMetadata(metadata)
+	tx, err := nftMintTx.FreezeWith(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	signedTransaction, err := tx.
+		SignWithOperator(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
+}
+
+// SubmitScheduledNftBurnTransaction creates an NFT burn transaction for the given serial number and submits it as a scheduled burn transaction
+func (hc Node) SubmitScheduledNftBurnTransaction(tokenID hedera.TokenID, serialNum int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	nftBurnTx := hedera.NewTokenBurnTransaction().SetTokenID(tokenID).SetSerialNumber(serialNum)
+	tx, err := nftBurnTx.FreezeWith(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	signedTransaction, err := tx.
+		SignWithOperator(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
+}
+
 // SubmitTopicConsensusMessage submits the provided message bytes to the
 // specified HCS `topicId`
 func (hc Node) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
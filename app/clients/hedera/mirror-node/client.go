@@ -17,13 +17,16 @@
 package mirror_node
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	timestampHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"io/ioutil"
@@ -35,149 +38,137 @@ type Client struct {
 	mirrorAPIAddress string
 	httpClient       client.HttpClient
 	pollingInterval  time.Duration
-	logger           *log.Entry
+	// The number of entries requested per page. If not positive, the default of the Mirror node is used
+	pageSize int
+	// The maximum number of pages read per poll of the watchers. If not positive, all pages are read
+	maxPages int
+	logger   *log.Entry
 }
 
-func NewClient(mirrorNodeAPIAddress string, pollingInterval time.Duration) *Client {
-	httpC := &http.Client{}
+func NewClient(mirrorNode config.MirrorNode, prometheusService service.Prometheus) *Client {
+	logger := config.GetLoggerFor("Mirror Node Client")
+	httpC := &http.Client{Timeout: mirrorNode.RequestTimeout * time.Second}
 	return &Client{
-		mirrorAPIAddress: mirrorNodeAPIAddress,
-		pollingInterval:  pollingInterval,
-		httpClient:       httpC,
-		logger:           config.GetLoggerFor("Mirror Node Client"),
+		mirrorAPIAddress: mirrorNode.ApiAddress,
+		pollingInterval:  mirrorNode.PollingInterval,
+		pageSize:         mirrorNode.PageSize,
+		maxPages:         mirrorNode.MaxPages,
+		httpClient: newTransport(
+			httpC,
+			mirrorNode.MaxRetries,
+			mirrorNode.RetryDelay*time.Second,
+			mirrorNode.RateLimit,
+			mirrorNode.CircuitBreakerThreshold,
+			mirrorNode.CircuitBreakerCooldown*time.Second,
+			prometheusService,
+			logger),
+		logger: logger,
 	}
 }
 
-func (c Client) GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (c Client) GetAccountTokenMintTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&scheduled=true&type=credit&timestamp=gt:%s&order=asc&transactiontype=tokenmint",
 		accountId.String(),
 		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionPages(ctx, transactionsDownloadQuery, 0)
 }
 
-func (c Client) GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error) {
-	return c.GetAccountTokenMintTransactionsAfterTimestampString(accountId, timestampHelper.String(from))
+func (c Client) GetAccountTokenMintTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error) {
+	return c.GetAccountTokenMintTransactionsAfterTimestampString(ctx, accountId, timestampHelper.String(from))
 }
 
-func (c Client) GetAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (c Client) GetAccountTokenBurnTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&scheduled=true&timestamp=gt:%s&order=asc&transactiontype=tokenburn",
 		accountId.String(),
 		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionPages(ctx, transactionsDownloadQuery, 0)
 }
 
-func (c Client) GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error) {
-	return c.GetAccountTokenBurnTransactionsAfterTimestampString(accountId, timestampHelper.String(from))
+func (c Client) GetAccountTokenBurnTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error) {
+	return c.GetAccountTokenBurnTransactionsAfterTimestampString(ctx, accountId, timestampHelper.String(from))
 }
 
-func (c Client) GetAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (c Client) GetAccountDebitTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=debit&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
 		accountId.String(),
 		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionPages(ctx, transactionsDownloadQuery, 0)
 }
 
-func (c Client) GetAccountCreditTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (c Client) GetAccountCreditTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=credit&result=success&timestamp=gt:%s&order=asc&transactiontype=cryptotransfer",
 		accountId.String(),
 		from)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionPages(ctx, transactionsDownloadQuery, c.maxPages)
 }
 
-func (c Client) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error) {
-	return c.GetAccountCreditTransactionsAfterTimestampString(accountId, timestampHelper.String(from))
+func (c Client) GetAccountCreditTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error) {
+	return c.GetAccountCreditTransactionsAfterTimestampString(ctx, accountId, timestampHelper.String(from))
 }
 
-// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
-func (c Client) GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]model.Transaction, error) {
-	transactions, err := c.GetAccountCreditTransactionsAfterTimestamp(accountId, from)
+// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` included and `to` excluded
+func (c Client) GetAccountCreditTransactionsBetween(ctx context.Context, accountId hedera.AccountID, from, to int64) ([]model.Transaction, error) {
+	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=credit&result=success&timestamp=gte:%s&timestamp=lt:%s&order=asc&transactiontype=cryptotransfer",
+		accountId.String(),
+		timestampHelper.String(from),
+		timestampHelper.String(to))
+	// The range is bounded, so all of its pages are read
+	response, err := c.getTransactionPages(ctx, transactionsDownloadQuery, 0)
 	if err != nil {
 		return nil, err
 	}
-
-	var res []model.Transaction
-	for _, t := range transactions.Transactions {
-		ts, err := timestampHelper.FromString(t.ConsensusTimestamp)
-		if err != nil {
-			return nil, err
-		}
-		if ts < to {
-			res = append(res, t)
-		}
-	}
-	return res, nil
+	return response.Transactions, nil
 }
 
 // GetMessagesAfterTimestamp returns all Topic messages after the given timestamp
-func (c Client) GetMessagesAfterTimestamp(topicId hedera.TopicID, from int64) ([]model.Message, error) {
-	messagesQuery := fmt.Sprintf("/%s/messages?timestamp=gt:%s",
+func (c Client) GetMessagesAfterTimestamp(ctx context.Context, topicId hedera.TopicID, from int64) ([]model.Message, error) {
+	messagesQuery := fmt.Sprintf("/%s/messages?timestamp=gt:%s&order=asc",
 		topicId.String(),
 		timestampHelper.String(from))
 
-	return c.getTopicMessagesByQuery(messagesQuery)
+	return c.getTopicMessagePages(ctx, messagesQuery, c.maxPages)
 }
 
-// GetMessagesForTopicBetween returns all Topic messages for the specified topic between timestamp `from` and `to` excluded
-func (c Client) GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]model.Message, error) {
-	transactionsDownloadQuery := fmt.Sprintf("/%s/messages?timestamp=gt:%s",
+// GetMessagesForTopicBetween returns all Topic messages for the specified topic between timestamp `from` included and `to` excluded
+func (c Client) GetMessagesForTopicBetween(ctx context.Context, topicId hedera.TopicID, from, to int64) ([]model.Message, error) {
+	messagesQuery := fmt.Sprintf("/%s/messages?timestamp=gte:%s&timestamp=lt:%s&order=asc",
 		topicId.String(),
-		timestampHelper.String(from))
-	msgs, err := c.getTopicMessagesByQuery(transactionsDownloadQuery)
-	if err != nil {
-		return nil, err
-	}
+		timestampHelper.String(from),
+		timestampHelper.String(to))
 
-	// TODO refactor into 1 function (reuse code above)
-	var res []model.Message
-	for _, m := range msgs {
-		ts, err := timestampHelper.FromString(m.ConsensusTimestamp)
-		if err != nil {
-			return nil, err
-		}
-		if ts < to {
-			res = append(res, m)
-		}
-	}
-	return res, nil
+	// The range is bounded, so all of its pages are read
+	return c.getTopicMessagePages(ctx, messagesQuery, 0)
 }
 
 // GetNftTransactions returns the nft transactions for tokenID and serialNum
-func (c Client) GetNftTransactions(tokenID string, serialNum int64) (model.NftTransactionsResponse, error) {
+func (c Client) GetNftTransactions(ctx context.Context, tokenID string, serialNum int64) (model.NftTransactionsResponse, error) {
 	query := fmt.Sprintf("%stokens/%s/nfts/%d/transactions", c.mirrorAPIAddress, tokenID, serialNum)
 
-	httpResponse, err := c.get(query)
-	if err != nil {
-		return model.NftTransactionsResponse{}, err
-	}
-
-	bodyBytes, err := readResponseBody(httpResponse)
-	if err != nil {
-		return model.NftTransactionsResponse{}, err
-	}
-
-	if httpResponse.StatusCode != http.StatusOK {
-		return model.NftTransactionsResponse{}, errors.New(fmt.Sprintf("Mirror Node API [%s] ended with Status Code [%d]. Body bytes: [%s]", query, httpResponse.StatusCode, bodyBytes))
-	}
-
-	var response *model.NftTransactionsResponse
-	err = json.Unmarshal(bodyBytes, &response)
-	if err != nil {
-		return model.NftTransactionsResponse{}, err
+	result := model.NftTransactionsResponse{}
+	pages := c.pages(ctx, query, 0)
+	for {
+		page := &model.NftTransactionsResponse{}
+		ok, err := pages.Next(page)
+		if err != nil {
+			return model.NftTransactionsResponse{}, err
+		}
+		if !ok {
+			return result, nil
+		}
+		result.Transactions = append(result.Transactions, page.Transactions...)
 	}
-
-	return *response, nil
 }
 
-func (c Client) GetTransaction(transactionID string) (*model.Response, error) {
+func (c Client) GetTransaction(ctx context.Context, transactionID string) (*model.Response, error) {
 	transactionsDownloadQuery := fmt.Sprintf("/%s",
 		transactionID)
-	return c.getTransactionsByQuery(transactionsDownloadQuery)
+	return c.getTransactionsByQuery(ctx, transactionsDownloadQuery)
 }
 
-func (c Client) GetSuccessfulTransaction(transactionID string) (model.Transaction, error) {
+func (c Client) GetSuccessfulTransaction(ctx context.Context, transactionID string) (model.Transaction, error) {
 	transactionsDownloadQuery := fmt.Sprintf("/%s",
 		transactionID)
-	response, err := c.getTransactionsByQuery(transactionsDownloadQuery)
+	response, err := c.getTransactionsByQuery(ctx, transactionsDownloadQuery)
 	if err != nil {
 		return model.Transaction{}, err
 	}
@@ -192,29 +183,16 @@ func (c Client) GetSuccessfulTransaction(transactionID string) (model.Transactio
 }
 
 // GetScheduledTransaction gets the Scheduled transaction of an executed transaction
-func (c Client) GetScheduledTransaction(transactionID string) (*model.Response, error) {
-	return c.GetTransaction(fmt.Sprintf("%s?scheduled=false", transactionID))
+func (c Client) GetScheduledTransaction(ctx context.Context, transactionID string) (*model.Response, error) {
+	return c.GetTransaction(ctx, fmt.Sprintf("%s?scheduled=false", transactionID))
 }
 
 // GetSchedule retrieves a schedule entity by its id
-func (c Client) GetSchedule(scheduleID string) (*model.Schedule, error) {
+func (c Client) GetSchedule(ctx context.Context, scheduleID string) (*model.Schedule, error) {
 	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "schedules/", scheduleID)
 
-	httpResponse, e := c.get(query)
-	if e != nil {
-		return nil, e
-	}
-	if httpResponse.StatusCode >= 400 {
-		return nil, errors.New(fmt.Sprintf(`Failed to execute query: [%s]. Error: [%s]`, query, query))
-	}
-
-	bodyBytes, e := readResponseBody(httpResponse)
-	if e != nil {
-		return nil, e
-	}
-
 	var response *model.Schedule
-	e = json.Unmarshal(bodyBytes, &response)
+	e := c.getJSON(ctx, query, &response)
 	if e != nil {
 		return nil, e
 	}
@@ -222,41 +200,28 @@ func (c Client) GetSchedule(scheduleID string) (*model.Schedule, error) {
 	return response, nil
 }
 
-func (c Client) GetStateProof(transactionID string) ([]byte, error) {
+func (c Client) GetStateProof(ctx context.Context, transactionID string) ([]byte, error) {
 	query := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions",
 		fmt.Sprintf("/%s/stateproof", transactionID))
 
-	response, e := c.get(query)
+	response, e := c.get(ctx, query)
 	if e != nil {
 		return nil, e
 	}
-
-	if response.StatusCode != http.StatusOK {
-		return nil, errors.New(fmt.Sprintf("State Proof HTTP GET for TransactionID [%s] ended with Status Code [%d].", transactionID, response.StatusCode))
+	e = checkStatus(query, response)
+	if e != nil {
+		return nil, e
 	}
 
 	return readResponseBody(response)
 }
 
-func (c Client) GetNft(tokenID string, serialNum int64) (*model.Nft, error) {
+func (c Client) GetNft(ctx context.Context, tokenID string, serialNum int64) (*model.Nft, error) {
 	nftQuery := fmt.Sprintf("%s%d", "/nfts/", serialNum)
 	query := fmt.Sprintf("%s%s%s%s", c.mirrorAPIAddress, "tokens/", tokenID, nftQuery)
 
-	httpResponse, e := c.get(query)
-	if e != nil {
-		return nil, e
-	}
-	if httpResponse.StatusCode >= 400 {
-		return nil, errors.New(fmt.Sprintf(`Failed to execute query: [%s]. Error: [%s]`, query, query))
-	}
-
-	bodyBytes, e := readResponseBody(httpResponse)
-	if e != nil {
-		return nil, e
-	}
-
 	var response *model.Nft
-	e = json.Unmarshal(bodyBytes, &response)
+	e := c.getJSON(ctx, query, &response)
 	if e != nil {
 		return nil, e
 	}
@@ -264,37 +229,24 @@ func (c Client) GetNft(tokenID string, serialNum int64) (*model.Nft, error) {
 	return response, nil
 }
 
-func (c Client) AccountExists(accountID hedera.AccountID) bool {
+func (c Client) AccountExists(ctx context.Context, accountID hedera.AccountID) bool {
 	mirrorNodeApiTransactionAddress := fmt.Sprintf("%s%s", c.mirrorAPIAddress, "accounts")
 	accountQuery := fmt.Sprintf("%s/%s",
 		mirrorNodeApiTransactionAddress,
 		accountID.String())
 
-	return c.query(accountQuery, accountID.String())
+	return c.query(ctx, accountQuery, accountID.String())
 }
 
 // GetAccount retrieves an account entity by its id
-func (c Client) GetAccount(accountID string) (*model.AccountsResponse, error) {
+func (c Client) GetAccount(ctx context.Context, accountID string) (*model.AccountsResponse, error) {
 	mirrorNodeApiTransactionAddress := fmt.Sprintf("%s%s", c.mirrorAPIAddress, "accounts")
 	query := fmt.Sprintf("%s/%s",
 		mirrorNodeApiTransactionAddress,
 		accountID)
 
-	httpResponse, e := c.get(query)
-	if e != nil {
-		return nil, e
-	}
-	if httpResponse.StatusCode >= 400 {
-		return nil, errors.New(fmt.Sprintf(`Failed to execute query: [%s]. Error: [%s]`, query, query))
-	}
-
-	bodyBytes, e := readResponseBody(httpResponse)
-	if e != nil {
-		return nil, e
-	}
-
 	var response *model.AccountsResponse
-	e = json.Unmarshal(bodyBytes, &response)
+	e := c.getJSON(ctx, query, &response)
 	if e != nil {
 		return nil, e
 	}
@@ -303,27 +255,14 @@ func (c Client) GetAccount(accountID string) (*model.AccountsResponse, error) {
 }
 
 // GetToken retrieves a token entity by its id
-func (c Client) GetToken(tokenID string) (*model.TokenResponse, error) {
+func (c Client) GetToken(ctx context.Context, tokenID string) (*model.TokenResponse, error) {
 	mirrorNodeApiTransactionAddress := fmt.Sprintf("%s%s", c.mirrorAPIAddress, "tokens")
 	query := fmt.Sprintf("%s/%s",
 		mirrorNodeApiTransactionAddress,
 		tokenID)
 
-	httpResponse, e := c.get(query)
-	if e != nil {
-		return nil, e
-	}
-	if httpResponse.StatusCode >= 400 {
-		return nil, errors.New(fmt.Sprintf(`Failed to execute query: [%s]. Error: [%s]`, query, query))
-	}
-
-	bodyBytes, e := readResponseBody(httpResponse)
-	if e != nil {
-		return nil, e
-	}
-
 	var response *model.TokenResponse
-	e = json.Unmarshal(bodyBytes, &response)
+	e := c.getJSON(ctx, query, &response)
 	if e != nil {
 		return nil, e
 	}
@@ -331,17 +270,17 @@ func (c Client) GetToken(tokenID string) (*model.TokenResponse, error) {
 	return response, nil
 }
 
-func (c Client) TopicExists(topicID hedera.TopicID) bool {
+func (c Client) TopicExists(ctx context.Context, topicID hedera.TopicID) bool {
 	mirrorNodeApiTransactionAddress := fmt.Sprintf("%s%s", c.mirrorAPIAddress, "topics")
 	topicQuery := fmt.Sprintf("%s/%s/messages",
 		mirrorNodeApiTransactionAddress,
 		topicID.String())
 
-	return c.query(topicQuery, topicID.String())
+	return c.query(ctx, topicQuery, topicID.String())
 }
 
-func (c Client) query(query, entityID string) bool {
-	response, err := c.httpClient.Get(query)
+func (c Client) query(ctx context.Context, query, entityID string) bool {
+	response, err := c.get(ctx, query)
 	if err != nil {
 		c.logger.Errorf("[%s] - failed to query account. Error [%s].", entityID, err)
 		return false
@@ -362,12 +301,19 @@ func (c Client) query(query, entityID string) bool {
 }
 
 // WaitForTransaction Polls the transaction at intervals. Depending on the
-// result, the corresponding `onSuccess` and `onFailure` functions are called
-func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
+// result, the corresponding `onSuccess` and `onFailure` functions are called.
+// Polling stops without calling either of them once the given context is done
+func (c Client) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
 	go func() {
 		for {
-			response, err := c.GetTransaction(txId)
-			if response != nil && response.IsNotFound() {
+			response, err := c.GetTransaction(ctx, txId)
+			if ctx.Err() != nil {
+				c.logger.Debugf("[%s] Stopped waiting for TX.", txId)
+				return
+			}
+			if errors.Is(err, client.ErrNotFound) || errors.Is(err, client.ErrUnavailable) {
+				c.logger.Tracef("[%s] TX is not available yet. Error: [%s].", txId, err)
+				wait.Sleep(ctx, c.pollingInterval*time.Second)
 				continue
 			}
 			if err != nil {
@@ -394,19 +340,26 @@ func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
 				return
 			}
 			c.logger.Tracef("Pinged Mirror Node for TX [%s]. No update", txId)
-			time.Sleep(c.pollingInterval * time.Second)
+			wait.Sleep(ctx, c.pollingInterval*time.Second)
 		}
 	}()
 	c.logger.Debugf("Added new TX [%s] for monitoring", txId)
 }
 
 // WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
-// result, the corresponding `onSuccess` and `onFailure` functions are called
-func (c Client) WaitForScheduledTransaction(txId string, onSuccess, onFailure func()) {
+// result, the corresponding `onSuccess` and `onFailure` functions are called.
+// Polling stops without calling either of them once the given context is done
+func (c Client) WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
 	c.logger.Debugf("Added new Scheduled TX [%s] for monitoring", txId)
 	for {
-		response, err := c.GetTransaction(txId)
-		if response != nil && response.IsNotFound() {
+		response, err := c.GetTransaction(ctx, txId)
+		if ctx.Err() != nil {
+			c.logger.Debugf("[%s] Stopped waiting for Scheduled TX.", txId)
+			return
+		}
+		if errors.Is(err, client.ErrNotFound) || errors.Is(err, client.ErrUnavailable) {
+			c.logger.Tracef("[%s] Scheduled TX is not available yet. Error: [%s].", txId, err)
+			wait.Sleep(ctx, c.pollingInterval*time.Second)
 			continue
 		}
 		if err != nil {
@@ -433,61 +386,123 @@ func (c Client) WaitForScheduledTransaction(txId string, onSuccess, onFailure fu
 			return
 		}
 		c.logger.Tracef("Pinged Mirror Node for Scheduled TX [%s]. No update", txId)
-		time.Sleep(c.pollingInterval * time.Second)
+		wait.Sleep(ctx, c.pollingInterval*time.Second)
 	}
 }
 
-func (c Client) get(query string) (*http.Response, error) {
-	return c.httpClient.Get(query)
+func (c Client) get(ctx context.Context, query string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(request)
 }
 
-func (c Client) getTransactionsByQuery(query string) (*model.Response, error) {
+func (c Client) getTransactionsByQuery(ctx context.Context, query string) (*model.Response, error) {
 	transactionsQuery := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", query)
 
-	return c.getAndParse(transactionsQuery)
+	return c.getAndParse(ctx, transactionsQuery)
 }
 
-func (c Client) getAndParse(query string) (*model.Response, error) {
-	httpResponse, e := c.get(query)
-	if e != nil {
-		return nil, e
+// getTransactionPages returns the transactions of all pages of the given list query. If maxPages is positive, at most
+// maxPages are read and the remaining transactions are left for the next poll
+func (c Client) getTransactionPages(ctx context.Context, query string, maxPages int) (*model.Response, error) {
+	transactionsQuery := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "transactions", query)
+
+	result := &model.Response{}
+	pages := c.pages(ctx, transactionsQuery, maxPages)
+	for {
+		page := &model.Response{}
+		ok, err := pages.Next(page)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		result.Transactions = append(result.Transactions, page.Transactions...)
 	}
 
-	bodyBytes, e := readResponseBody(httpResponse)
-	if e != nil {
-		return nil, e
+	if pages.Truncated() {
+		c.logger.Debugf("Read [%d] pages of transactions, the rest is left for the next poll.", maxPages)
 	}
+	return result, nil
+}
 
+func (c Client) getAndParse(ctx context.Context, query string) (*model.Response, error) {
 	var response *model.Response
-	e = json.Unmarshal(bodyBytes, &response)
+	e := c.getJSON(ctx, query, &response)
 	if e != nil {
 		return nil, e
 	}
-	if httpResponse.StatusCode >= 400 {
-		return response, errors.New(fmt.Sprintf(`Failed to execute query: [%s]. Error: [%s]`, query, response.Status.String()))
-	}
 
 	return response, nil
 }
 
-func (c Client) getTopicMessagesByQuery(query string) ([]model.Message, error) {
-	messagesQuery := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "topics", query)
-	response, e := c.get(messagesQuery)
+// getJSON unmarshals the response of the given query into v
+func (c Client) getJSON(ctx context.Context, query string, v interface{}) error {
+	httpResponse, e := c.get(ctx, query)
 	if e != nil {
-		return nil, e
+		return e
 	}
-
-	bodyBytes, e := readResponseBody(response)
+	e = checkStatus(query, httpResponse)
 	if e != nil {
-		return nil, e
+		return e
 	}
 
-	var messages *model.Messages
-	e = json.Unmarshal(bodyBytes, &messages)
+	bodyBytes, e := readResponseBody(httpResponse)
 	if e != nil {
-		return nil, e
+		return e
+	}
+
+	return json.Unmarshal(bodyBytes, v)
+}
+
+// checkStatus returns an error, if the query ended with an error Status Code. The error wraps client.ErrNotFound, if
+// the entity does not exist, or client.ErrUnavailable, if the Mirror node kept failing with a transient error
+func checkStatus(query string, response *http.Response) error {
+	if response.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	var bodyBytes []byte
+	if response.Body != nil {
+		bodyBytes, _ = readResponseBody(response)
+	}
+	message := fmt.Sprintf("Mirror Node API [%s] ended with Status Code [%d]. Body bytes: [%s]", query, response.StatusCode, bodyBytes)
+
+	if response.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", message, client.ErrNotFound)
+	}
+	if isTransient(response, nil) {
+		return fmt.Errorf("%s: %w", message, client.ErrUnavailable)
+	}
+	return errors.New(message)
+}
+
+// getTopicMessagePages returns the messages of all pages of the given list query. If maxPages is positive, at most
+// maxPages are read and the remaining messages are left for the next poll
+func (c Client) getTopicMessagePages(ctx context.Context, query string, maxPages int) ([]model.Message, error) {
+	messagesQuery := fmt.Sprintf("%s%s%s", c.mirrorAPIAddress, "topics", query)
+
+	var messages []model.Message
+	pages := c.pages(ctx, messagesQuery, maxPages)
+	for {
+		page := &model.Messages{}
+		ok, err := pages.Next(page)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		messages = append(messages, page.Messages...)
+	}
+
+	if pages.Truncated() {
+		c.logger.Debugf("Read [%d] pages of topic messages, the rest is left for the next poll.", maxPages)
 	}
-	return messages.Messages, nil
+	return messages, nil
 }
 
 func readResponseBody(response *http.Response) ([]byte, error) {
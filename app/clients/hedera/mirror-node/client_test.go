@@ -17,9 +17,11 @@
 package mirror_node
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -69,16 +71,23 @@ func setup() {
 
 func Test_NewClient(t *testing.T) {
 	setup()
-	newClient := NewClient(mirrorAPIAddress, pollingInterval)
+	newClient := NewClient(config.MirrorNode{
+		ApiAddress:      mirrorAPIAddress,
+		PollingInterval: pollingInterval,
+		PageSize:        25,
+		MaxPages:        2,
+	}, nil)
 	assert.Equal(t, c.mirrorAPIAddress, newClient.mirrorAPIAddress)
 	assert.Equal(t, c.pollingInterval, newClient.pollingInterval)
+	assert.Equal(t, 25, newClient.pageSize)
+	assert.Equal(t, 2, newClient.maxPages)
 	assert.Equal(t, c.logger, newClient.logger)
 }
 
 func Test_GetAccountTokenMintTransactionsAfterTimestamp_ThrowsError(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetAccountTokenMintTransactionsAfterTimestamp(accountId, time.Now().UnixNano())
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetAccountTokenMintTransactionsAfterTimestamp(context.Background(), accountId, time.Now().UnixNano())
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
@@ -88,16 +97,16 @@ func Test_GetSchedule_Status400(t *testing.T) {
 	response := &http.Response{
 		StatusCode: 400,
 	}
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
-	schedule, err := c.GetSchedule("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(response, nil)
+	schedule, err := c.GetSchedule(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
 
 func Test_GetSchedule_Fails(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	schedule, err := c.GetSchedule("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	schedule, err := c.GetSchedule(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
@@ -110,8 +119,8 @@ func Test_AccountExists_Status400(t *testing.T) {
 		StatusCode: 400,
 		Body:       stringReadCloser,
 	}
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
-	exists := c.AccountExists(accountId)
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(response, nil)
+	exists := c.AccountExists(context.Background(), accountId)
 	assert.False(t, exists)
 }
 
@@ -120,16 +129,16 @@ func Test_GetAccount_Status400(t *testing.T) {
 	response := &http.Response{
 		StatusCode: 400,
 	}
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
-	schedule, err := c.GetAccount("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(response, nil)
+	schedule, err := c.GetAccount(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
 
 func Test_GetAccount_Fails(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	schedule, err := c.GetAccount("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	schedule, err := c.GetAccount(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
@@ -139,16 +148,16 @@ func Test_GetToken_Status400(t *testing.T) {
 	response := &http.Response{
 		StatusCode: 400,
 	}
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
-	schedule, err := c.GetToken("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(response, nil)
+	schedule, err := c.GetToken(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
 
 func Test_GetToken_Fails(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	schedule, err := c.GetToken("0.0.2")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	schedule, err := c.GetToken(context.Background(), "0.0.2")
 	assert.Nil(t, schedule)
 	assert.NotNil(t, err)
 }
@@ -161,73 +170,73 @@ func Test_TopicExists_Status400(t *testing.T) {
 		StatusCode: 400,
 		Body:       stringReadCloser,
 	}
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
-	exists := c.TopicExists(topicId)
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(response, nil)
+	exists := c.TopicExists(context.Background(), topicId)
 	assert.False(t, exists)
 }
 
 func Test_GetAccountTokenBurnTransactionsAfterTimestamp(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetAccountTokenBurnTransactionsAfterTimestamp(accountId, time.Now().UnixNano())
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetAccountTokenBurnTransactionsAfterTimestamp(context.Background(), accountId, time.Now().UnixNano())
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetMessagesAfterTimestamp(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetMessagesAfterTimestamp(topicId, time.Now().UnixNano())
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetMessagesAfterTimestamp(context.Background(), topicId, time.Now().UnixNano())
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetTransaction(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetTransaction("txid")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetTransaction(context.Background(), "txid")
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetScheduledTransaction(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetScheduledTransaction("txid")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetScheduledTransaction(context.Background(), "txid")
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetStateProof_Status400(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(&http.Response{
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(&http.Response{
 		StatusCode: 400,
 	}, nil)
-	response, err := c.GetStateProof("txid")
+	response, err := c.GetStateProof(context.Background(), "txid")
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetStateProof_Fails(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetStateProof("txid")
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetStateProof(context.Background(), "txid")
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetAccountCreditTransactionsAfterTimestamp(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetAccountCreditTransactionsAfterTimestamp(accountId, time.Now().UnixNano())
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetAccountCreditTransactionsAfterTimestamp(context.Background(), accountId, time.Now().UnixNano())
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
 
 func Test_GetAccountDebitTransactionsAfterTimestampString(t *testing.T) {
 	setup()
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetAccountDebitTransactionsAfterTimestampString(accountId, fmt.Sprintf("%v", time.Now().UnixNano()))
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetAccountDebitTransactionsAfterTimestampString(context.Background(), accountId, fmt.Sprintf("%v", time.Now().UnixNano()))
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
@@ -236,8 +245,95 @@ func Test_GetAccountCreditTransactionsBetween(t *testing.T) {
 	setup()
 	now := time.Now()
 	then := now.Add(time.Hour * 2)
-	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
-	response, err := c.GetAccountCreditTransactionsBetween(accountId, now.UnixNano(), then.UnixNano())
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("some-error"))
+	response, err := c.GetAccountCreditTransactionsBetween(context.Background(), accountId, now.UnixNano(), then.UnixNano())
 	assert.Error(t, errors.New("some-error"), err)
 	assert.Nil(t, response)
 }
+
+func Test_GetAccountCreditTransactionsBetween_IncludesFrom(t *testing.T) {
+	setup()
+	c.mirrorAPIAddress = "http://mirror-node/api/v1/"
+	query := "http://mirror-node/api/v1/transactions?account.id=0.0.1&type=credit&result=success&timestamp=gte:1.500000000&timestamp=lt:3.0&order=asc&transactiontype=cryptotransfer"
+	mocks.MHTTPClient.On("Do", query).Return(jsonResponse(http.StatusOK, `{"transactions":[{"transaction_id":"0.0.2-1-500000000","consensus_timestamp":"1.500000000"}],"links":{"next":null}}`), nil)
+
+	transactions, err := c.GetAccountCreditTransactionsBetween(context.Background(), accountId, 1500000000, 3000000000)
+
+	assert.Nil(t, err)
+	assert.Len(t, transactions, 1)
+	assert.Equal(t, "1.500000000", transactions[0].ConsensusTimestamp)
+}
+
+func Test_GetAccountCreditTransactionsAfterTimestamp_FollowsNextLinks(t *testing.T) {
+	setup()
+	c.mirrorAPIAddress = "http://mirror-node/api/v1/"
+	c.pageSize = 1
+	c.maxPages = 2
+	firstQuery := "http://mirror-node/api/v1/transactions?account.id=0.0.1&type=credit&result=success&timestamp=gt:0.1&order=asc&transactiontype=cryptotransfer&limit=1"
+	secondQuery := "http://mirror-node/api/v1/transactions?account.id=0.0.1&timestamp=gt:1.000000000&limit=1"
+	mocks.MHTTPClient.On("Do", firstQuery).Return(jsonResponse(http.StatusOK, `{"transactions":[{"transaction_id":"0.0.2-1-0"}],"links":{"next":"/api/v1/transactions?account.id=0.0.1&timestamp=gt:1.000000000&limit=1"}}`), nil)
+	mocks.MHTTPClient.On("Do", secondQuery).Return(jsonResponse(http.StatusOK, `{"transactions":[{"transaction_id":"0.0.2-2-0"}],"links":{"next":"/api/v1/transactions?account.id=0.0.1&timestamp=gt:2.000000000&limit=1"}}`), nil)
+
+	response, err := c.GetAccountCreditTransactionsAfterTimestamp(context.Background(), accountId, 1)
+
+	assert.Nil(t, err)
+	assert.Len(t, response.Transactions, 2)
+	assert.Equal(t, "0.0.2-1-0", response.Transactions[0].TransactionID)
+	assert.Equal(t, "0.0.2-2-0", response.Transactions[1].TransactionID)
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func Test_GetMessagesForTopicBetween_ReadsAllPages(t *testing.T) {
+	setup()
+	c.mirrorAPIAddress = "http://mirror-node/api/v1/"
+	c.maxPages = 1
+	firstQuery := "http://mirror-node/api/v1/topics/0.0.2/messages?timestamp=gte:0.1&timestamp=lt:3.0&order=asc"
+	secondQuery := "http://mirror-node/api/v1/topics/0.0.2/messages?timestamp=gt:1.000000000&timestamp=lt:3.000000000&order=asc"
+	mocks.MHTTPClient.On("Do", firstQuery).Return(jsonResponse(http.StatusOK, `{"messages":[{"consensus_timestamp":"1.000000000"}],"links":{"next":"/api/v1/topics/0.0.2/messages?timestamp=gt:1.000000000&timestamp=lt:3.000000000&order=asc"}}`), nil)
+	mocks.MHTTPClient.On("Do", secondQuery).Return(jsonResponse(http.StatusOK, `{"messages":[{"consensus_timestamp":"2.000000000"}],"links":{"next":null}}`), nil)
+
+	messages, err := c.GetMessagesForTopicBetween(context.Background(), topicId, 1, 3000000000)
+
+	assert.Nil(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, "2.000000000", messages[1].ConsensusTimestamp)
+}
+
+func Test_GetMessagesAfterTimestamp_Status400(t *testing.T) {
+	setup()
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(jsonResponse(http.StatusBadRequest, `{"_status":{"messages":[{"message":"Invalid parameter: limit"}]}}`), nil)
+
+	messages, err := c.GetMessagesAfterTimestamp(context.Background(), topicId, 1)
+
+	assert.Error(t, err)
+	assert.Nil(t, messages)
+}
+
+func Test_GetTransaction_NotFound(t *testing.T) {
+	setup()
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(jsonResponse(http.StatusNotFound, `{"_status":{"messages":[{"message":"Not found"}]}}`), nil)
+	response, err := c.GetTransaction(context.Background(), "txid")
+	assert.True(t, errors.Is(err, client.ErrNotFound))
+	assert.Nil(t, response)
+}
+
+func Test_WaitForScheduledTransaction_PollsUntilFound(t *testing.T) {
+	setup()
+	c.pollingInterval = 0
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(jsonResponse(http.StatusNotFound, ""), nil).Once()
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(jsonResponse(http.StatusServiceUnavailable, ""), nil).Once()
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(jsonResponse(http.StatusOK, `{"transactions":[{"scheduled":false,"result":"SUCCESS"},{"scheduled":true,"result":"SUCCESS"}]}`), nil).Once()
+
+	succeeded := false
+	c.WaitForScheduledTransaction(context.Background(), "txid", func() { succeeded = true }, func() {})
+
+	assert.True(t, succeeded)
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 3)
+}
+
+func jsonResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
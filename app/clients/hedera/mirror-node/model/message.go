@@ -29,5 +29,10 @@ type (
 	// Topic Messages are queried
 	Messages struct {
 		Messages []Message
+		Links    Pagination `json:"links"`
 	}
 )
+
+func (m Messages) NextLink() string {
+	return m.Links.Next
+}
@@ -61,6 +61,7 @@ type (
 	Response struct {
 		Transactions []Transaction
 		Status       `json:"_status"`
+		Links        Pagination `json:"links"`
 	}
 	// Schedule struct used by the Hedera Mirror node REST API to return information
 	// regarding a given Schedule entity
@@ -99,6 +100,11 @@ type (
 	Pagination struct {
 		Next string `json:"next"` // Hyperlink to the next page of results
 	}
+	// Page is implemented by the responses of the list queries of the Hedera Mirror node REST API
+	Page interface {
+		// NextLink returns the hyperlink to the next page of results. Empty on the last page
+		NextLink() string
+	}
 	// ParsedTransfer Used in GetIncomingTransfer to return the information about an Incoming Transfer
 	ParsedTransfer struct {
 		IsNft             bool
@@ -107,6 +113,14 @@ type (
 	}
 )
 
+func (r Response) NextLink() string {
+	return r.Links.Next
+}
+
+func (r NftTransactionsResponse) NextLink() string {
+	return r.Links.Next
+}
+
 // getIncomingAmountFor returns the amount that is credited to the specified
 // account for the given transaction
 func (t Transaction) getIncomingAmountFor(account string) (int64, string, error) {
@@ -139,6 +153,28 @@ func (t Transaction) getIncomingNftTransferFor(account string) (serialNum int64,
 	return 0, "", errors.New("no incoming nft transfer found")
 }
 
+// GetMintedNftSerialNumber returns the serial number of the given token, minted in the transaction
+func (t Transaction) GetMintedNftSerialNumber(token string) (int64, error) {
+	for _, ntr := range t.NftTransfers {
+		if ntr.Token == token && ntr.SenderAccountID == "" {
+			return ntr.SerialNumber, nil
+		}
+	}
+
+	return 0, errors.New("no minted nft found")
+}
+
+// GetIncomingTokenTransfers returns all fungible token transfers, which credit the specified account in the transaction
+func (t Transaction) GetIncomingTokenTransfers(account string) []Transfer {
+	var transfers []Transfer
+	for _, tr := range t.TokenTransfers {
+		if tr.Account == account && tr.Amount > 0 {
+			transfers = append(transfers, tr)
+		}
+	}
+	return transfers
+}
+
 // GetHBARTransfer gets the HBAR transfer for an Account
 func (t Transaction) GetHBARTransfer(account string) (amount int64, isFound bool) {
 	for _, tr := range t.Transfers {
@@ -200,6 +236,20 @@ func (r Response) GetLatestTxnConsensusTime() (int64, error) {
 	return max, nil
 }
 
+// WithTokenTransfers returns a response with only the transactions, which transfer the given token
+func (r Response) WithTokenTransfers(token string) *Response {
+	filtered := &Response{Status: r.Status}
+	for _, t := range r.Transactions {
+		for _, tr := range t.TokenTransfers {
+			if tr.Token == token {
+				filtered.Transactions = append(filtered.Transactions, t)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 // IsNotFound traverses all Error messages and searches for Not Found message
 func (r Response) IsNotFound() bool {
 	for _, m := range r.Messages {
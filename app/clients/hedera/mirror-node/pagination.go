@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror_node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"net/url"
+	"strings"
+)
+
+// pages iterates over the pages of a list query of the Mirror node by following the `links.next` hyperlinks of the
+// responses
+type pages struct {
+	ctx      context.Context
+	client   Client
+	next     string
+	maxPages int
+	read     int
+}
+
+// pages returns an iterator over the pages of the given list query, requesting the configured page size. If maxPages
+// is positive, at most maxPages are read. The pages are requested until the given context is done
+func (c Client) pages(ctx context.Context, query string, maxPages int) *pages {
+	if c.pageSize > 0 {
+		separator := "?"
+		if strings.Contains(query, "?") {
+			separator = "&"
+		}
+		query = fmt.Sprintf("%s%slimit=%d", query, separator, c.pageSize)
+	}
+
+	return &pages{
+		ctx:      ctx,
+		client:   c,
+		next:     query,
+		maxPages: maxPages,
+	}
+}
+
+// Next reads the next page into the given value. Returns false if all pages or maxPages were read
+func (p *pages) Next(page model.Page) (bool, error) {
+	if p.next == "" || (p.maxPages > 0 && p.read >= p.maxPages) {
+		return false, nil
+	}
+
+	query := p.next
+	response, err := p.client.get(p.ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	err = checkStatus(query, response)
+	if err != nil {
+		return false, err
+	}
+
+	bodyBytes, err := readResponseBody(response)
+	if err != nil {
+		return false, err
+	}
+
+	err = json.Unmarshal(bodyBytes, page)
+	if err != nil {
+		return false, err
+	}
+	p.read++
+
+	p.next, err = p.client.resolve(page.NextLink())
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Truncated returns whether pages were left unread, because maxPages were read
+func (p *pages) Truncated() bool {
+	return p.next != ""
+}
+
+// resolve converts the `links.next` hyperlink, which is relative to the host of the Mirror node, to a query
+func (c Client) resolve(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	base, err := url.Parse(c.mirrorAPIAddress)
+	if err != nil {
+		return "", err
+	}
+	reference, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(reference).String(), nil
+}
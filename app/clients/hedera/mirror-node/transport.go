@@ -0,0 +1,303 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror_node
+
+import (
+	"context"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The upper bound of the delay between two attempts of a request
+const maxRetryDelay = 30 * time.Second
+
+var errCircuitOpen = fmt.Errorf("circuit breaker is open: %w", client.ErrUnavailable)
+
+// transport is a client.HttpClient, which limits the rate of the requests to the Mirror node, retries the ones failing
+// with a transient error with an exponential backoff and stops calling the Mirror node, while it keeps failing
+type transport struct {
+	httpClient client.HttpClient
+	limiter    *limiter
+	breaker    *breaker
+	maxRetries int
+	retryDelay time.Duration
+	sleep      func(ctx context.Context, duration time.Duration) bool
+
+	retriesCounter prometheus.Counter
+	errorsCounter  prometheus.Counter
+	logger         *log.Entry
+}
+
+func newTransport(httpClient client.HttpClient, maxRetries int, retryDelay time.Duration, rateLimit float64, breakerThreshold int, breakerCooldown time.Duration, prometheusService service.Prometheus, logger *log.Entry) *transport {
+	t := &transport{
+		httpClient: httpClient,
+		limiter:    newLimiter(rateLimit),
+		breaker:    newBreaker(breakerThreshold, breakerCooldown, logger),
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+		sleep:      wait.Sleep,
+		logger:     logger,
+	}
+
+	if prometheusService != nil && prometheusService.GetIsMonitoringEnabled() {
+		t.retriesCounter = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.MirrorNodeRetriesName,
+			Help: constants.MirrorNodeRetriesHelp,
+		})
+		t.errorsCounter = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.MirrorNodeErrorsName,
+			Help: constants.MirrorNodeErrorsHelp,
+		})
+		if t.breaker != nil {
+			t.breaker.openGauge = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+				Name: constants.MirrorNodeCircuitOpenName,
+				Help: constants.MirrorNodeCircuitOpenHelp,
+			})
+			t.breaker.openGauge.Set(0)
+		}
+	}
+
+	return t
+}
+
+// Do sends the given request. Requests failing with a transient error are retried up to maxRetries times. The response
+// of the last attempt is returned, so that its status is handled by the caller. Waiting and retrying stop once the
+// context of the request is done
+func (t *transport) Do(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			return nil, errCircuitOpen
+		}
+		if !t.limiter.wait(ctx) {
+			t.breaker.abort()
+			return nil, ctx.Err()
+		}
+
+		response, err := t.httpClient.Do(request)
+		if err != nil && ctx.Err() != nil {
+			// A cancelled request says nothing about the health of the Mirror node
+			t.breaker.abort()
+			return nil, ctx.Err()
+		}
+		transient := isTransient(response, err)
+		t.breaker.record(!transient)
+		if !transient {
+			return response, nil
+		}
+
+		if t.errorsCounter != nil {
+			t.errorsCounter.Inc()
+		}
+		if attempt >= t.maxRetries {
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", err, client.ErrUnavailable)
+			}
+			return response, nil
+		}
+
+		delay := t.backoff(attempt, response)
+		if err != nil {
+			t.logger.Warnf("Request failed, retrying in [%s]. Error: [%s]", delay, err)
+		} else {
+			t.logger.Warnf("Request ended with Status Code [%d], retrying in [%s].", response.StatusCode, delay)
+			response.Body.Close()
+		}
+		if t.retriesCounter != nil {
+			t.retriesCounter.Inc()
+		}
+		if !t.sleep(ctx, delay) {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// isTransient returns whether the request failed due to a timeout, a connection error, rate limiting or a server error
+func isTransient(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before the next attempt, doubling the retry delay with every attempt. The delay is
+// randomised between its half and its full value, so that the retries of concurrent requests are spread. A longer
+// delay, requested by the Mirror node through the Retry-After header, is respected
+func (t *transport) backoff(attempt int, response *http.Response) time.Duration {
+	delay := t.retryDelay
+	for i := 0; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	if delay > 0 {
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+
+	if response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+			if retryAfter := time.Duration(seconds) * time.Second; retryAfter > delay {
+				delay = retryAfter
+			}
+		}
+	}
+	return delay
+}
+
+// limiter spaces the requests evenly, so that at most the given number of requests per second are sent
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newLimiter returns nil, if the rate is not positive. Requests are not limited then
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next request may be sent. Returns false if the given context is done first
+func (l *limiter) wait(ctx context.Context) bool {
+	if l == nil {
+		return ctx.Err() == nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	return wait.Sleep(ctx, delay)
+}
+
+// breaker opens after the given number of consecutive transient failures and rejects all requests for the cooldown.
+// Afterwards a single request is let through. The breaker closes, if it succeeds, and opens again otherwise
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	open      bool
+	probing   bool
+	openedAt  time.Time
+	now       func() time.Time
+	openGauge prometheus.Gauge
+	logger    *log.Entry
+}
+
+// newBreaker returns nil, if the threshold is not positive. The breaker never opens then
+func newBreaker(threshold int, cooldown time.Duration, logger *log.Entry) *breaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		logger:    logger,
+	}
+}
+
+// allow returns whether a request may be sent
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record records the outcome of a request, which was allowed
+func (b *breaker) record(success bool) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		if b.open {
+			b.logger.Infof("Mirror node recovered. Circuit breaker closed.")
+			b.setOpen(false)
+		}
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.open || b.failures >= b.threshold {
+		if !b.open {
+			b.logger.Warnf("Mirror node failed [%d] consecutive times. Circuit breaker open for [%s].", b.failures, b.cooldown)
+		}
+		b.openedAt = b.now()
+		b.setOpen(true)
+	}
+}
+
+// abort releases the probe of a request, which was allowed but cancelled before its outcome was known
+func (b *breaker) abort() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// setOpen must be called while holding the lock of the breaker
+func (b *breaker) setOpen(open bool) {
+	b.open = open
+	if b.openGauge == nil {
+		return
+	}
+	if open {
+		b.openGauge.Set(1)
+	} else {
+		b.openGauge.Set(0)
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror_node
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func setupTransport(maxRetries, breakerThreshold int) (*transport, *[]time.Duration) {
+	mocks.Setup()
+	t := newTransport(mocks.MHTTPClient, maxRetries, time.Second, 0, breakerThreshold, time.Minute, nil, logger)
+	var delays []time.Duration
+	t.sleep = func(ctx context.Context, d time.Duration) bool {
+		delays = append(delays, d)
+		return true
+	}
+	return t, &delays
+}
+
+func get(t *transport, query string) (*http.Response, error) {
+	return getWithContext(context.Background(), t, query)
+}
+
+func getWithContext(ctx context.Context, t *transport, query string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	return t.Do(request)
+}
+
+func Test_Transport_RetriesServerErrors(t *testing.T) {
+	transport, delays := setupTransport(3, 0)
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusServiceUnavailable, ""), nil).Once()
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusOK, "{}"), nil).Once()
+
+	response, err := get(transport, "query")
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 2)
+	assert.Len(t, *delays, 1)
+}
+
+func Test_Transport_BacksOffExponentially(t *testing.T) {
+	transport, delays := setupTransport(3, 0)
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusInternalServerError, ""), nil)
+
+	response, err := get(transport, "query")
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 4)
+	assert.Len(t, *delays, 3)
+	for i, delay := range *delays {
+		max := time.Second << i
+		assert.True(t, delay >= max/2 && delay <= max, "delay [%s] of retry [%d]", delay, i)
+	}
+}
+
+func Test_Transport_RespectsRetryAfter(t *testing.T) {
+	transport, delays := setupTransport(1, 0)
+	rateLimited := jsonResponse(http.StatusTooManyRequests, "")
+	rateLimited.Header = http.Header{"Retry-After": []string{"10"}}
+	mocks.MHTTPClient.On("Do", "query").Return(rateLimited, nil).Once()
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusOK, "{}"), nil).Once()
+
+	_, err := get(transport, "query")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Duration{10 * time.Second}, *delays)
+}
+
+func Test_Transport_ConnectionErrorIsUnavailable(t *testing.T) {
+	transport, _ := setupTransport(2, 0)
+	mocks.MHTTPClient.On("Do", "query").Return(nil, errors.New("connection refused"))
+
+	response, err := get(transport, "query")
+
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, client.ErrUnavailable))
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 3)
+}
+
+func Test_Transport_StopsRetryingOnCancel(t *testing.T) {
+	transport, delays := setupTransport(3, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MHTTPClient.On("Do", "query").Run(func(mock.Arguments) { cancel() }).Return(nil, context.Canceled)
+
+	response, err := getWithContext(ctx, transport, "query")
+
+	assert.Nil(t, response)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, *delays)
+	assert.True(t, transport.breaker.allow())
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 1)
+}
+
+func Test_Transport_DoesNotRetryNotFound(t *testing.T) {
+	transport, delays := setupTransport(3, 0)
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusNotFound, ""), nil)
+
+	response, err := get(transport, "query")
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 1)
+	assert.Empty(t, *delays)
+}
+
+func Test_Transport_CircuitBreaker(t *testing.T) {
+	transport, _ := setupTransport(0, 2)
+	now := time.Now()
+	transport.breaker.now = func() time.Time {
+		return now
+	}
+	mocks.MHTTPClient.On("Do", "query").Return(nil, errors.New("timeout")).Twice()
+
+	get(transport, "query")
+	get(transport, "query")
+	response, err := get(transport, "query")
+
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, client.ErrUnavailable))
+	mocks.MHTTPClient.AssertNumberOfCalls(t, "Do", 2)
+
+	now = now.Add(time.Minute)
+	mocks.MHTTPClient.On("Do", "query").Return(jsonResponse(http.StatusOK, "{}"), nil)
+
+	response, err = get(transport, "query")
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.False(t, transport.breaker.open)
+}
+
+func Test_Breaker_ReopensAfterFailedProbe(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(1, time.Minute, logger)
+	b.now = func() time.Time {
+		return now
+	}
+
+	b.record(false)
+	assert.False(t, b.allow())
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+
+	b.record(false)
+	assert.False(t, b.allow())
+}
+
+func Test_Limiter(t *testing.T) {
+	l := newLimiter(100)
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		l.wait(context.Background())
+	}
+
+	assert.True(t, time.Since(start) >= 40*time.Millisecond)
+	assert.Nil(t, newLimiter(0))
+}
+
+func Test_CheckStatus(t *testing.T) {
+	assert.Nil(t, checkStatus("query", jsonResponse(http.StatusOK, "")))
+	assert.True(t, errors.Is(checkStatus("query", jsonResponse(http.StatusNotFound, "")), client.ErrNotFound))
+	assert.True(t, errors.Is(checkStatus("query", jsonResponse(http.StatusTooManyRequests, "")), client.ErrUnavailable))
+	assert.True(t, errors.Is(checkStatus("query", jsonResponse(http.StatusBadGateway, "")), client.ErrUnavailable))
+
+	err := checkStatus("query", jsonResponse(http.StatusBadRequest, ""))
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, client.ErrNotFound))
+	assert.False(t, errors.Is(err, client.ErrUnavailable))
+}
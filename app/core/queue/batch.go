@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package queue
+
+import "sync"
+
+// Batch collects pushed messages, so that they can be committed to a Queue
+// together with the progress of the watcher which produced them
+type Batch struct {
+	mutex    sync.Mutex
+	messages []*Message
+}
+
+// Push appends a message to the batch. Safe for concurrent use.
+func (b *Batch) Push(message *Message) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.messages = append(b.messages, message)
+}
+
+// Messages returns the messages pushed so far
+func (b *Batch) Messages() []*Message {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.messages
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+)
+
+// Payload types of the persisted messages
+const (
+	TransferPayload     = "TRANSFER"
+	TopicMessagePayload = "TOPIC_MESSAGE"
+)
+
+// topicMessage is the persisted representation of a message.Message,
+// as the protobuf oneof field cannot be marshalled to JSON directly
+type topicMessage struct {
+	Message              []byte
+	TransactionTimestamp int64
+}
+
+// EncodePayload serializes the given payload, returning its type and contents
+func EncodePayload(payload interface{}) (string, string, error) {
+	switch p := payload.(type) {
+	case *transfer.Transfer:
+		bytes, err := json.Marshal(p)
+		return TransferPayload, string(bytes), err
+	case *message.Message:
+		msg, err := p.ToBytes()
+		if err != nil {
+			return "", "", err
+		}
+		bytes, err := json.Marshal(topicMessage{Message: msg, TransactionTimestamp: p.TransactionTimestamp})
+		return TopicMessagePayload, string(bytes), err
+	default:
+		return "", "", errors.New(fmt.Sprintf("unsupported payload type [%T]", payload))
+	}
+}
+
+// DecodePayload deserializes payload contents of the given type
+func DecodePayload(payloadType, contents string) (interface{}, error) {
+	switch payloadType {
+	case TransferPayload:
+		payload := &transfer.Transfer{}
+		err := json.Unmarshal([]byte(contents), payload)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case TopicMessagePayload:
+		var msg topicMessage
+		err := json.Unmarshal([]byte(contents), &msg)
+		if err != nil {
+			return nil, err
+		}
+		return message.FromBytesWithTS(msg.Message, msg.TransactionTimestamp)
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported payload type [%s]", payloadType))
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package queue
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// The maximum amount of messages leased from the database at once
+const leaseBatchSize = 10
+
+// The default duration for which a delivered message is hidden from redelivery.
+// It must exceed the time handlers take to process a message.
+const defaultVisibilityTimeout = 10 * time.Minute
+
+// The default interval at which the database is polled when there are no available messages
+const defaultPollingInterval = time.Second
+
+// Persistent is a Queue backed by the database. Pushed messages survive restarts and are
+// delivered to the channel under a lease. Messages which are not acknowledged before their
+// visibility timeout expires are delivered again.
+type Persistent struct {
+	repository        repository.Queue
+	channel           chan *Message
+	visibilityTimeout time.Duration
+	pollingInterval   time.Duration
+	maxAttempts       int
+	retryDelay        time.Duration
+	logger            *log.Entry
+}
+
+// NewPersistentQueue creates a Persistent queue and starts delivering the stored messages until the given context is done.
+// Leases held by a previous run of the node are released, so that its unfinished work is resumed immediately.
+func NewPersistentQueue(ctx context.Context, repository repository.Queue, visibilityTimeout, pollingInterval time.Duration, maxAttempts int, retryDelay time.Duration) *Persistent {
+	if visibilityTimeout == 0 {
+		visibilityTimeout = defaultVisibilityTimeout
+	}
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+	if maxAttempts == 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if retryDelay == 0 {
+		retryDelay = defaultRetryDelay
+	}
+
+	q := &Persistent{
+		repository:        repository,
+		channel:           make(chan *Message),
+		visibilityTimeout: visibilityTimeout,
+		pollingInterval:   pollingInterval,
+		maxAttempts:       maxAttempts,
+		retryDelay:        retryDelay,
+		logger:            config.GetLoggerFor("Persistent Queue"),
+	}
+
+	err := repository.ReleaseAll()
+	if err != nil {
+		q.logger.Fatalf("Failed to release previously leased messages. Error: [%s]", err)
+	}
+
+	go q.deliver(ctx)
+
+	return q
+}
+
+// Push persists a message for delivery
+func (q *Persistent) Push(ctx context.Context, message *Message) error {
+	record, err := q.toEntity(message)
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to encode message payload. Error: [%s]", message.Topic, err)
+		return err
+	}
+
+	err = q.repository.Create([]*entity.QueueMessage{record})
+	if err != nil {
+		q.logger.Errorf("[%s] - Failed to persist message. Error: [%s]", message.Topic, err)
+	}
+	return err
+}
+
+// Commit persists the given messages and updates the Status of the given entity in a single transaction
+func (q *Persistent) Commit(ctx context.Context, entityID string, timestampOrBlockNumber int64, messages ...*Message) error {
+	records := make([]*entity.QueueMessage, 0, len(messages))
+	for _, message := range messages {
+		record, err := q.toEntity(message)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+
+	return q.repository.CreateWithStatus(entityID, timestampOrBlockNumber, records)
+}
+
+// Ack removes a handled message, so that it is not delivered again
+func (q *Persistent) Ack(message *Message) {
+	err := q.repository.Delete(message.ID)
+	if err != nil {
+		q.logger.Errorf("[%d] - Failed to acknowledge message. Error: [%s]", message.ID, err)
+	}
+}
+
+// Nack delays the next delivery of a failed message with a backoff. Messages which have reached
+// the maximum attempts are moved to the dead letters instead.
+func (q *Persistent) Nack(message *Message, err error) {
+	if message.Attempts < q.maxAttempts {
+		delayErr := q.repository.Delay(message.ID, backoff(q.retryDelay, message.Attempts))
+		if delayErr != nil {
+			q.logger.Errorf("[%d] - Failed to delay message redelivery. Error: [%s]", message.ID, delayErr)
+		}
+		return
+	}
+
+	deadLetterErr := q.repository.DeadLetter(message.ID, err.Error())
+	if deadLetterErr != nil {
+		q.logger.Errorf("[%d] - Failed to move message to the dead letters. Error: [%s]", message.ID, deadLetterErr)
+		return
+	}
+	q.logger.Warnf("[%d] - Moved message for [%s] to the dead letters after [%d] attempts.", message.ID, message.Topic, message.Attempts)
+}
+
+// Release makes the leased message available again without counting its delivery as an attempt
+func (q *Persistent) Release(message *Message) {
+	q.release(message.ID)
+}
+
+// Replay moves the dead letter back to the stored messages in a single transaction
+func (q *Persistent) Replay(deadLetter *entity.DeadLetter) error {
+	return q.repository.Replay(deadLetter.ID)
+}
+
+// Resume moves the paused message back to the stored messages in a single transaction
+func (q *Persistent) Resume(message *entity.PausedMessage) error {
+	return q.repository.Resume(message.TransferID)
+}
+
+func (q *Persistent) Channel() chan *Message {
+	return q.channel
+}
+
+// deliver leases the stored messages and delivers them to the channel until the given context is done.
+// Messages which are leased but not delivered by then are released, so that they are delivered on the next start.
+func (q *Persistent) deliver(ctx context.Context) {
+	for ctx.Err() == nil {
+		records, err := q.repository.Lease(leaseBatchSize, q.visibilityTimeout)
+		if err != nil {
+			q.logger.Errorf("Failed to lease messages. Error: [%s]", err)
+			wait.Sleep(ctx, q.pollingInterval)
+			continue
+		}
+
+		if len(records) == 0 {
+			wait.Sleep(ctx, q.pollingInterval)
+			continue
+		}
+
+		for i, record := range records {
+			payload, err := DecodePayload(record.PayloadType, record.Payload)
+			if err != nil {
+				q.logger.Errorf("[%d] - Failed to decode message payload. Error: [%s]", record.ID, err)
+				err = q.repository.DeadLetter(record.ID, err.Error())
+				if err != nil {
+					q.logger.Errorf("[%d] - Failed to move message to the dead letters. Error: [%s]", record.ID, err)
+				}
+				continue
+			}
+
+			if record.Attempts > 1 {
+				q.logger.Infof("[%d] - Redelivering message for [%s]. Attempt [%d]", record.ID, record.Topic, record.Attempts)
+			}
+
+			message := &Message{
+				Payload:  payload,
+				Topic:    record.Topic,
+				ID:       record.ID,
+				Attempts: record.Attempts,
+			}
+			select {
+			case q.channel <- message:
+			case <-ctx.Done():
+				for _, undelivered := range records[i:] {
+					q.release(undelivered.ID)
+				}
+				return
+			}
+		}
+	}
+}
+
+// release makes a leased message available again without counting the lease as an attempt
+func (q *Persistent) release(id uint64) {
+	err := q.repository.Release(id)
+	if err != nil {
+		q.logger.Errorf("[%d] - Failed to release message. Error: [%s]", id, err)
+	}
+}
+
+func (q *Persistent) toEntity(message *Message) (*entity.QueueMessage, error) {
+	payloadType, payload, err := EncodePayload(message.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.QueueMessage{
+		Topic:       message.Topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+	}, nil
+}
@@ -0,0 +1,292 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package queue_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	mockRepository  *repository.MockQueueRepository
+	transferPayload = transfer.New(
+		"0.0.123123-123321-420",
+		0,
+		1,
+		0,
+		"0xreceiver",
+		"0.0.123",
+		"0xwrapped00123",
+		"0.0.123",
+		"100")
+	topicMessagePayload = &message.Message{
+		TopicMessage: message.NewFungibleSignature(&proto.TopicEthSignatureMessage{
+			SourceChainId: 0,
+			TargetChainId: 1,
+			TransferID:    "0.0.123123-123321-420",
+			Asset:         "0xwrapped00123",
+			Recipient:     "0xreceiver",
+			Amount:        "100",
+			Signature:     "signature",
+		}).TopicMessage,
+		TransactionTimestamp: 1633633534108746000,
+	}
+)
+
+func Test_EncodeDecodeTransfer(t *testing.T) {
+	payloadType, contents, err := EncodePayload(transferPayload)
+	assert.Nil(t, err)
+	assert.Equal(t, TransferPayload, payloadType)
+
+	decoded, err := DecodePayload(payloadType, contents)
+	assert.Nil(t, err)
+	assert.Equal(t, transferPayload, decoded)
+}
+
+func Test_EncodeDecodeTopicMessage(t *testing.T) {
+	payloadType, contents, err := EncodePayload(topicMessagePayload)
+	assert.Nil(t, err)
+	assert.Equal(t, TopicMessagePayload, payloadType)
+
+	decoded, err := DecodePayload(payloadType, contents)
+	assert.Nil(t, err)
+	actual := decoded.(*message.Message)
+	assert.Equal(t, topicMessagePayload.TransactionTimestamp, actual.TransactionTimestamp)
+	assert.Equal(t, topicMessagePayload.GetFungibleSignatureMessage().String(), actual.GetFungibleSignatureMessage().String())
+}
+
+func Test_EncodePayload_UnsupportedType(t *testing.T) {
+	_, _, err := EncodePayload("unsupported")
+	assert.NotNil(t, err)
+}
+
+func Test_DecodePayload_UnsupportedType(t *testing.T) {
+	_, err := DecodePayload("unsupported", "{}")
+	assert.NotNil(t, err)
+}
+
+func Test_Persistent_Commit(t *testing.T) {
+	q := setupPersistent()
+	_, contents, _ := EncodePayload(transferPayload)
+	expected := []*entity.QueueMessage{
+		{
+			Topic:       constants.HederaTransferMessageSubmission,
+			PayloadType: TransferPayload,
+			Payload:     contents,
+		},
+	}
+	mockRepository.On("CreateWithStatus", "0.0.444444", int64(100), expected).Return(nil)
+
+	err := q.Commit(context.Background(), "0.0.444444", 100, &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission})
+
+	assert.Nil(t, err)
+	mockRepository.AssertCalled(t, "CreateWithStatus", "0.0.444444", int64(100), expected)
+}
+
+func Test_Persistent_Commit_RepositoryFails(t *testing.T) {
+	q := setupPersistent()
+	expectedErr := errors.New("some-error")
+	mockRepository.On("CreateWithStatus", "0.0.444444", int64(100), []*entity.QueueMessage{}).Return(expectedErr)
+
+	err := q.Commit(context.Background(), "0.0.444444", 100)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Persistent_Commit_UnsupportedPayload(t *testing.T) {
+	q := setupPersistent()
+
+	err := q.Commit(context.Background(), "0.0.444444", 100, &Message{Payload: "unsupported", Topic: constants.HederaTransferMessageSubmission})
+
+	assert.NotNil(t, err)
+	mockRepository.AssertNotCalled(t, "CreateWithStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Persistent_Push(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Create", mock.Anything).Return(nil)
+
+	err := q.Push(context.Background(), &Message{Payload: topicMessagePayload, Topic: constants.TopicMessageValidation})
+
+	assert.Nil(t, err)
+	mockRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_Persistent_Push_RepositoryFails(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Create", mock.Anything).Return(errors.New("some-error"))
+
+	err := q.Push(context.Background(), &Message{Payload: topicMessagePayload, Topic: constants.TopicMessageValidation})
+
+	assert.NotNil(t, err)
+}
+
+func Test_Persistent_Release(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Release", uint64(5)).Return(nil)
+
+	q.Release(&Message{ID: 5, Attempts: 2})
+
+	mockRepository.AssertCalled(t, "Release", uint64(5))
+}
+
+func Test_Persistent_Ack(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Delete", uint64(5)).Return(nil)
+
+	q.Ack(&Message{ID: 5})
+
+	mockRepository.AssertCalled(t, "Delete", uint64(5))
+}
+
+func Test_Persistent_Replay(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Replay", uint64(5)).Return(nil)
+
+	err := q.Replay(&entity.DeadLetter{ID: 5})
+
+	assert.Nil(t, err)
+	mockRepository.AssertCalled(t, "Replay", uint64(5))
+}
+
+func Test_Persistent_Resume(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Resume", "0.0.123123-123321-420").Return(nil)
+
+	err := q.Resume(&entity.PausedMessage{TransferID: "0.0.123123-123321-420"})
+
+	assert.Nil(t, err)
+	mockRepository.AssertCalled(t, "Resume", "0.0.123123-123321-420")
+}
+
+func Test_Persistent_Deliver(t *testing.T) {
+	mockRepository = &repository.MockQueueRepository{}
+	_, contents, _ := EncodePayload(transferPayload)
+	record := &entity.QueueMessage{
+		ID:          7,
+		Topic:       constants.HederaTransferMessageSubmission,
+		PayloadType: TransferPayload,
+		Payload:     contents,
+		Attempts:    1,
+	}
+	mockRepository.On("ReleaseAll").Return(nil)
+	mockRepository.On("Lease", mock.Anything, time.Minute).Return([]*entity.QueueMessage{record}, nil).Once()
+	mockRepository.On("Lease", mock.Anything, time.Minute).Return([]*entity.QueueMessage{}, nil)
+
+	q := NewPersistentQueue(context.Background(), mockRepository, time.Minute, time.Millisecond, 3, time.Second)
+
+	select {
+	case delivered := <-q.Channel():
+		assert.Equal(t, &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission, ID: 7, Attempts: 1}, delivered)
+	case <-time.After(time.Second):
+		t.Fatal("Expected message to be delivered")
+	}
+	mockRepository.AssertCalled(t, "ReleaseAll")
+}
+
+func Test_Persistent_Deliver_UndecodablePayload(t *testing.T) {
+	mockRepository = &repository.MockQueueRepository{}
+	record := &entity.QueueMessage{
+		ID:          7,
+		Topic:       constants.HederaTransferMessageSubmission,
+		PayloadType: "unsupported",
+		Payload:     "{}",
+		Attempts:    1,
+	}
+	deadLettered := make(chan bool, 1)
+	mockRepository.On("ReleaseAll").Return(nil)
+	mockRepository.On("Lease", mock.Anything, time.Minute).Return([]*entity.QueueMessage{record}, nil).Once()
+	mockRepository.On("Lease", mock.Anything, time.Minute).Return([]*entity.QueueMessage{}, nil)
+	mockRepository.On("DeadLetter", uint64(7), mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		deadLettered <- true
+	})
+
+	NewPersistentQueue(context.Background(), mockRepository, time.Minute, time.Millisecond, 3, time.Second)
+
+	select {
+	case <-deadLettered:
+	case <-time.After(time.Second):
+		t.Fatal("Expected message to be dead-lettered")
+	}
+}
+
+func Test_Persistent_Deliver_StopsWhenDone(t *testing.T) {
+	mockRepository = &repository.MockQueueRepository{}
+	_, contents, _ := EncodePayload(transferPayload)
+	records := []*entity.QueueMessage{
+		{ID: 7, Topic: constants.HederaTransferMessageSubmission, PayloadType: TransferPayload, Payload: contents, Attempts: 1},
+		{ID: 8, Topic: constants.HederaTransferMessageSubmission, PayloadType: TransferPayload, Payload: contents, Attempts: 1},
+	}
+	released := make(chan uint64, 2)
+	mockRepository.On("ReleaseAll").Return(nil)
+	mockRepository.On("Lease", mock.Anything, time.Minute).Return(records, nil).Once()
+	mockRepository.On("Release", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		released <- args.Get(0).(uint64)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	q := NewPersistentQueue(ctx, mockRepository, time.Minute, time.Millisecond, 3, time.Second)
+	<-q.Channel()
+	cancel()
+
+	select {
+	case id := <-released:
+		assert.Equal(t, uint64(8), id)
+	case <-time.After(time.Second):
+		t.Fatal("Expected the undelivered message to be released")
+	}
+	mockRepository.AssertNumberOfCalls(t, "Lease", 1)
+}
+
+func Test_Persistent_Nack_DelaysRedelivery(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("Delay", uint64(5), mock.Anything).Return(nil)
+
+	q.Nack(&Message{ID: 5, Attempts: 1}, errors.New("some-error"))
+	q.Nack(&Message{ID: 5, Attempts: 2}, errors.New("some-error"))
+
+	mockRepository.AssertCalled(t, "Delay", uint64(5), time.Second)
+	mockRepository.AssertCalled(t, "Delay", uint64(5), 2*time.Second)
+	mockRepository.AssertNotCalled(t, "DeadLetter", mock.Anything, mock.Anything)
+}
+
+func Test_Persistent_Nack_MaxAttempts(t *testing.T) {
+	q := setupPersistent()
+	mockRepository.On("DeadLetter", uint64(5), "some-error").Return(nil)
+
+	q.Nack(&Message{ID: 5, Attempts: 3}, errors.New("some-error"))
+
+	mockRepository.AssertCalled(t, "DeadLetter", uint64(5), "some-error")
+	mockRepository.AssertNotCalled(t, "Delay", mock.Anything, mock.Anything)
+}
+
+func setupPersistent() *Persistent {
+	mockRepository = &repository.MockQueueRepository{}
+	mockRepository.On("ReleaseAll").Return(nil)
+	mockRepository.On("Lease", mock.Anything, mock.Anything).Return([]*entity.QueueMessage{}, nil)
+	return NewPersistentQueue(context.Background(), mockRepository, time.Minute, time.Second, 3, time.Second)
+}
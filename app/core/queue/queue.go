@@ -16,26 +16,148 @@
 
 package queue
 
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
 type Message struct {
 	Payload interface{}
 	Topic   string
+	// ID identifies the message in persistent queues. Always 0 for in-memory queues.
+	ID uint64
+	// Attempts is the number of times the message has been delivered, including the current delivery
+	Attempts int
 }
 
 // Queue is a wrapper of a go channel, particularly to restrict actions on the channel itself
 type Queue struct {
-	channel chan *Message
+	// ctx bounds the pushes of the queue itself, such as the retries of failed messages
+	ctx                  context.Context
+	channel              chan *Message
+	statusRepository     repository.Status
+	deadLetterRepository repository.DeadLetter
+	pausedRepository     repository.PausedMessage
+	maxAttempts          int
+	retryDelay           time.Duration
+	logger               *log.Entry
+}
+
+// Push pushes a message to the channel. Returns the error of the given context if it is done
+// before the message is received.
+func (q *Queue) Push(ctx context.Context, message *Message) error {
+	message.Attempts++
+	select {
+	case q.channel <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Commit pushes the given messages to the channel and afterwards updates the Status of the given entity.
+// The Status is not updated if any of the messages is not pushed.
+// Messages that were pushed but not handled before a restart are lost.
+func (q *Queue) Commit(ctx context.Context, entityID string, timestampOrBlockNumber int64, messages ...*Message) error {
+	for _, message := range messages {
+		err := q.Push(ctx, message)
+		if err != nil {
+			return err
+		}
+	}
+	return q.statusRepository.Update(entityID, timestampOrBlockNumber)
+}
+
+// Ack is a no-op, as messages are removed from the channel upon receiving
+func (q *Queue) Ack(message *Message) {}
+
+// Release is a no-op, as messages of the in-memory queue are lost on restart
+func (q *Queue) Release(message *Message) {}
+
+// Nack pushes the message again after a backoff. Messages which have reached the maximum attempts
+// are persisted as dead letters instead.
+func (q *Queue) Nack(message *Message, err error) {
+	if message.Attempts < q.maxAttempts {
+		time.AfterFunc(backoff(q.retryDelay, message.Attempts), func() {
+			err := q.Push(q.ctx, message)
+			if err != nil {
+				q.logger.Warnf("[%s] - Dropped the retry of a failed message, as the queue is shut down.", message.Topic)
+			}
+		})
+		return
+	}
+
+	deadLetter, encodeErr := newDeadLetter(message, err.Error())
+	if encodeErr != nil {
+		q.logger.Errorf("[%s] - Failed to encode message payload. Error: [%s]", message.Topic, encodeErr)
+		return
+	}
+
+	createErr := q.deadLetterRepository.Create(deadLetter)
+	if createErr != nil {
+		q.logger.Errorf("[%s] - Failed to persist dead letter. Error: [%s]", message.Topic, createErr)
+		return
+	}
+	q.logger.Warnf("[%s] - Moved message to the dead letters after [%d] attempts.", message.Topic, message.Attempts)
 }
 
-// Push pushes a message to the channel
-func (q *Queue) Push(message *Message) {
-	q.channel <- message
+// Replay pushes the payload of the dead letter to the channel and deletes the dead letter afterwards
+func (q *Queue) Replay(deadLetter *entity.DeadLetter) error {
+	payload, err := DecodePayload(deadLetter.PayloadType, deadLetter.Payload)
+	if err != nil {
+		return err
+	}
+
+	err = q.Push(q.ctx, &Message{Payload: payload, Topic: deadLetter.Topic})
+	if err != nil {
+		return err
+	}
+
+	return q.deadLetterRepository.Delete(deadLetter.ID)
+}
+
+// Resume pushes the payload of the paused message to the channel and deletes the paused message afterwards
+func (q *Queue) Resume(message *entity.PausedMessage) error {
+	payload, err := DecodePayload(message.PayloadType, message.Payload)
+	if err != nil {
+		return err
+	}
+
+	err = q.Push(q.ctx, &Message{Payload: payload, Topic: message.Topic})
+	if err != nil {
+		return err
+	}
+
+	return q.pausedRepository.Delete(message.TransferID)
 }
 
 func (q *Queue) Channel() chan *Message {
 	return q.channel
 }
 
-func NewQueue() *Queue {
+// NewQueue creates an in-memory Queue. Once the given context is done, the queue stops retrying failed messages
+// and rejects replayed and resumed messages, as nothing delivers them anymore.
+func NewQueue(ctx context.Context, statusRepository repository.Status, deadLetterRepository repository.DeadLetter, pausedRepository repository.PausedMessage, maxAttempts int, retryDelay time.Duration) *Queue {
+	if maxAttempts == 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	if retryDelay == 0 {
+		retryDelay = defaultRetryDelay
+	}
+
 	ch := make(chan *Message)
-	return &Queue{channel: ch}
+	return &Queue{
+		ctx:                  ctx,
+		channel:              ch,
+		statusRepository:     statusRepository,
+		deadLetterRepository: deadLetterRepository,
+		pausedRepository:     pausedRepository,
+		maxAttempts:          maxAttempts,
+		retryDelay:           retryDelay,
+		logger:               config.GetLoggerFor("Queue"),
+	}
 }
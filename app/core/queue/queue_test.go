@@ -0,0 +1,160 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	mockStatusRepository        *repository.MockStatusRepository
+	mockDeadLetterRepository    *repository.MockDeadLetterRepository
+	mockPausedMessageRepository *repository.MockPausedMessageRepository
+)
+
+func Test_Queue_Push(t *testing.T) {
+	q := setupQueue()
+	message := &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission}
+
+	go q.Push(context.Background(), message)
+
+	assert.Equal(t, 1, (<-q.Channel()).Attempts)
+}
+
+func Test_Queue_Push_Cancelled(t *testing.T) {
+	q := setupQueue()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := q.Push(ctx, &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission})
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func Test_Queue_Commit_Cancelled(t *testing.T) {
+	q := setupQueue()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := q.Commit(ctx, "0.0.444444", 100, &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission})
+
+	assert.Equal(t, context.Canceled, err)
+	mockStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_Queue_Nack_Redelivers(t *testing.T) {
+	q := setupQueue()
+	message := &Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission, Attempts: 1}
+
+	q.Nack(message, errors.New("some-error"))
+
+	select {
+	case redelivered := <-q.Channel():
+		assert.Equal(t, message, redelivered)
+		assert.Equal(t, 2, redelivered.Attempts)
+	case <-time.After(time.Second):
+		t.Fatal("Expected message to be redelivered")
+	}
+	mockDeadLetterRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Queue_Nack_AfterShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := setupQueueWithContext(ctx)
+	cancel()
+
+	q.Nack(&Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission, Attempts: 1}, errors.New("some-error"))
+	// The retry must give up instead of waiting for a receiver, which never comes after shutdown
+	time.Sleep(50 * time.Millisecond)
+
+	select {
+	case <-q.Channel():
+		t.Fatal("Expected the retry to be dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func Test_Queue_Nack_MaxAttempts(t *testing.T) {
+	q := setupQueue()
+	_, contents, _ := EncodePayload(transferPayload)
+	mockDeadLetterRepository.On("Create", mock.Anything).Return(nil)
+
+	q.Nack(&Message{Payload: transferPayload, Topic: constants.HederaTransferMessageSubmission, Attempts: 3}, errors.New("some-error"))
+
+	deadLetter := mockDeadLetterRepository.Calls[0].Arguments.Get(0).(*entity.DeadLetter)
+	assert.Equal(t, constants.HederaTransferMessageSubmission, deadLetter.Topic)
+	assert.Equal(t, TransferPayload, deadLetter.PayloadType)
+	assert.Equal(t, contents, deadLetter.Payload)
+	assert.Equal(t, "some-error", deadLetter.Error)
+	assert.Equal(t, 3, deadLetter.Attempts)
+}
+
+func Test_Queue_Replay(t *testing.T) {
+	q := setupQueue()
+	payloadType, contents, _ := EncodePayload(transferPayload)
+	mockDeadLetterRepository.On("Delete", uint64(5)).Return(nil)
+
+	go q.Replay(&entity.DeadLetter{ID: 5, Topic: constants.HederaTransferMessageSubmission, PayloadType: payloadType, Payload: contents})
+
+	replayed := <-q.Channel()
+	assert.Equal(t, transferPayload, replayed.Payload)
+	assert.Equal(t, 1, replayed.Attempts)
+}
+
+func Test_Queue_Replay_UndecodablePayload(t *testing.T) {
+	q := setupQueue()
+
+	err := q.Replay(&entity.DeadLetter{ID: 5, PayloadType: "unsupported"})
+
+	assert.NotNil(t, err)
+	mockDeadLetterRepository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func Test_Queue_Resume(t *testing.T) {
+	q := setupQueue()
+	payloadType, contents, _ := EncodePayload(transferPayload)
+	deleted := make(chan bool, 1)
+	mockPausedMessageRepository.On("Delete", "0.0.123123-123321-420").Return(nil).Run(func(args mock.Arguments) {
+		deleted <- true
+	})
+
+	go q.Resume(&entity.PausedMessage{TransferID: "0.0.123123-123321-420", Topic: constants.HederaTransferMessageSubmission, PayloadType: payloadType, Payload: contents})
+
+	resumed := <-q.Channel()
+	assert.Equal(t, transferPayload, resumed.Payload)
+	<-deleted
+}
+
+func setupQueue() *Queue {
+	return setupQueueWithContext(context.Background())
+}
+
+func setupQueueWithContext(ctx context.Context) *Queue {
+	mockStatusRepository = &repository.MockStatusRepository{}
+	mockDeadLetterRepository = &repository.MockDeadLetterRepository{}
+	mockPausedMessageRepository = &repository.MockPausedMessageRepository{}
+	return NewQueue(ctx, mockStatusRepository, mockDeadLetterRepository, mockPausedMessageRepository, 3, time.Millisecond)
+}
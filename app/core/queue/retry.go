@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"time"
+)
+
+// The default amount of deliveries after which a failing message is moved to the dead letters
+const defaultMaxAttempts = 5
+
+// The default delay before a failed message is delivered again. It doubles with every following attempt.
+const defaultRetryDelay = 30 * time.Second
+
+// The upper bound of the delay between two deliveries of a failing message
+const maxRetryDelay = 30 * time.Minute
+
+// backoff returns the delay before the next delivery of a message which failed on the given attempt
+func backoff(retryDelay time.Duration, attempts int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func newDeadLetter(message *Message, reason string) (*entity.DeadLetter, error) {
+	payloadType, payload, err := EncodePayload(message.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.DeadLetter{
+		Topic:       message.Topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Error:       reason,
+		Attempts:    message.Attempts,
+		CreatedAt:   time.Now().UnixNano(),
+	}, nil
+}
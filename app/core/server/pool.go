@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pool handles the messages of a single topic with a fixed amount of workers.
+// Messages are buffered until a worker is available. Once the buffer is full, pushing blocks.
+type pool struct {
+	topic       string
+	handler     Handler
+	concurrency int
+	messages    chan *q.Message
+	queueDepth  prometheus.Gauge
+	inFlight    prometheus.Gauge
+	duration    prometheus.Histogram
+}
+
+func newPool(topic string, handler Handler, concurrency, bufferSize int, prometheusService service.Prometheus) *pool {
+	p := &pool{
+		topic:       topic,
+		handler:     handler,
+		concurrency: concurrency,
+		messages:    make(chan *q.Message, bufferSize),
+	}
+
+	if prometheusService.GetIsMonitoringEnabled() {
+		name := constants.HandlerMetricsNamePrefix + strings.ToLower(topic)
+		p.queueDepth = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name: name + constants.HandlerQueueDepthNameSuffix,
+			Help: constants.HandlerQueueDepthHelp,
+		})
+		p.inFlight = prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+			Name: name + constants.HandlerInFlightNameSuffix,
+			Help: constants.HandlerInFlightHelp,
+		})
+		p.duration = prometheusService.CreateHistogramIfNotExists(prometheus.HistogramOpts{
+			Name: name + constants.HandlerDurationNameSuffix,
+			Help: constants.HandlerDurationHelp,
+		})
+	}
+
+	return p
+}
+
+// start runs the workers of the pool, each executing the given function for the messages it receives.
+// Workers exit once the pool is stopped and its buffered messages are handled, marking the given WaitGroup as done.
+func (p *pool) start(ctx context.Context, workers *sync.WaitGroup, handle func(ctx context.Context, handler Handler, message *q.Message)) {
+	workers.Add(p.concurrency)
+	for i := 0; i < p.concurrency; i++ {
+		go func() {
+			defer workers.Done()
+			for message := range p.messages {
+				p.addToGauge(p.queueDepth, -1)
+				p.addToGauge(p.inFlight, 1)
+				start := time.Now()
+
+				handle(ctx, p.handler, message)
+
+				if p.duration != nil {
+					p.duration.Observe(time.Since(start).Seconds())
+				}
+				p.addToGauge(p.inFlight, -1)
+			}
+		}()
+	}
+}
+
+// push buffers the message for the workers, blocking while the buffer is full.
+// Returns false if the given context is done before the message is buffered.
+func (p *pool) push(ctx context.Context, message *q.Message) bool {
+	p.addToGauge(p.queueDepth, 1)
+	select {
+	case p.messages <- message:
+		return true
+	case <-ctx.Done():
+		p.addToGauge(p.queueDepth, -1)
+		return false
+	}
+}
+
+// stop prevents further pushes, letting the workers exit after handling the buffered messages
+func (p *pool) stop() {
+	close(p.messages)
+}
+
+func (p *pool) addToGauge(gauge prometheus.Gauge, value float64) {
+	if gauge != nil {
+		gauge.Add(value)
+	}
+}
@@ -17,34 +17,72 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/go-chi/chi"
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"sync"
+	"time"
 )
 
+// The default amount of workers handling the messages of a topic concurrently
+const defaultConcurrency = 10
+
+// The default amount of messages of a topic buffered while all of its workers are busy
+const defaultBufferSize = 100
+
+// The default maximum time for in-flight work to complete on shutdown
+const defaultShutdownTimeout = 30 * time.Second
+
+// The maximum time for cancelled handlers to return and release their messages after the shutdown timeout
+const releaseTimeout = 5 * time.Second
+
 type Watcher interface {
-	Watch(queue queue.Queue)
+	// Watch processes new events until the given context is done.
+	// The progress is persisted along the way, so that watching resumes from it on the next start.
+	Watch(ctx context.Context, queue queue.Queue)
 }
 
 type Handler interface {
-	Handle(interface{})
+	// Handle processes the given payload. A returned error marks the payload for redelivery.
+	// The given context is done once the node stops waiting for in-flight work on shutdown.
+	Handle(ctx context.Context, payload interface{}) error
 }
 
 type Server struct {
-	logger   *log.Entry
-	watchers []Watcher
-	handlers map[string]Handler
-	queue    queue.Queue
+	logger            *log.Entry
+	watchers          []Watcher
+	handlers          map[string]Handler
+	queue             queue.Queue
+	workers           config.Workers
+	shutdownTimeout   time.Duration
+	prometheusService service.Prometheus
 }
 
-func NewServer() *Server {
+func NewServer(queue queue.Queue, workers config.Workers, shutdownTimeout time.Duration, prometheusService service.Prometheus) *Server {
+	if workers.Concurrency == 0 {
+		workers.Concurrency = defaultConcurrency
+	}
+	if workers.BufferSize == 0 {
+		workers.BufferSize = defaultBufferSize
+	}
+	if shutdownTimeout == 0 {
+		shutdownTimeout = defaultShutdownTimeout
+	}
+
 	return &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    q.NewQueue(),
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		queue:             queue,
+		workers:           workers,
+		shutdownTimeout:   shutdownTimeout,
+		prometheusService: prometheusService,
 	}
 }
 
@@ -56,17 +94,164 @@ func (s *Server) AddHandler(topic string, handler Handler) {
 	s.handlers[topic] = handler
 }
 
-// Run starts every handler and watcher, serving the chi.Mux on a given port
-func (s *Server) Run(chi *chi.Mux, port string) {
+// Run starts every handler and watcher, serving the chi.Mux on a given port. Once the given context is done,
+// the watchers are stopped, the handlers are given time to complete their in-flight work and the HTTP server is shut down.
+// Messages which are not handled within the shutdown timeout are released, so that they are delivered again on the next start.
+func (s *Server) Run(ctx context.Context, chi *chi.Mux, port string) {
+	// Handlers are cancelled only after the shutdown timeout, giving them the chance to complete
+	handlerCtx, cancelHandlers := context.WithCancel(context.Background())
+	defer cancelHandlers()
+	dispatchCtx, stopDispatching := context.WithCancel(context.Background())
+
+	workers := &sync.WaitGroup{}
+	pools := s.startPools(handlerCtx, workers)
+	dispatching := &sync.WaitGroup{}
+	dispatching.Add(1)
 	go func() {
-		for message := range s.queue.Channel() {
-			go s.handlers[message.Topic].Handle(message.Payload)
-		}
+		defer dispatching.Done()
+		s.dispatch(dispatchCtx, pools)
 	}()
 
+	watching := &sync.WaitGroup{}
 	for _, watcher := range s.watchers {
-		go watcher.Watch(s.queue)
+		watching.Add(1)
+		go func(watcher Watcher) {
+			defer watching.Done()
+			watcher.Watch(ctx, s.queue)
+		}(watcher)
+	}
+
+	httpServer := &http.Server{Addr: port, Handler: chi}
+	go func() {
+		s.logger.Infof("Listening on port [%s]", port)
+		err := httpServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			s.logger.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	s.logger.Infof("Shutting down. Waiting up to [%s] for in-flight work to complete.", s.shutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if !waitFor(shutdownCtx, watching) {
+		s.logger.Warnf("Watchers did not stop within the shutdown timeout.")
+	}
+
+	stopDispatching()
+	dispatching.Wait()
+	for _, p := range pools {
+		p.stop()
+	}
+	if !waitFor(shutdownCtx, workers) {
+		s.logger.Warnf("Handlers did not complete their in-flight work within the shutdown timeout. Releasing the remaining messages.")
+		cancelHandlers()
+		releaseCtx, cancelRelease := context.WithTimeout(context.Background(), releaseTimeout)
+		if !waitFor(releaseCtx, workers) {
+			s.logger.Warnf("Handlers did not return within [%s] after being cancelled.", releaseTimeout)
+		}
+		cancelRelease()
+	}
+	cancelHandlers()
+
+	err := httpServer.Shutdown(shutdownCtx)
+	if err != nil {
+		s.logger.Errorf("Failed to gracefully shut down the HTTP server. Error: [%s]", err)
+		httpServer.Close()
+	}
+	s.logger.Infof("Shut down.")
+}
+
+// startPools starts a pool of workers for every registered handler, adding the workers to the given WaitGroup
+func (s *Server) startPools(ctx context.Context, workers *sync.WaitGroup) map[string]*pool {
+	for topic := range s.workers.Topics {
+		if _, ok := s.handlers[topic]; !ok {
+			s.logger.Warnf("Workers are configured for topic [%s], which has no handler.", topic)
+		}
+	}
+
+	pools := make(map[string]*pool)
+	for topic, handler := range s.handlers {
+		concurrency, ok := s.workers.Topics[topic]
+		if !ok || concurrency <= 0 {
+			concurrency = s.workers.Concurrency
+		}
+
+		p := newPool(topic, handler, concurrency, s.workers.BufferSize, s.prometheusService)
+		p.start(ctx, workers, s.handle)
+		pools[topic] = p
+		s.logger.Debugf("Started [%d] workers for topic [%s].", concurrency, topic)
+	}
+
+	return pools
+}
+
+// dispatch routes the messages from the queue to the pool of their topic until the given context is done.
+// While the pool is full, dispatching blocks, which in turn blocks the queue and the watchers pushing to it.
+func (s *Server) dispatch(ctx context.Context, pools map[string]*pool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-s.queue.Channel():
+			if !ok {
+				return
+			}
+
+			p, ok := pools[message.Topic]
+			if !ok {
+				err := errors.New(fmt.Sprintf("no handler registered for topic [%s]", message.Topic))
+				s.logger.Error(err)
+				s.queue.Nack(message, err)
+				continue
+			}
+
+			if !p.push(ctx, message) {
+				s.queue.Release(message)
+				return
+			}
+		}
+	}
+}
+
+// handle executes the given handler and acknowledges the message afterwards.
+// Messages whose handler fails are negatively acknowledged, so that they are retried or dead-lettered.
+// Once the given context is done, messages are released instead, so that a shutdown does not use up their attempts.
+func (s *Server) handle(ctx context.Context, handler Handler, message *q.Message) {
+	if ctx.Err() != nil {
+		s.queue.Release(message)
+		return
+	}
+
+	err := handler.Handle(ctx, message.Payload)
+	if err != nil {
+		if ctx.Err() != nil {
+			s.logger.Warnf("[%s] - Handler was cancelled on shutdown. Releasing message. Error: [%s]", message.Topic, err)
+			s.queue.Release(message)
+			return
+		}
+		s.logger.Errorf("[%s] - Handler failed on attempt [%d]. Error: [%s]", message.Topic, message.Attempts, err)
+		s.queue.Nack(message, err)
+		return
+	}
+
+	s.queue.Ack(message)
+}
+
+// waitFor waits for the given WaitGroup until the given context is done.
+// Returns false if the context was done first.
+func waitFor(ctx context.Context, group *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		group.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
 	}
-	s.logger.Infof("Listening on port [%s]", port)
-	s.logger.Fatal(http.ListenAndServe(port, chi))
 }
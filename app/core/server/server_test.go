@@ -0,0 +1,293 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/go-chi/chi"
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"sync"
+	"testing"
+	"time"
+)
+
+const topic = "SOME_TOPIC"
+
+type blockingHandler struct {
+	mu       sync.Mutex
+	inFlight int
+	maxSeen  int
+	started  chan struct{}
+	release  chan struct{}
+	err      error
+}
+
+func (h *blockingHandler) Handle(ctx context.Context, payload interface{}) error {
+	if h.started != nil {
+		h.started <- struct{}{}
+	}
+
+	h.mu.Lock()
+	h.inFlight++
+	if h.inFlight > h.maxSeen {
+		h.maxSeen = h.inFlight
+	}
+	h.mu.Unlock()
+
+	<-h.release
+
+	h.mu.Lock()
+	h.inFlight--
+	h.mu.Unlock()
+	return h.err
+}
+
+func (h *blockingHandler) max() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.maxSeen
+}
+
+// contextHandler blocks until the given context is done
+type contextHandler struct {
+	started chan struct{}
+}
+
+func (h contextHandler) Handle(ctx context.Context, payload interface{}) error {
+	close(h.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// contextWatcher blocks until the given context is done
+type contextWatcher struct {
+	stopped chan struct{}
+}
+
+func (w *contextWatcher) Watch(ctx context.Context, queue queue.Queue) {
+	<-ctx.Done()
+	close(w.stopped)
+}
+
+func Test_NewServer_Defaults(t *testing.T) {
+	setup()
+
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+
+	assert.Equal(t, defaultConcurrency, s.workers.Concurrency)
+	assert.Equal(t, defaultBufferSize, s.workers.BufferSize)
+	assert.Equal(t, defaultShutdownTimeout, s.shutdownTimeout)
+}
+
+func Test_NewServer_ShutdownTimeout(t *testing.T) {
+	setup()
+
+	s := NewServer(mocks.MQueue, config.Workers{}, 5*time.Second, mocks.MPrometheusService)
+
+	assert.Equal(t, 5*time.Second, s.shutdownTimeout)
+}
+
+func Test_Run_DrainsInFlightWorkOnShutdown(t *testing.T) {
+	setup()
+	channel := make(chan *q.Message)
+	mocks.MQueue.On("Channel").Return(channel)
+	acked := make(chan bool, 1)
+	mocks.MQueue.On("Ack", mock.Anything).Return().Run(func(args mock.Arguments) {
+		acked <- true
+	})
+	handler := &blockingHandler{started: make(chan struct{}), release: make(chan struct{})}
+	watcher := &contextWatcher{stopped: make(chan struct{})}
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+	s.AddHandler(topic, handler)
+	s.AddWatcher(watcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx, chi.NewRouter(), "127.0.0.1:0")
+		close(done)
+	}()
+	channel <- &q.Message{Topic: topic}
+	<-handler.started
+
+	cancel()
+	<-watcher.stopped
+	select {
+	case <-done:
+		t.Fatal("Expected shutdown to wait for the in-flight handler")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(handler.release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected shutdown to complete once the in-flight handler is done")
+	}
+	select {
+	case <-acked:
+	case <-time.After(time.Second):
+		t.Fatal("Expected in-flight message to be acknowledged")
+	}
+}
+
+func Test_Run_CancelsHandlersAfterShutdownTimeout(t *testing.T) {
+	setup()
+	channel := make(chan *q.Message)
+	message := &q.Message{Topic: topic}
+	mocks.MQueue.On("Channel").Return(channel)
+	released := make(chan struct{}, 1)
+	mocks.MQueue.On("Release", message).Return().Run(func(args mock.Arguments) {
+		released <- struct{}{}
+	})
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+	s.shutdownTimeout = 10 * time.Millisecond
+	handler := contextHandler{started: make(chan struct{})}
+	s.AddHandler(topic, handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx, chi.NewRouter(), "127.0.0.1:0")
+		close(done)
+	}()
+	channel <- message
+	<-handler.started
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected shutdown to complete after the shutdown timeout")
+	}
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("Expected cancelled message to be released")
+	}
+	mocks.MQueue.AssertNotCalled(t, "Nack", mock.Anything, mock.Anything)
+}
+
+func Test_Dispatch_LimitsConcurrencyPerTopic(t *testing.T) {
+	setup()
+	channel := make(chan *q.Message)
+	mocks.MQueue.On("Channel").Return(channel)
+	acked := &sync.WaitGroup{}
+	acked.Add(5)
+	mocks.MQueue.On("Ack", mock.Anything).Return().Run(func(args mock.Arguments) {
+		acked.Done()
+	})
+	handler := &blockingHandler{release: make(chan struct{})}
+	s := NewServer(mocks.MQueue, config.Workers{Concurrency: 10, BufferSize: 10, Topics: map[string]int{topic: 2}}, 0, mocks.MPrometheusService)
+	s.AddHandler(topic, handler)
+
+	go s.dispatch(context.Background(), s.startPools(context.Background(), &sync.WaitGroup{}))
+	for i := 0; i < 5; i++ {
+		channel <- &q.Message{Topic: topic}
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Equal(t, 2, handler.max())
+	close(handler.release)
+	acked.Wait()
+	assert.Equal(t, 2, handler.max())
+}
+
+func Test_Dispatch_Backpressure(t *testing.T) {
+	setup()
+	channel := make(chan *q.Message)
+	mocks.MQueue.On("Channel").Return(channel)
+	mocks.MQueue.On("Ack", mock.Anything).Return()
+	handler := &blockingHandler{release: make(chan struct{})}
+	defer close(handler.release)
+	s := NewServer(mocks.MQueue, config.Workers{Concurrency: 1, BufferSize: 1}, 0, mocks.MPrometheusService)
+	s.AddHandler(topic, handler)
+
+	go s.dispatch(context.Background(), s.startPools(context.Background(), &sync.WaitGroup{}))
+	// One message is being handled, one is buffered and one is held by the dispatcher
+	for i := 0; i < 3; i++ {
+		channel <- &q.Message{Topic: topic}
+	}
+
+	select {
+	case channel <- &q.Message{Topic: topic}:
+		t.Fatal("Expected push to block while the pool is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func Test_Dispatch_UnknownTopic(t *testing.T) {
+	setup()
+	channel := make(chan *q.Message)
+	message := &q.Message{Topic: "UNKNOWN"}
+	mocks.MQueue.On("Channel").Return(channel)
+	nacked := make(chan bool, 1)
+	mocks.MQueue.On("Nack", message, mock.Anything).Return().Run(func(args mock.Arguments) {
+		nacked <- true
+	})
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+
+	go s.dispatch(context.Background(), s.startPools(context.Background(), &sync.WaitGroup{}))
+	channel <- message
+
+	select {
+	case <-nacked:
+	case <-time.After(time.Second):
+		t.Fatal("Expected message to be negatively acknowledged")
+	}
+}
+
+func Test_Handle_Fails(t *testing.T) {
+	setup()
+	message := &q.Message{Topic: topic}
+	handler := &blockingHandler{release: make(chan struct{}), err: errors.New("some-error")}
+	close(handler.release)
+	mocks.MQueue.On("Nack", message, handler.err).Return()
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+
+	s.handle(context.Background(), handler, message)
+
+	mocks.MQueue.AssertCalled(t, "Nack", message, handler.err)
+	mocks.MQueue.AssertNotCalled(t, "Ack", mock.Anything)
+}
+
+func setup() {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+}
+
+func Test_Handle_ReleasesAfterCancel(t *testing.T) {
+	setup()
+	message := &q.Message{Topic: topic}
+	handler := &blockingHandler{started: make(chan struct{}, 1), release: make(chan struct{})}
+	mocks.MQueue.On("Release", message).Return()
+	s := NewServer(mocks.MQueue, config.Workers{}, 0, mocks.MPrometheusService)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.handle(ctx, handler, message)
+
+	mocks.MQueue.AssertCalled(t, "Release", message)
+	mocks.MQueue.AssertNotCalled(t, "Nack", mock.Anything, mock.Anything)
+	assert.Len(t, handler.started, 0)
+}
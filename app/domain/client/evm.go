@@ -30,6 +30,10 @@ type EVM interface {
 	BlockNumber(ctx context.Context) (uint64, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
 	GetBlockTimestamp(blockNumber *big.Int) uint64
+	// HeaderByNumber returns the header of the given block of the canonical chain
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	// TransactionReceipt returns the receipt of the given transaction, if it is included in the canonical chain
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	ValidateContractDeployedAt(contractAddress string) (*common.Address, error)
 	// WaitForTransaction waits for transaction receipt and depending on receipt status calls one of the provided functions
 	// onSuccess is called once the TX is successfully mined
@@ -43,8 +47,8 @@ type EVM interface {
 	BlockConfirmations() uint64
 	// RetryBlockNumber returns the most recent block number
 	// Uses a retry mechanism in case the filter query is stuck
-	RetryBlockNumber() (uint64, error)
+	RetryBlockNumber(ctx context.Context) (uint64, error)
 	// RetryFilterLogs returns the logs from the input query
 	// Uses a retry mechanism in case the filter query is stuck
-	RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error)
+	RetryFilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
 }
@@ -19,5 +19,5 @@ package client
 import "net/http"
 
 type HttpClient interface {
-	Get(url string) (resp *http.Response, err error)
+	Do(request *http.Request) (resp *http.Response, err error)
 }
@@ -17,58 +17,69 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 )
 
+// ErrNotFound is returned by the MirrorNode methods, if the requested entity does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrUnavailable is returned by the MirrorNode methods, if the Mirror node kept failing with transient errors, such as
+// timeouts, rate limiting or server errors, or if its circuit breaker is open. The call may succeed later
+var ErrUnavailable = errors.New("mirror node unavailable")
+
 type MirrorNode interface {
 	// GetAccountTokenMintTransactionsAfterTimestampString queries the hedera mirror node for transactions on a certain account with type TokenMint
-	GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error)
+	GetAccountTokenMintTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error)
 	// GetAccountTokenMintTransactionsAfterTimestamp queries the hedera mirror node for transactions on a certain account with type TokenMint
-	GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error)
+	GetAccountTokenMintTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error)
 	// GetAccountTokenBurnTransactionsAfterTimestampString queries the hedera mirror node for transactions on a certain account with type TokenBurn
-	GetAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error)
+	GetAccountTokenBurnTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error)
 	// GetAccountTokenBurnTransactionsAfterTimestamp queries the hedera mirror node for transactions on a certain account with type TokenBurn
-	GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error)
+	GetAccountTokenBurnTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error)
 	// GetAccountDebitTransactionsAfterTimestampString queries the hedera mirror node for transactions that are debit and after a given timestamp
-	GetAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error)
+	GetAccountDebitTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error)
 	// GetAccountCreditTransactionsAfterTimestampString returns all transaction after a given timestamp
-	GetAccountCreditTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error)
+	GetAccountCreditTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error)
 	// GetAccountCreditTransactionsAfterTimestamp returns all transaction after a given timestamp
-	GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error)
-	// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
-	GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]model.Transaction, error)
+	GetAccountCreditTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error)
+	// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` included and `to` excluded
+	GetAccountCreditTransactionsBetween(ctx context.Context, accountId hedera.AccountID, from, to int64) ([]model.Transaction, error)
 	// GetMessagesAfterTimestamp returns all topic messages after the given timestamp
-	GetMessagesAfterTimestamp(topicId hedera.TopicID, from int64) ([]model.Message, error)
+	GetMessagesAfterTimestamp(ctx context.Context, topicId hedera.TopicID, from int64) ([]model.Message, error)
 	// GetMessagesForTopicBetween returns all topic messages for a given topic between timestamp `from` included and `to` excluded
-	GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]model.Message, error)
+	GetMessagesForTopicBetween(ctx context.Context, topicId hedera.TopicID, from, to int64) ([]model.Message, error)
 	// GetNftTransactions returns the nft transactions for tokenID and serialNum
-	GetNftTransactions(tokenID string, serialNum int64) (model.NftTransactionsResponse, error)
+	GetNftTransactions(ctx context.Context, tokenID string, serialNum int64) (model.NftTransactionsResponse, error)
 	// GetScheduledTransaction gets the Scheduled transaction of an executed transaction
-	GetScheduledTransaction(transactionID string) (*model.Response, error)
+	GetScheduledTransaction(ctx context.Context, transactionID string) (*model.Response, error)
 	// GetTransaction gets all data related to a specific transaction id or returns an error
-	GetTransaction(transactionID string) (*model.Response, error)
+	GetTransaction(ctx context.Context, transactionID string) (*model.Response, error)
 	// GetSuccessfulTransaction gets the success transaction by transaction id or returns an error
-	GetSuccessfulTransaction(transactionID string) (model.Transaction, error)
+	GetSuccessfulTransaction(ctx context.Context, transactionID string) (model.Transaction, error)
 	// GetSchedule retrieves a schedule entity by its id
-	GetSchedule(scheduleID string) (*model.Schedule, error)
+	GetSchedule(ctx context.Context, scheduleID string) (*model.Schedule, error)
 	// GetStateProof sends a query to get the state proof. If the query is successful, the function returns the state.
 	// If the query returns a status != 200, the function returns an error.
-	GetStateProof(transactionID string) ([]byte, error)
+	GetStateProof(ctx context.Context, transactionID string) ([]byte, error)
 	// GetNft retrieves an nft token entity by its id and serial number
-	GetNft(tokenID string, serialNum int64) (*model.Nft, error)
+	GetNft(ctx context.Context, tokenID string, serialNum int64) (*model.Nft, error)
 	// AccountExists sends a query to check whether a specific account exists. If the query returns a status != 200, the function returns a false value
-	AccountExists(accountID hedera.AccountID) bool
+	AccountExists(ctx context.Context, accountID hedera.AccountID) bool
 	// GetAccount gets the account data by ID.
-	GetAccount(accountID string) (*model.AccountsResponse, error)
+	GetAccount(ctx context.Context, accountID string) (*model.AccountsResponse, error)
 	// GetToken gets the token data by ID.
-	GetToken(tokenID string) (*model.TokenResponse, error)
+	GetToken(ctx context.Context, tokenID string) (*model.TokenResponse, error)
 	// TopicExists sends a query to check whether a specific topic exists. If the query returns a status != 200, the function returns a false value
-	TopicExists(topicID hedera.TopicID) bool
+	TopicExists(ctx context.Context, topicID hedera.TopicID) bool
 	// WaitForTransaction Polls the transaction at intervals. Depending on the
-	// result, the corresponding `onSuccess` and `onFailure` functions are called
-	WaitForTransaction(txId string, onSuccess, onFailure func())
+	// result, the corresponding `onSuccess` and `onFailure` functions are called.
+	// Polling stops without calling either of them once the given context is done
+	WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func())
 	// WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
-	// result, the corresponding `onSuccess` and `onFailure` functions are called
-	WaitForScheduledTransaction(txId string, onSuccess, onFailure func())
+	// result, the corresponding `onSuccess` and `onFailure` functions are called.
+	// Polling stops without calling either of them once the given context is done
+	WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess, onFailure func())
 }
@@ -39,4 +39,8 @@ type HederaNode interface {
 	SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// SubmitScheduledTokenBurnTransaction creates a token burn transaction and submits it as a scheduled burn transaction
 	SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	// SubmitScheduledNftMintTransaction creates an NFT mint transaction with the given metadata and submits it as a scheduled mint transaction
+	SubmitScheduledNftMintTransaction(tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	// SubmitScheduledNftBurnTransaction creates an NFT burn transaction for the given serial number and submits it as a scheduled burn transaction
+	SubmitScheduledNftBurnTransaction(tokenID hedera.TokenID, serialNum int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 }
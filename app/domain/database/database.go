@@ -22,4 +22,6 @@ import (
 
 type Database interface {
 	GetConnection() *gorm.DB
+	// Close closes the underlying connection pool
+	Close() error
 }
@@ -17,10 +17,37 @@
 package queue
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 )
 
-type Queue interface {
+// Pusher is implemented by everything messages can be pushed to
+type Pusher interface {
 	Push(message *queue.Message)
+}
+
+type Queue interface {
+	// Push enqueues the given message. Returns an error if the message could not be enqueued
+	// or the given context is done before it is.
+	Push(ctx context.Context, message *queue.Message) error
+	// Commit pushes the given messages and updates the Status of the given entity.
+	// Persistent implementations do both atomically, so that the progress of a watcher
+	// is never recorded without the work it produced.
+	Commit(ctx context.Context, entityID string, timestampOrBlockNumber int64, messages ...*queue.Message) error
+	// Ack marks the given message as handled
+	Ack(message *queue.Message)
+	// Nack marks the given message as failed with the given error. The message is delivered again
+	// after a backoff, until it exceeds the maximum attempts and is moved to the dead letters.
+	Nack(message *queue.Message, err error)
+	// Release returns a delivered message, which was not handled, to the queue without counting its delivery
+	// as an attempt. Used on shutdown for messages which are not handled in time.
+	Release(message *queue.Message)
+	// Replay moves the given dead letter back to the queue. The dead letter is deleted only once its message is
+	// enqueued. Persistent implementations do both atomically, so that a failed replay never loses the message.
+	Replay(deadLetter *entity.DeadLetter) error
+	// Resume moves the given paused message back to the queue. The paused message is deleted only once it is
+	// enqueued. Persistent implementations do both atomically.
+	Resume(message *entity.PausedMessage) error
 	Channel() chan *queue.Message
 }
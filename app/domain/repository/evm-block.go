@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+
+type EvmBlock interface {
+	// Create persists the hash of the given block
+	Create(block *entity.EvmBlock) error
+	// GetLatest returns up to limit blocks of the given entity, newest first
+	GetLatest(entityID string, limit int) ([]*entity.EvmBlock, error)
+	// DeleteAfter deletes the blocks of the given entity with a number greater than the given one
+	DeleteAfter(entityID string, number int64) error
+	// DeleteBefore deletes the blocks of the given entity with a number less than the given one
+	DeleteBefore(entityID string, number int64) error
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+
+type EvmEvent interface {
+	// Create persists the given event. Events which were already persisted are moved to the block of the given one.
+	Create(event *entity.EvmEvent) error
+	// GetByTransferID returns the events observed for the given transfer, oldest first
+	GetByTransferID(transferID string) ([]*entity.EvmEvent, error)
+	// GetFromBlock returns the events, which are not orphaned, observed on the given chain from the given block onwards
+	GetFromBlock(chainID, blockNumber uint64) ([]*entity.EvmEvent, error)
+	// MarkOrphaned marks the given event as no longer part of the canonical chain
+	MarkOrphaned(transactionHash string, logIndex uint) error
+}
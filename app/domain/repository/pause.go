@@ -0,0 +1,29 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+
+type Pause interface {
+	// Create pauses the scope of the given pause, if it is not paused already
+	Create(pause *entity.Pause) error
+	// GetAll returns every pause, oldest first
+	GetAll() ([]*entity.Pause, error)
+	// Get returns the pause of the given scope or nil if there is none
+	Get(scope, value string) (*entity.Pause, error)
+	Delete(scope, value string) error
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"time"
+)
+
+type Queue interface {
+	// Create persists the given messages, making them immediately available for leasing
+	Create(messages []*entity.QueueMessage) error
+	// CreateWithStatus persists the given messages and updates the Status of the given entity in a single transaction
+	CreateWithStatus(entityID string, timestampOrBlockNumber int64, messages []*entity.QueueMessage) error
+	// Lease returns up to limit available messages, hiding them from other leases for the given visibility timeout
+	Lease(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error)
+	// Delete removes an acknowledged message
+	Delete(id uint64) error
+	// Delay hides a leased message from leases until the given delay passes
+	Delay(id uint64, delay time.Duration) error
+	// DeadLetter moves a message to the dead letters with the given error in a single transaction
+	DeadLetter(id uint64, reason string) error
+	// Replay moves the dead letter with the given id back to the queue in a single transaction, so that it is
+	// delivered again starting from the first attempt
+	Replay(deadLetterID uint64) error
+	// Resume moves the paused message of the given transfer back to the queue in a single transaction
+	Resume(transferID string) error
+	// Release makes a leased message available for leasing again, without counting its lease as an attempt
+	Release(id uint64) error
+	// ReleaseAll makes every currently leased message available for leasing again
+	ReleaseAll() error
+	// GetAll returns all messages, leased or not, oldest first
+	GetAll() ([]*entity.QueueMessage, error)
+}
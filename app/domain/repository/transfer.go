@@ -27,9 +27,38 @@ type Transfer interface {
 	// Returns Transfer with preloaded Fee table. Returns nil if not found
 	GetWithFee(txId string) (*entity.Transfer, error)
 	GetWithPreloads(txId string) (*entity.Transfer, error)
+	// GetPage returns up to limit transfers matching the given filter with preloaded Messages and Schedules, newest first.
+	// If a cursor is given, only the transfers after it are returned.
+	GetPage(filter *transfer.Filter, after *transfer.Cursor, limit int) ([]*entity.Transfer, error)
 	UpdateFee(txId string, fee string) error
+	// UpdateWrappedSerialNumber sets the serial number of the wrapped Hedera NFT, minted for the given transfer
+	UpdateWrappedSerialNumber(txId string, serialNum int64) error
+	// GetByWrappedNft returns the transfer, which minted the given wrapped Hedera NFT. Returns nil if not found
+	GetByWrappedNft(asset string, serialNum int64) (*entity.Transfer, error)
 
 	Create(ct *transfer.Transfer) (*entity.Transfer, error)
 	UpdateStatusCompleted(txId string) error
 	UpdateStatusFailed(txId string) error
+	// UpdateStatusRevoked revokes the transfer, if it is still INITIAL
+	UpdateStatusRevoked(txId string) error
+	// ReinstateRevoked moves the transfer back to INITIAL, if it is REVOKED
+	ReinstateRevoked(txId string) error
+	// UpdateStatusPendingReview holds the transfer for review, if it is still INITIAL
+	UpdateStatusPendingReview(txId string) error
+	// ApprovePendingReview moves the transfer back to INITIAL, if it is PENDING_REVIEW
+	ApprovePendingReview(txId string) error
+	// RejectPendingReview fails the transfer, if it is PENDING_REVIEW
+	RejectPendingReview(txId string) error
+	// GetUnpaid returns the fungible transfers of the given native asset, which are neither revoked nor paid out on their
+	// target chain yet
+	GetUnpaid(nativeChainId uint64, nativeAsset string) ([]*entity.Transfer, error)
+	// UpdateStatusBlocked blocks the transfer, if it is still INITIAL
+	UpdateStatusBlocked(txId string) error
+	// GetOutflowSince returns the target chains, target assets and amounts of the fungible transfers of the given native
+	// asset to any network, which were recorded since the given unix nanoseconds and are neither failed, revoked,
+	// blocked, nor pending review
+	GetOutflowSince(nativeChainId uint64, nativeAsset string, since int64) ([]*entity.Transfer, error)
+	// GetInitial returns the INITIAL transfers recorded since the given unix nanoseconds with preloaded Fees, Messages,
+	// Schedules and Erc1155Items, oldest first
+	GetInitial(since int64) ([]*entity.Transfer, error)
 }
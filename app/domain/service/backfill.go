@@ -0,0 +1,58 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+)
+
+// BackfillSource is implemented by the Hedera watchers, which can re-scan a range of their history
+type BackfillSource interface {
+	// Name returns the watched account or topic
+	Name() string
+	// Backfill re-scans the history with consensus timestamps between from included and to excluded. Everything,
+	// which is not persisted, is pushed to the given pusher. Returns the count of scanned entries and the IDs of the
+	// missing ones
+	Backfill(ctx context.Context, from, to int64, q queue.Pusher) (scanned int, missing []string, err error)
+}
+
+// BackfillResult is the outcome of re-scanning a single source
+type BackfillResult struct {
+	Source  string   `json:"source"`
+	Scanned int      `json:"scanned"`
+	Missing []string `json:"missing"`
+	// Error is set, if the source could not be re-scanned
+	Error string `json:"error,omitempty"`
+}
+
+// BackfillReport is the outcome of re-scanning a timestamp range of all sources
+type BackfillReport struct {
+	From    int64            `json:"from"`
+	To      int64            `json:"to"`
+	DryRun  bool             `json:"dryRun"`
+	Results []BackfillResult `json:"results"`
+}
+
+// Backfill is the service used to find and enqueue the transfers and topic messages, which the watchers skipped
+type Backfill interface {
+	// Backfill re-scans every source between from included and to excluded and enqueues the missing entries, unless
+	// dryRun is set. Enqueuing stops once the given context is done
+	Backfill(ctx context.Context, from, to int64, dryRun bool) (*BackfillReport, error)
+	// GetLatest returns the report of the latest backfill. Returns nil if there was none yet
+	GetLatest() *BackfillReport
+}
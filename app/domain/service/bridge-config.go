@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"strings"
+)
+
+// BridgeConfig holds the active bridge configuration and replaces it at runtime
+type BridgeConfig interface {
+	// Active returns the active bridge configuration and its version
+	Active() (parser.Bridge, string)
+	// Apply validates the given bridge configuration and makes it the active one.
+	// Returns InvalidBridgeConfigError if the configuration cannot be applied without a restart
+	Apply(bridge parser.Bridge) error
+}
+
+// InvalidBridgeConfigError lists the problems, because of which a bridge configuration was not applied
+type InvalidBridgeConfigError struct {
+	Problems []error
+}
+
+func (e *InvalidBridgeConfigError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		problems[i] = problem.Error()
+	}
+	return "invalid bridge configuration: " + strings.Join(problems, "; ")
+}
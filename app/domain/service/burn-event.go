@@ -17,6 +17,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
 
@@ -24,7 +26,7 @@ import (
 type BurnEvent interface {
 	// ProcessEvent processes the burn event by submitting the appropriate
 	// scheduled transaction, leaving the synchronization of the actual transfer on HCS
-	ProcessEvent(transfer transfer.Transfer)
+	ProcessEvent(ctx context.Context, transfer transfer.Transfer) error
 	// TransactionID returns the corresponding Scheduled Transaction paying out the
 	// fees to validators and the amount being bridged to the receiver address
 	TransactionID(id string) (string, error)
@@ -40,6 +40,8 @@ type Contracts interface {
 	IsMember(address string) bool
 	// HasValidSignaturesLength returns whether the signatures are enough for submission
 	HasValidSignaturesLength(*big.Int) (bool, error)
+	// IsHashUsed returns whether a mint or unlock authorised by the given hash is executed
+	IsHashUsed(hash [32]byte) (bool, error)
 	// ParseMintLog parses a general typed log to a RouterMint event
 	ParseMintLog(log types.Log) (*abi.RouterMint, error)
 	// ParseBurnLog parses a general typed log to a RouterBurn event
@@ -50,6 +52,14 @@ type Contracts interface {
 	ParseUnlockLog(log types.Log) (*abi.RouterUnlock, error)
 	// ParseBurnERC721Log parses a general typed log to a BurnERC721event
 	ParseBurnERC721Log(log types.Log) (*abi.RouterBurnERC721, error)
+	// ParseLockERC721Log parses a general typed log to a RouterLockERC721 event
+	ParseLockERC721Log(log types.Log) (*abi.RouterLockERC721, error)
+	// ParseUnlockERC721Log parses a general typed log to a RouterUnlockERC721 event
+	ParseUnlockERC721Log(log types.Log) (*abi.RouterUnlockERC721, error)
+	// ParseLockERC1155Log parses a general typed log to a RouterLockERC1155 event
+	ParseLockERC1155Log(log types.Log) (*abi.RouterLockERC1155, error)
+	// ParseUnlockERC1155Log parses a general typed log to a RouterUnlockERC1155 event
+	ParseUnlockERC1155Log(log types.Log) (*abi.RouterUnlockERC1155, error)
 	// WatchBurnEventLogs creates a subscription for Burn Events emitted in the Bridge contract
 	WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *abi.RouterBurn) (event.Subscription, error)
 	// WatchLockEventLogs creates a subscription for Lock Events emitted in the Bridge contract
@@ -58,4 +68,6 @@ type Contracts interface {
 	AddDecimals(amount *big.Int, asset string) (*big.Int, error)
 	// RemoveDecimals adjusts the decimals in the native and wrapped tokens when their decimals do not match and one of them is over 8
 	RemoveDecimals(amount *big.Int, asset string) (*big.Int, error)
+	// TokenURI returns the metadata URI of the given ERC-721 token
+	TokenURI(asset string, tokenId *big.Int) (string, error)
 }
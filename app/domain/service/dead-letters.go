@@ -0,0 +1,33 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// DeadLetters is the service used by operators to inspect and resolve messages whose handler failed repeatedly
+type DeadLetters interface {
+	// GetAll returns every dead letter, oldest first
+	GetAll() ([]*entity.DeadLetter, error)
+	// Get returns the dead letter with the given id. Returns ErrNotFound if there is none
+	Get(id uint64) (*entity.DeadLetter, error)
+	// Replay pushes the payload of the dead letter back to the queue for handling and removes the dead letter
+	Replay(id uint64) error
+	// Discard removes the dead letter without handling its payload
+	Discard(id uint64) error
+}
@@ -19,3 +19,12 @@ package service
 import "errors"
 
 var ErrNotFound = errors.New("not found")
+
+// ErrInvalidPayload is returned by handlers given a payload of an unexpected type
+var ErrInvalidPayload = errors.New("invalid payload")
+
+// ErrInvalidRange is returned when the start of a timestamp range is not before its end
+var ErrInvalidRange = errors.New("invalid timestamp range")
+
+// ErrInvalidCursor is returned when listing with a cursor which was not issued by a previous page
+var ErrInvalidCursor = errors.New("invalid cursor")
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// Limits is the service used to hold the fungible transfers, which exceed the amount limits of their asset
+type Limits interface {
+	// Hold evaluates the amount limits of the given recorded transfer before it is signed or scheduled.
+	// If any limit is exceeded, the transfer is held in PENDING_REVIEW along with the message of the given topic.
+	// Returns whether the transfer is held. Transfers approved by an operator are not held again
+	Hold(ctx context.Context, tm transfer.Transfer, topic string) (bool, error)
+}
+
+// Reviews is the service used by operators to resolve the transfers held by the amount limits
+type Reviews interface {
+	// GetPending returns every transfer pending review, oldest first
+	GetPending() ([]*entity.Review, error)
+	// Get returns the pending review of the given transfer. Returns ErrNotFound if there is none
+	Get(transferID string) (*entity.Review, error)
+	// Approve moves the transfer back to INITIAL and pushes its message back to the queue for processing
+	Approve(transferID string) error
+	// Reject fails the transfer without processing it
+	Reject(transferID string) error
+}
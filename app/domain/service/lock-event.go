@@ -17,12 +17,20 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
 
 // LockEvent is the major service used for processing BurnEvent operations
 type LockEvent interface {
 	// ProcessEvent processes the lock event by submitting the appropriate
-	// Scheduled Token Mint and Transfer transactions
-	ProcessEvent(event transfer.Transfer)
+	// Scheduled Token Mint and Transfer transactions. Awaiting the mint stops once the given context is done.
+	ProcessEvent(ctx context.Context, event transfer.Transfer) error
+	// ProcessNftEvent processes the ERC-721 lock event by submitting the appropriate
+	// Scheduled NFT Mint and Transfer transactions. Awaiting the mint stops once the given context is done.
+	ProcessNftEvent(ctx context.Context, event transfer.Transfer) error
+	// ProcessErc1155Event processes the ERC-1155 lock event by submitting the appropriate
+	// Scheduled Token Mint and Transfer transactions for every token ID of the batch.
+	// Awaiting the transactions stops once the given context is done.
+	ProcessErc1155Event(ctx context.Context, event transfer.Transfer) error
 }
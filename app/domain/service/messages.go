@@ -28,10 +28,15 @@ type Messages interface {
 	// SanityCheckNftSignature performs any validation required prior handling the topic message
 	// (verifies input data against the corresponding Transaction record)
 	SanityCheckNftSignature(tm *proto.TopicEthNftSignatureMessage) (bool, error)
+	// SanityCheckErc1155Signature performs any validation required prior handling the topic message
+	// (verifies input data and the ERC-1155 batch against the corresponding Transaction record)
+	SanityCheckErc1155Signature(tm *proto.TopicEthErc1155SignatureMessage) (bool, error)
 	// ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
 	ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error
 	// SignFungibleMessage signs a Fungible message based on Transfer
 	SignFungibleMessage(transfer model.Transfer) ([]byte, error)
 	// SignNftMessage signs an NFT messaged based on Transfer
 	SignNftMessage(transfer model.Transfer) ([]byte, error)
+	// SignErc1155Message signs an ERC-1155 batch message based on Transfer
+	SignErc1155Message(transfer model.Transfer) ([]byte, error)
 }
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// Pause is the service used by operators to suspend signing, topic submission and scheduled transaction execution
+// globally, per chain or per asset, while the watchers keep indexing
+type Pause interface {
+	// GetAll returns every active pause, oldest first
+	GetAll() ([]*entity.Pause, error)
+	// Pause suspends the transfers in the given scope. Pausing a paused scope has no effect
+	Pause(scope, value string) error
+	// Resume lifts the pause of the given scope and pushes the deferred messages, to which no other pause applies,
+	// back to the queue. Returns ErrNotFound if the scope is not paused
+	Resume(scope, value string) error
+	// Defer persists the message of the given transfer until its pause is lifted, if any pause applies to the transfer.
+	// Returns whether the message is deferred
+	Defer(topic string, tm transfer.Transfer) (bool, error)
+}
@@ -35,6 +35,8 @@ type Prometheus interface {
 	GetCounter(name string) prometheus.Counter
 	// DeleteCounter unregisters and deletes Counter with the passed name
 	DeleteCounter(name string)
+	// CreateHistogramIfNotExists creates new Histogram Metric and registers it in Prometheus if not exists
+	CreateHistogramIfNotExists(opts prometheus.HistogramOpts) prometheus.Histogram
 	// ConstructMetricName constructing name for metric
 	ConstructMetricName(sourceNetworkId, targetNetworkId uint64, asset, transactionId, metricTarget string) (string, error)
 	// GetIsMonitoringEnabled returns if the monitoring is enabled
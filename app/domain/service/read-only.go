@@ -17,13 +17,16 @@
 package service
 
 import (
+	"context"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
 
+// ReadOnly polls the Mirror node for the transactions of a transfer, which are executed by the other validators.
+// Polling stops once the transfer is found or the given context is done, in which case the error of the context is returned
 type ReadOnly interface {
-	FindTransfer(transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
-	FindAssetTransfer(transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
-	FindNftTransfer(transferID string, tokenID string, serialNum int64, sender string, receiver string,
-		save func(transactionID, scheduleID, status string) error)
+	FindTransfer(ctx context.Context, transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) error
+	FindAssetTransfer(ctx context.Context, transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) error
+	FindNftTransfer(ctx context.Context, transferID string, tokenID string, serialNum int64, sender string, receiver string,
+		save func(transactionID, scheduleID, status string) error) error
 }
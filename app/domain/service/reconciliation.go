@@ -0,0 +1,35 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// Reconciliation is the service used to check that the locked balance of every native fungible asset matches the
+// total supply of its wrapped assets on every chain
+type Reconciliation interface {
+	// Reconcile compares the locked balance of every native fungible asset against the supply of its wrapped assets and
+	// the amounts of the transfers not paid out yet. Stores and returns a snapshot of every reconciled asset
+	Reconcile(ctx context.Context) ([]*entity.SupplySnapshot, error)
+	// GetLatest returns the latest snapshot of every native asset
+	GetLatest() ([]*entity.SupplySnapshot, error)
+	// GetHistory returns up to limit snapshots of the given native asset, newest first
+	GetHistory(chainId uint64, asset string, limit int) ([]*entity.SupplySnapshot, error)
+}
@@ -17,19 +17,25 @@
 package service
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
 
 // Scheduled interface is implemented by the Scheduled Service
-// Provides business logic for execution of Scheduled Transactions
+// Provides business logic for execution of Scheduled Transactions.
+// Awaiting the execution of the transactions stops once the given context is done
 type Scheduled interface {
 	// ExecuteScheduledTransferTransaction submits a scheduled transfer transaction and executes provided functions when necessary
-	ExecuteScheduledTransferTransaction(id, asset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledTransferTransaction(ctx context.Context, id, asset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledMintTransaction submits a scheduled mint transaction and executes provided functions when necessary
-	ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledBurnTransaction submits a scheduled burn transaction and executes provided functions when necessary
-	ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	// ExecuteScheduledNftMintTransaction submits a scheduled NFT mint transaction with the given metadata and executes provided functions when necessary
+	ExecuteScheduledNftMintTransaction(ctx context.Context, id, asset string, metadata []byte, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	// ExecuteScheduledNftBurnTransaction submits a scheduled burn transaction of the given NFT serial number and executes provided functions when necessary
+	ExecuteScheduledNftBurnTransaction(ctx context.Context, id, asset string, serialNum int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledNftTransferTransaction submits a scheduled nft transfer transaction and executes provided functions when necessary
-	ExecuteScheduledNftTransferTransaction(id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledNftTransferTransaction(ctx context.Context, id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 }
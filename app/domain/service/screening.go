@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+// Screening is the service used to block the transfers, whose sender or receiver is denied by the screening provider
+type Screening interface {
+	// Screen checks the sender and receiver of the given recorded transfer before it is signed or scheduled.
+	// If any of them is denied, the transfer is moved to BLOCKED and the hit is recorded. Returns whether the transfer is blocked
+	Screen(tm transfer.Transfer) (bool, error)
+	// GetHits returns every recorded screening hit, oldest first
+	GetHits() ([]*entity.ScreeningHit, error)
+}
+
+// ScreeningProvider is the source of the denied Hedera account IDs and EVM addresses
+type ScreeningProvider interface {
+	// IsDenied returns whether the given Hedera account ID or checksummed EVM address is denied
+	IsDenied(party string) (bool, error)
+}
+
+// ScreeningList is a ScreeningProvider, whose entries are loaded from the content of a list file
+type ScreeningList interface {
+	ScreeningProvider
+	// Load replaces the entries of the list with the given content
+	Load(content []byte) error
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+// The types of the events published while a transfer is being processed
+const (
+	// TransferEventCreated is published once the transfer is recorded
+	TransferEventCreated = "CREATED"
+	// TransferEventSignature is published once a validator signature is persisted
+	TransferEventSignature = "SIGNATURE"
+	// TransferEventMajority is published once the signatures reach super majority
+	TransferEventMajority = "MAJORITY"
+	// TransferEventScheduleCompleted is published once a scheduled transaction of the transfer completes
+	TransferEventScheduleCompleted = "SCHEDULE_COMPLETED"
+	// TransferEventScheduleFailed is published once a scheduled transaction of the transfer fails
+	TransferEventScheduleFailed = "SCHEDULE_FAILED"
+)
+
+// TransferEvent is a change in the processing of a transfer. The timestamp is in Unix nanoseconds
+type TransferEvent struct {
+	TransferID    string `json:"transferId"`
+	Type          string `json:"type"`
+	Timestamp     int64  `json:"timestamp"`
+	Signer        string `json:"signer,omitempty"`
+	TransactionId string `json:"transactionId,omitempty"`
+}
+
+// TransferEvents is the in-process event bus notifying subscribers about the progress of transfers
+type TransferEvents interface {
+	// Publish delivers the event to the subscribers of its transfer without blocking
+	Publish(event TransferEvent)
+	// Subscribe returns the events of the given transfer and a function cancelling the subscription.
+	// The channel is closed once the subscription is cancelled or the bus is closed
+	Subscribe(transferID string) (<-chan TransferEvent, func())
+	// Close closes all subscriptions. Events published afterwards are dropped
+	Close()
+}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -32,20 +33,34 @@ type Transfers interface {
 	InitiateNewTransfer(tm transfer.Transfer) (*entity.Transfer, error)
 	// ProcessNativeTransfer processes the native fungible transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeTransfer(tm transfer.Transfer) error
+	ProcessNativeTransfer(ctx context.Context, tm transfer.Transfer) error
 	// ProcessNativeNftTransfer processes the native nft transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeNftTransfer(tm transfer.Transfer) error
+	ProcessNativeNftTransfer(ctx context.Context, tm transfer.Transfer) error
 	// ProcessWrappedTransfer processes the wrapped transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessWrappedTransfer(tm transfer.Transfer) error
+	ProcessWrappedTransfer(ctx context.Context, tm transfer.Transfer) error
+	// ProcessWrappedNftTransfer processes the wrapped nft transfer message by burning the wrapped Hedera NFT,
+	// signing the required unlock authorisation signature and submitting it into the required HCS Topic
+	ProcessWrappedNftTransfer(ctx context.Context, tm transfer.Transfer) error
+	// ProcessErc1155Transfer processes the wrapped ERC-1155 transfer message by burning the wrapped HTS tokens of the batch,
+	// signing the required unlock authorisation signature and submitting it into the required HCS Topic
+	ProcessErc1155Transfer(ctx context.Context, tm transfer.Transfer) error
+	// LockedNftTokenId returns the token ID of the EVM native NFT, which is locked for the given wrapped Hedera NFT
+	LockedNftTokenId(wrappedAsset string, serialNum int64) (int64, error)
 	// TransferData returns from the database the given transfer, its signatures and
 	// calculates if its messages have reached super majority
 	TransferData(txId string) (interface{}, error)
+	// TransfersPage returns up to limit transfers matching the given filter, newest first.
+	// The page begins after the given cursor, if any, and holds the cursor of the next page.
+	TransfersPage(filter transfer.Filter, cursor string, limit int) (*TransfersPage, error)
+	// TransferTimeline returns the lifecycle events of the given transfer, oldest first
+	TransferTimeline(txId string) (*TransferTimeline, error)
 }
 
 type TransferData struct {
 	IsNft         bool     `json:"isNft"`
+	IsErc1155     bool     `json:"isErc1155,omitempty"`
 	Recipient     string   `json:"recipient"`
 	RouterAddress string   `json:"routerAddress"`
 	SourceChainId uint64   `json:"sourceChainId"`
@@ -63,7 +78,87 @@ type NonFungibleTransferData struct {
 	Metadata string `json:"metadata"`
 }
 
+// Erc1155TransferData holds the batch of an ERC-1155 transfer. The token IDs and amounts are in the order they are signed in
+type Erc1155TransferData struct {
+	TransferData
+	TokenIds []int64  `json:"tokenIds"`
+	Amounts  []string `json:"amounts"`
+}
+
 type FungibleTransferData struct {
 	TransferData
 	Amount string `json:"amount"`
 }
+
+// TransfersPage is a single page of listed transfers. Next is the cursor of the following page and is empty on the last page.
+type TransfersPage struct {
+	Transfers []TransferListItem `json:"transfers"`
+	Next      string             `json:"next,omitempty"`
+}
+
+type TransferListItem struct {
+	TransferData
+	TransactionId string         `json:"transactionId"`
+	Amount        string         `json:"amount,omitempty"`
+	TokenId       int64          `json:"tokenId,omitempty"`
+	Metadata      string         `json:"metadata,omitempty"`
+	TokenIds      []int64        `json:"tokenIds,omitempty"`
+	Amounts       []string       `json:"amounts,omitempty"`
+	Status        string         `json:"status"`
+	Fee           string         `json:"fee"`
+	CreatedAt     int64          `json:"createdAt"`
+	ConfigVersion string         `json:"configVersion,omitempty"`
+	Schedules     []ScheduleData `json:"schedules"`
+	// Majority hides the majority of the embedded TransferData, which is not checked for listed transfers
+	Majority *bool `json:"majority,omitempty"`
+}
+
+type ScheduleData struct {
+	TransactionId string `json:"transactionId"`
+	ScheduleId    string `json:"scheduleId"`
+	Operation     string `json:"operation"`
+	Status        string `json:"status"`
+}
+
+// The types of the events in the lifecycle of a transfer
+const (
+	// TimelineEventCreated is the recording of the transfer by the validator
+	TimelineEventCreated = "CREATED"
+	// TimelineEventSignature is a signature submitted to the HCS topic by a validator
+	TimelineEventSignature = "SIGNATURE"
+	// TimelineEventFee is a scheduled transaction paying out the validator fees
+	TimelineEventFee = "FEE"
+	// TimelineEventSchedule is a scheduled transaction minting, burning or transferring the asset
+	TimelineEventSchedule = "SCHEDULE"
+	// TimelineEventLock is the Lock or LockERC721 event the transfer originates from on an EVM network
+	TimelineEventLock = "LOCK"
+	// TimelineEventBurn is the Burn or BurnERC721 event the transfer originates from on an EVM network
+	TimelineEventBurn = "BURN"
+	// TimelineEventMint is the Mint event paying out the transfer on an EVM network
+	TimelineEventMint = "MINT"
+	// TimelineEventUnlock is the Unlock or UnlockERC721 event paying out the transfer on an EVM network
+	TimelineEventUnlock = "UNLOCK"
+)
+
+// TransferTimeline lists the lifecycle events of a transfer, oldest first
+type TransferTimeline struct {
+	TransactionId string          `json:"transactionId"`
+	Status        string          `json:"status"`
+	Events        []TimelineEvent `json:"events"`
+}
+
+// TimelineEvent is a single step in the lifecycle of a transfer.
+// The timestamp is in Unix nanoseconds and is 0 if unknown.
+type TimelineEvent struct {
+	Type            string `json:"type"`
+	Timestamp       int64  `json:"timestamp"`
+	Status          string `json:"status,omitempty"`
+	Signer          string `json:"signer,omitempty"`
+	TransactionId   string `json:"transactionId,omitempty"`
+	ScheduleId      string `json:"scheduleId,omitempty"`
+	Operation       string `json:"operation,omitempty"`
+	Amount          string `json:"amount,omitempty"`
+	ChainId         uint64 `json:"chainId,omitempty"`
+	TransactionHash string `json:"transactionHash,omitempty"`
+	BlockNumber     uint64 `json:"blockNumber,omitempty"`
+}
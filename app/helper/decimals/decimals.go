@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decimals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/wtoken"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"math/big"
+	"strconv"
+	"sync"
+)
+
+const hbarDecimals = 8
+
+// Cache reads the decimals of the fungible assets on Hedera and the EVM networks. Decimals are read once and cached
+type Cache struct {
+	mirrorNode client.MirrorNode
+	evmClients map[uint64]client.EVM
+	decimals   map[uint64]map[string]uint8
+	mutex      *sync.Mutex
+}
+
+func NewCache(mirrorNode client.MirrorNode, evmClients map[uint64]client.EVM) *Cache {
+	return &Cache{
+		mirrorNode: mirrorNode,
+		evmClients: evmClients,
+		decimals:   make(map[uint64]map[string]uint8),
+		mutex:      &sync.Mutex{},
+	}
+}
+
+// Get returns the decimals of the given asset on the given network
+func (c *Cache) Get(ctx context.Context, chainId uint64, asset string) (uint8, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if decimals, ok := c.decimals[chainId][asset]; ok {
+		return decimals, nil
+	}
+
+	decimals, err := c.read(ctx, chainId, asset)
+	if err != nil {
+		return 0, err
+	}
+
+	if c.decimals[chainId] == nil {
+		c.decimals[chainId] = make(map[string]uint8)
+	}
+	c.decimals[chainId][asset] = decimals
+	return decimals, nil
+}
+
+func (c *Cache) read(ctx context.Context, chainId uint64, asset string) (uint8, error) {
+	if chainId == constants.HederaNetworkId && asset == constants.Hbar {
+		return hbarDecimals, nil
+	}
+
+	if chainId == constants.HederaNetworkId {
+		token, err := c.mirrorNode.GetToken(ctx, asset)
+		if err != nil {
+			return 0, err
+		}
+		parsed, err := strconv.ParseUint(token.Decimals, 10, 8)
+		if err != nil {
+			return 0, err
+		}
+		return uint8(parsed), nil
+	}
+
+	evmClient, ok := c.evmClients[chainId]
+	if !ok {
+		return 0, errors.New(fmt.Sprintf("no EVM client for chain [%d]", chainId))
+	}
+	token, err := wtoken.NewWtoken(common.HexToAddress(asset), evmClient.GetClient())
+	if err != nil {
+		return 0, err
+	}
+	return token.Decimals(&bind.CallOpts{Context: ctx})
+}
+
+// Scale converts the amount from the given decimals to the target decimals
+func Scale(amount *big.Int, decimals, targetDecimals uint8) *big.Int {
+	if decimals == targetDecimals {
+		return amount
+	}
+	if decimals < targetDecimals {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(targetDecimals-decimals)), nil)
+		return new(big.Int).Mul(amount, factor)
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-targetDecimals)), nil)
+	return new(big.Int).Quo(amount, factor)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package decimals
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func Test_Get_Hbar(t *testing.T) {
+	mocks.Setup()
+	c := NewCache(mocks.MHederaMirrorClient, map[uint64]client.EVM{})
+
+	decimals, err := c.Get(context.Background(), constants.HederaNetworkId, constants.Hbar)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(8), decimals)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetToken", constants.Hbar)
+}
+
+func Test_Get_Cached(t *testing.T) {
+	mocks.Setup()
+	c := NewCache(mocks.MHederaMirrorClient, map[uint64]client.EVM{})
+	mocks.MHederaMirrorClient.On("GetToken", "0.0.1").Return(&model.TokenResponse{Decimals: "6"}, nil)
+
+	c.Get(context.Background(), constants.HederaNetworkId, "0.0.1")
+	decimals, err := c.Get(context.Background(), constants.HederaNetworkId, "0.0.1")
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(6), decimals)
+	mocks.MHederaMirrorClient.AssertNumberOfCalls(t, "GetToken", 1)
+}
+
+func Test_Get_Fails(t *testing.T) {
+	mocks.Setup()
+	c := NewCache(mocks.MHederaMirrorClient, map[uint64]client.EVM{})
+	expectedErr := errors.New("some-error")
+	mocks.MHederaMirrorClient.On("GetToken", "0.0.1").Return((*model.TokenResponse)(nil), expectedErr)
+
+	_, err := c.Get(context.Background(), constants.HederaNetworkId, "0.0.1")
+	assert.Equal(t, expectedErr, err)
+
+	_, err = c.Get(context.Background(), 80001, "0x0000000000000000000000000000000000000001")
+	assert.Error(t, err)
+}
+
+func Test_Scale(t *testing.T) {
+	assert.Equal(t, big.NewInt(1000), Scale(big.NewInt(1), 8, 11))
+	assert.Equal(t, big.NewInt(1), Scale(big.NewInt(1999), 11, 8))
+	assert.Equal(t, big.NewInt(5), Scale(big.NewInt(5), 8, 8))
+}
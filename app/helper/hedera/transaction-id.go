@@ -17,8 +17,10 @@
 package hedera
 
 import (
+	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"strings"
 )
 
@@ -37,6 +39,16 @@ func ToMirrorNodeTransactionID(txId string) string {
 		fmt.Sprintf("%09s", split[1]))
 }
 
+// TimestampFromMirrorNodeTransactionID parses the valid start of TX with format `0.0.X-{seconds}-{nanos}` into int64 timestamp
+func TimestampFromMirrorNodeTransactionID(txId string) (int64, error) {
+	split := strings.Split(txId, "-")
+	if len(split) != 3 {
+		return 0, errors.New("invalid transaction id provided")
+	}
+
+	return timestamp.FromString(fmt.Sprintf("%s.%s", split[1], split[2]))
+}
+
 func FromHederaTransactionID(id hedera.TransactionID) HederaTransactionID {
 	stringTxId := id.String()
 	split := strings.Split(stringTxId, "@")
@@ -36,6 +36,17 @@ func Test_ToMirrorNodeTransactionID(t *testing.T) {
 	assert.Equal(t, expectedTransactionID, res)
 }
 
+func Test_TimestampFromMirrorNodeTransactionID(t *testing.T) {
+	res, err := TimestampFromMirrorNodeTransactionID(expectedTransactionID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1598924675082525000), res)
+}
+
+func Test_TimestampFromMirrorNodeTransactionID_Invalid(t *testing.T) {
+	_, err := TimestampFromMirrorNodeTransactionID("0x1234-1")
+	assert.EqualError(t, err, "invalid transaction id provided")
+}
+
 func Test_FromHederaTransactionID(t *testing.T) {
 	hederaTransactionID, err := hedera.TransactionIdFromString(transactionID)
 
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"io/ioutil"
+)
+
+// ReadEVM decrypts the EVM private key from the Ethereum V3 keystore file at the given path
+func ReadEVM(path, passphrase string) (*ecdsa.PrivateKey, error) {
+	keyJson, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PrivateKey, nil
+}
+
+// NewEVM encrypts the given EVM private key into an Ethereum V3 keystore
+func NewEVM(privateKey *ecdsa.PrivateKey, passphrase string) ([]byte, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	return keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// ReadHedera decrypts the Hedera private key from the Hedera SDK keystore file at the given path
+func ReadHedera(path, passphrase string) (hedera.PrivateKey, error) {
+	ks, err := ioutil.ReadFile(path)
+	if err != nil {
+		return hedera.PrivateKey{}, err
+	}
+
+	return hedera.PrivateKeyFromKeystore(ks, passphrase)
+}
+
+// NewHedera encrypts the given Hedera private key into a Hedera SDK keystore
+func NewHedera(privateKey hedera.PrivateKey, passphrase string) ([]byte, error) {
+	return privateKey.Keystore(passphrase)
+}
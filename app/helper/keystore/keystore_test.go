@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const passphrase = "passphrase"
+
+// writeTemp writes the content to a temporary file. The returned function removes it
+func writeTemp(t *testing.T, content []byte) (string, func()) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, "key.json")
+	err = ioutil.WriteFile(path, content, 0600)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return path, cleanup
+}
+
+func Test_EVM(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	keyJson, err := NewEVM(privateKey, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, cleanup := writeTemp(t, keyJson)
+	defer cleanup()
+
+	decrypted, err := ReadEVM(path, passphrase)
+
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.FromECDSA(privateKey), crypto.FromECDSA(decrypted))
+}
+
+func Test_EVM_WrongPassphrase(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	keyJson, err := NewEVM(privateKey, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, cleanup := writeTemp(t, keyJson)
+	defer cleanup()
+
+	decrypted, err := ReadEVM(path, "wrong")
+
+	assert.Nil(t, decrypted)
+	assert.NotNil(t, err)
+}
+
+func Test_EVM_MissingFile(t *testing.T) {
+	decrypted, err := ReadEVM("missing.json", passphrase)
+
+	assert.Nil(t, decrypted)
+	assert.NotNil(t, err)
+}
+
+func Test_Hedera(t *testing.T) {
+	privateKey, _ := hedera.GeneratePrivateKey()
+	ks, err := NewHedera(privateKey, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, cleanup := writeTemp(t, ks)
+	defer cleanup()
+
+	decrypted, err := ReadHedera(path, passphrase)
+
+	assert.Nil(t, err)
+	assert.Equal(t, privateKey.String(), decrypted.String())
+}
+
+func Test_Hedera_WrongPassphrase(t *testing.T) {
+	privateKey, _ := hedera.GeneratePrivateKey()
+	ks, err := NewHedera(privateKey, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, cleanup := writeTemp(t, ks)
+	defer cleanup()
+
+	_, err = ReadHedera(path, "wrong")
+
+	assert.NotNil(t, err)
+}
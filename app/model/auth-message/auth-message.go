@@ -17,6 +17,7 @@
 package auth_message
 
 import (
+	"errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -71,6 +72,86 @@ func EncodeNftBytesFrom(sourceChainId, targetChainId uint64, txId, asset string,
 	return keccak(bytesToHash), nil
 }
 
+// EncodeErc1155BytesFrom encodes the batch of ERC-1155 token IDs and their amounts at the same positions
+func EncodeErc1155BytesFrom(sourceChainId, targetChainId uint64, txId, asset string, tokenIds []int64, amounts []string, receiverEthAddress string) ([]byte, error) {
+	if len(tokenIds) != len(amounts) {
+		return nil, errors.New("token ids and amounts lengths mismatch")
+	}
+	args, err := generateErc1155Arguments()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdsBn := make([]*big.Int, len(tokenIds))
+	amountsBn := make([]*big.Int, len(amounts))
+	for i := range tokenIds {
+		tokenIdsBn[i] = big.NewInt(tokenIds[i])
+		amountsBn[i], err = big_numbers.ToBigInt(amounts[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bytesToHash, err := args.Pack(
+		new(big.Int).SetUint64(sourceChainId),
+		new(big.Int).SetUint64(targetChainId),
+		[]byte(txId),
+		common.HexToAddress(asset),
+		tokenIdsBn,
+		amountsBn,
+		common.HexToAddress(receiverEthAddress))
+	if err != nil {
+		return nil, err
+	}
+	return keccak(bytesToHash), nil
+}
+
+func generateErc1155Arguments() (abi.Arguments, error) {
+	bytesType, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uint256ArrayType, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addressType, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{
+		{
+			Type: uint256Type,
+		},
+		{
+			Type: uint256Type,
+		},
+		{
+			Type: bytesType,
+		},
+		{
+			Type: addressType,
+		},
+		{
+			Type: uint256ArrayType,
+		},
+		{
+			Type: uint256ArrayType,
+		},
+		{
+			Type: addressType,
+		},
+	}, nil
+}
+
 func generateNftArguments() (abi.Arguments, error) {
 	bytesType, err := abi.NewType("bytes", "", nil)
 	if err != nil {
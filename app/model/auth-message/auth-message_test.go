@@ -40,3 +40,45 @@ func Test_EncodeFungibleBytesFromWorks(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, actualResult)
 }
+
+func Test_EncodeErc1155BytesFromWorks(t *testing.T) {
+	actualResult, err := EncodeErc1155BytesFrom(
+		sourceChainId,
+		targetChainId,
+		txId,
+		asset,
+		[]int64{1, 2},
+		[]string{amount, amount},
+		receiverAddress)
+
+	assert.Nil(t, err)
+	assert.Len(t, actualResult, 32)
+}
+
+func Test_EncodeErc1155BytesFromWithInvalidAmount(t *testing.T) {
+	actualResult, err := EncodeErc1155BytesFrom(
+		sourceChainId,
+		targetChainId,
+		txId,
+		asset,
+		[]int64{1},
+		[]string{invalidAmount},
+		receiverAddress)
+
+	assert.Error(t, err)
+	assert.Nil(t, actualResult)
+}
+
+func Test_EncodeErc1155BytesFromWithLengthsMismatch(t *testing.T) {
+	actualResult, err := EncodeErc1155BytesFrom(
+		sourceChainId,
+		targetChainId,
+		txId,
+		asset,
+		[]int64{1, 2},
+		[]string{amount},
+		receiverAddress)
+
+	assert.Error(t, err)
+	assert.Nil(t, actualResult)
+}
@@ -41,6 +41,8 @@ func FromBytes(data []byte) (*Message, error) {
 		return &Message{TopicMessage: msg}, nil
 	case *model.TopicMessage_FungibleSignatureMessage:
 		return &Message{TopicMessage: msg}, nil
+	case *model.TopicMessage_Erc1155SignatureMessage:
+		return &Message{TopicMessage: msg}, nil
 	default: // try to parse it to backward compatible type
 		oldFungibleMessage := &model.TopicEthSignatureMessage{}
 		err = proto.Unmarshal(data, oldFungibleMessage)
@@ -92,6 +94,11 @@ func NewNftSignature(topicMsg *model.TopicEthNftSignatureMessage) *Message {
 	return &Message{TopicMessage: &model.TopicMessage{Message: &model.TopicMessage_NftSignatureMessage{NftSignatureMessage: topicMsg}}}
 }
 
+// NewErc1155Signature instantiates Signature Message struct ready for submission to the Bridge Topic
+func NewErc1155Signature(topicMsg *model.TopicEthErc1155SignatureMessage) *Message {
+	return &Message{TopicMessage: &model.TopicMessage{Message: &model.TopicMessage_Erc1155SignatureMessage{Erc1155SignatureMessage: topicMsg}}}
+}
+
 // ToBytes marshals the underlying protobuf Message into bytes
 func (tm *Message) ToBytes() ([]byte, error) {
 	return proto.Marshal(tm.TopicMessage)
@@ -78,6 +78,28 @@ func Test_NewSignatureWorks(t *testing.T) {
 	signatureEqualFields(t, expectedSignature(), actualSignature.TopicMessage.GetFungibleSignatureMessage())
 }
 
+func Test_NewErc1155SignatureRoundTrip(t *testing.T) {
+	topicMsg := &model.TopicEthErc1155SignatureMessage{
+		SourceChainId: 0,
+		TargetChainId: 1,
+		TransferID:    "0.0.123321-123321-420",
+		Asset:         "0xasset",
+		TokenIds:      []uint64{1, 2},
+		Amounts:       []string{"10", "20"},
+		Recipient:     "0xsomereceiver",
+		Signature:     "somesigneddatahere",
+	}
+	bytes, err := NewErc1155Signature(topicMsg).ToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := FromBytes(bytes)
+
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(topicMsg, actual.GetErc1155SignatureMessage()))
+}
+
 func Test_FromStringWithInvalidTS(t *testing.T) {
 	result, err := FromString(invalidStringData, invalidStringTs)
 	assert.Nil(t, result)
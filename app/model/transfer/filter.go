@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+// Filter narrows down the transfers returned when listing transfers.
+// Unset fields do not filter the transfers.
+type Filter struct {
+	SourceChainId *uint64
+	TargetChainId *uint64
+	// Asset matches the source, target or native asset of the transfers
+	Asset    string
+	Receiver string
+	Status   string
+	IsNft    *bool
+	// From is the earliest creation time (inclusive) in nanoseconds
+	From int64
+	// To is the latest creation time (exclusive) in nanoseconds
+	To int64
+}
+
+// Cursor is the position of the last listed transfer, after which the next page begins
+type Cursor struct {
+	CreatedAt     int64  `json:"createdAt"`
+	TransactionId string `json:"transactionId"`
+}
@@ -31,6 +31,19 @@ type Transfer struct {
 	Metadata      string
 	IsNft         bool
 	Timestamp     string
+	ConfigVersion string
+	// Serial number of the wrapped Hedera NFT, representing an EVM native NFT with token ID SerialNum
+	WrappedSerialNum int64
+	IsErc1155        bool
+	// Token IDs and amounts of an ERC-1155 batch. The assets of the transfer are the ERC-1155 contract
+	Erc1155Items []Erc1155Item
+}
+
+// Erc1155Item is a single token ID of an ERC-1155 batch and the HTS token, which wraps it on Hedera
+type Erc1155Item struct {
+	TokenId      int64
+	Amount       string
+	WrappedAsset string
 }
 
 // New instantiates Transfer struct ready for submission to the handler
@@ -69,3 +82,30 @@ func NewNft(
 		IsNft:         true,
 	}
 }
+
+// NewErc1155 instantiates a Transfer of a batch of ERC-1155 token IDs and their amounts
+func NewErc1155(
+	txId string,
+	sourceChainId, targetChainId, nativeChainId uint64, receiver, asset string, items []Erc1155Item) *Transfer {
+	return &Transfer{
+		TransactionId: txId,
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: nativeChainId,
+		SourceAsset:   asset,
+		TargetAsset:   asset,
+		NativeAsset:   asset,
+		Receiver:      receiver,
+		IsErc1155:     true,
+		Erc1155Items:  items,
+	}
+}
+
+// Erc1155Batch returns the token IDs and amounts of the ERC-1155 batch
+func (t Transfer) Erc1155Batch() (tokenIds []int64, amounts []string) {
+	for _, item := range t.Erc1155Items {
+		tokenIds = append(tokenIds, item.TokenId)
+		amounts = append(amounts, item.Amount)
+	}
+	return tokenIds, amounts
+}
@@ -39,3 +39,18 @@ func Test_New(t *testing.T) {
 		amount)
 	assert.Equal(t, expectedTransfer, actualTransfer)
 }
+
+func Test_NewErc1155(t *testing.T) {
+	items := []Erc1155Item{
+		{TokenId: 1, Amount: "10", WrappedAsset: "0.0.5001"},
+		{TokenId: 2, Amount: "20", WrappedAsset: "0.0.5002"},
+	}
+
+	actualTransfer := NewErc1155(txId, sourceChainId, targetChainId, sourceChainId, receiver, sourceAsset, items)
+	tokenIds, amounts := actualTransfer.Erc1155Batch()
+
+	assert.True(t, actualTransfer.IsErc1155)
+	assert.Equal(t, sourceAsset, actualTransfer.TargetAsset)
+	assert.Equal(t, []int64{1, 2}, tokenIds)
+	assert.Equal(t, []string{"10", "20"}, amounts)
+}
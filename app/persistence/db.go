@@ -18,7 +18,7 @@ package persistence
 
 import (
 	"fmt"
-	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
@@ -35,6 +35,15 @@ func (db *Database) GetConnection() *gorm.DB {
 	return db.connection
 }
 
+// Close closes the underlying connection pool
+func (db *Database) Close() error {
+	sqlDB, err := db.connection.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
 func NewDatabase(config config.Database) *Database {
 	return &Database{
 		connection: ConnectWithMigration(config),
@@ -43,24 +52,34 @@ func NewDatabase(config config.Database) *Database {
 
 // Establish connection to the Postgres Database
 func Connect(dbConfig config.Database) *gorm.DB {
-	connectionStr := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", dbConfig.Host, dbConfig.Port, dbConfig.Username, dbConfig.Name, dbConfig.Password)
-
-	db := tryConnection(connectionStr)
+	db := tryConnection(connectionString(dbConfig))
 	log.Infoln("Successfully connected to Database")
 
 	return db
 }
 
-// TryConnection, tries to connect to the database associated to the validator node. If it fails, it retries after 10 seconds.
-// This function will try to reconnect until it succeeds or the validator node gets stopped manually
-func tryConnection(connectionStr string) *gorm.DB {
-	db, err := gorm.Open(
+// Open connects to the Postgres Database once. Contrary to Connect, a failed connection is returned as an error
+func Open(dbConfig config.Database) (*gorm.DB, error) {
+	return open(connectionString(dbConfig))
+}
+
+func connectionString(dbConfig config.Database) string {
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", dbConfig.Host, dbConfig.Port, dbConfig.Username, dbConfig.Name, dbConfig.Password)
+}
+
+func open(connectionStr string) (*gorm.DB, error) {
+	return gorm.Open(
 		postgres.Open(connectionStr),
 		&gorm.Config{
 			Logger: logger.Default.LogMode(logger.Silent),
 		},
 	)
+}
 
+// TryConnection, tries to connect to the database associated to the validator node. If it fails, it retries after 10 seconds.
+// This function will try to reconnect until it succeeds or the validator node gets stopped manually
+func tryConnection(connectionStr string) *gorm.DB {
+	db, err := open(connectionStr)
 	if err != nil {
 		log.Error(err)
 		time.Sleep(10 * time.Second)
@@ -70,18 +89,30 @@ func tryConnection(connectionStr string) *gorm.DB {
 	return db
 }
 
+// NewMigrator creates a migrator of the validator schema for the given connection
+func NewMigrator(db *gorm.DB) (*migrations.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(sqlDB, embedded), nil
+}
+
 // Migrate tables
 func migrateDb(db *gorm.DB) {
-	err := db.AutoMigrate(
-		entity.Transfer{},
-		entity.Fee{},
-		entity.Message{},
-		entity.Schedule{},
-		entity.Status{})
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	count, err := migrator.Up()
 	if err != nil {
 		log.Fatal(err)
 	}
-	log.Println("Migrations passed successfully")
+	log.Infof("Migrations passed successfully. Applied [%d] migration(s).", count)
 }
 
 // Connect and Migrate
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Dead Letter Repository"),
+	}
+}
+
+func (r Repository) Create(deadLetter *entity.DeadLetter) error {
+	return r.dbClient.Create(deadLetter).Error
+}
+
+func (r Repository) GetAll() ([]*entity.DeadLetter, error) {
+	var deadLetters []*entity.DeadLetter
+	err := r.dbClient.
+		Order("id").
+		Find(&deadLetters).
+		Error
+	return deadLetters, err
+}
+
+// Returns DeadLetter. Returns nil if not found
+func (r Repository) Get(id uint64) (*entity.DeadLetter, error) {
+	record := &entity.DeadLetter{}
+
+	result := r.dbClient.
+		Model(entity.DeadLetter{}).
+		Where("id = ?", id).
+		First(record)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return record, nil
+}
+
+func (r Repository) Delete(id uint64) error {
+	return r.dbClient.Delete(&entity.DeadLetter{}, id).Error
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// DeadLetter is a db model used to persist the messages whose handler failed repeatedly, until an operator replays or discards them
+type DeadLetter struct {
+	ID          uint64 `gorm:"primaryKey"`
+	Topic       string
+	PayloadType string
+	Payload     string
+	Error       string
+	Attempts    int
+	CreatedAt   int64 // Unix nanoseconds at which the message was dead-lettered
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// The names of the EVM events paying out transfers
+const (
+	EvmEventMint          = "mint"
+	EvmEventUnlock        = "unlock"
+	EvmEventUnlockERC721  = "unlock_erc721"
+	EvmEventUnlockERC1155 = "unlock_erc1155"
+)
+
+// The names of the EVM events transfers originate from
+const (
+	EvmEventLock        = "lock"
+	EvmEventBurn        = "burn"
+	EvmEventBurnERC721  = "burn_erc721"
+	EvmEventLockERC721  = "lock_erc721"
+	EvmEventLockERC1155 = "lock_erc1155"
+)
+
+// EvmEvent is a db model used to track the events observed on EVM networks, which originate or pay out a given transfer
+type EvmEvent struct {
+	TransactionHash string `gorm:"primaryKey"`
+	LogIndex        uint   `gorm:"primaryKey"`
+	TransferID      string `gorm:"index"`
+	Name            string // the name of the event (mint, unlock, unlock_erc721, unlock_erc1155, lock, burn, burn_erc721, lock_erc721, lock_erc1155)
+	ChainID         uint64 `gorm:"index:idx_evm_events_chain_block"`
+	BlockNumber     uint64 `gorm:"index:idx_evm_events_chain_block"`
+	BlockHash       string
+	Timestamp       int64 // Unix nanoseconds of the block including the event
+	Orphaned        bool  // set once the block including the event is no longer part of the canonical chain
+}
+
+// IsSource returns whether a transfer originates from the event
+func (e EvmEvent) IsSource() bool {
+	return e.Name == EvmEventLock || e.Name == EvmEventBurn || e.Name == EvmEventBurnERC721 ||
+		e.Name == EvmEventLockERC721 || e.Name == EvmEventLockERC1155
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// Pause scopes
+const (
+	// PauseGlobal suspends all transfers. Its value is empty
+	PauseGlobal = "GLOBAL"
+	// PauseChain suspends the transfers from or to the chain with the given id
+	PauseChain = "CHAIN"
+	// PauseAsset suspends the transfers of the given source, target or native asset
+	PauseAsset = "ASSET"
+)
+
+// Pause is a db model used to persist the scopes, in which signing, topic submission and scheduled transaction execution are suspended
+type Pause struct {
+	Scope     string `gorm:"primaryKey"`
+	Value     string `gorm:"primaryKey"`
+	CreatedAt int64  // Unix nanoseconds at which the scope was paused
+}
+
+// PausedMessage is a db model used to persist the message of a paused transfer, until its pause is lifted
+type PausedMessage struct {
+	TransferID  string `gorm:"primaryKey"`
+	Topic       string
+	PayloadType string
+	Payload     string
+	CreatedAt   int64 // Unix nanoseconds at which the message was deferred
+}
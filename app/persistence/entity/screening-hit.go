@@ -0,0 +1,31 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+const (
+	ScreeningRoleSender   = "SENDER"
+	ScreeningRoleReceiver = "RECEIVER"
+)
+
+// ScreeningHit is a db model used to audit the transfers blocked, because their sender or receiver is on the screening list
+type ScreeningHit struct {
+	ID         uint64 `gorm:"primaryKey"`
+	TransferID string `gorm:"index"`
+	Party      string // The listed Hedera account ID or EVM address
+	Role       string // Whether the party is the sender or the receiver of the transfer
+	CreatedAt  int64  // Unix nanoseconds at which the transfer was blocked
+}
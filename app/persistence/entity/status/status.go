@@ -28,4 +28,13 @@ const (
 	Failed = "FAILED"
 	// Submitted is set when a pending Fee/Schedule operation is created.
 	Submitted = "SUBMITTED"
+	// Revoked is set once the EVM event a transfer originates from is orphaned by a chain reorganisation.
+	// The transfer is reinstated if the event is included in the canonical chain again
+	Revoked = "REVOKED"
+	// PendingReview is set once a transfer exceeds the amount limits of its asset.
+	// The transfer is processed only once an operator approves it and fails if an operator rejects it
+	PendingReview = "PENDING_REVIEW"
+	// Blocked is set once the sender or receiver of a transfer is found on the screening list.
+	// This is a terminal status
+	Blocked = "BLOCKED"
 )
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// SupplySnapshot is a db model used to keep the history of the supply reconciliation of a native fungible asset.
+// All amounts are in the lowest denomination of the native asset
+type SupplySnapshot struct {
+	ID         uint64 `gorm:"primaryKey"`
+	ChainID    uint64 `gorm:"index:idx_supply_snapshots_asset"` // The chain of the native asset
+	Asset      string `gorm:"index:idx_supply_snapshots_asset"` // The native asset
+	Locked     string // The balance of the native asset, held by the bridge account or the router contract
+	Supply     string // The sum of the total supplies of the wrapped assets on every chain
+	InFlight   string // The sum of the amounts of the recorded transfers, which are not paid out yet
+	Difference string // Locked minus Supply minus InFlight
+	Diverged   bool   // Whether the difference exceeds the tolerance
+	CreatedAt  int64  // Unix nanoseconds at which the snapshot was taken
+}
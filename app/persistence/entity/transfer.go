@@ -33,9 +33,24 @@ type Transfer struct {
 	SerialNumber  int64
 	Metadata      string
 	IsNft         bool       `gorm:"default:false"`
+	CreatedAt     int64      `gorm:"index"` // Unix nanoseconds at which the transfer was recorded
+	ConfigVersion string     // Version of the bridge configuration, which was active when the transfer was recorded
 	Messages      []Message  `gorm:"foreignKey:TransferID"`
 	Fees          []Fee      `gorm:"foreignKey:TransferID"`
 	Schedules     []Schedule `gorm:"foreignKey:TransferID"`
+	// Serial number of the wrapped Hedera NFT, representing the EVM native NFT with token ID SerialNumber
+	WrappedSerialNumber int64
+	IsErc1155           bool          `gorm:"default:false"`
+	Erc1155Items        []Erc1155Item `gorm:"foreignKey:TransferID"`
+}
+
+// Erc1155Item is a db model used to track the token IDs and amounts of an ERC-1155 batch transfer in their original order
+type Erc1155Item struct {
+	ID           uint64 `gorm:"primaryKey"`
+	TransferID   string `gorm:"index"`
+	TokenID      int64
+	Amount       string
+	WrappedAsset string // HTS token, which wraps the token ID on Hedera
 }
 
 // Message is a db model used to track the messages signed by validators for a given transfer
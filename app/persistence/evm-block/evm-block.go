@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm_block
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("EVM Block Repository"),
+	}
+}
+
+// Create persists the hash of the given block, overriding the previously persisted one
+func (r Repository) Create(block *entity.EvmBlock) error {
+	return r.dbClient.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_id"}, {Name: "number"}},
+			DoUpdates: clause.AssignmentColumns([]string{"hash"}),
+		}).
+		Create(block).
+		Error
+}
+
+// GetLatest returns up to limit blocks of the given entity, newest first
+func (r Repository) GetLatest(entityID string, limit int) ([]*entity.EvmBlock, error) {
+	var blocks []*entity.EvmBlock
+	err := r.dbClient.
+		Where("entity_id = ?", entityID).
+		Order("number desc").
+		Limit(limit).
+		Find(&blocks).
+		Error
+	return blocks, err
+}
+
+// DeleteAfter deletes the blocks of the given entity with a number greater than the given one
+func (r Repository) DeleteAfter(entityID string, number int64) error {
+	return r.dbClient.
+		Where("entity_id = ? AND number > ?", entityID, number).
+		Delete(&entity.EvmBlock{}).
+		Error
+}
+
+// DeleteBefore deletes the blocks of the given entity with a number less than the given one
+func (r Repository) DeleteBefore(entityID string, number int64) error {
+	return r.dbClient.
+		Where("entity_id = ? AND number < ?", entityID, number).
+		Delete(&entity.EvmBlock{}).
+		Error
+}
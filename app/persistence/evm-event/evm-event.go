@@ -0,0 +1,79 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package evm_event
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("EVM Event Repository"),
+	}
+}
+
+// Create persists the given event. Events which were already persisted are moved to the block of the given one.
+func (r Repository) Create(event *entity.EvmEvent) error {
+	return r.dbClient.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "transaction_hash"}, {Name: "log_index"}},
+			DoUpdates: clause.AssignmentColumns([]string{"block_number", "block_hash", "timestamp", "orphaned"}),
+		}).
+		Create(event).
+		Error
+}
+
+// GetByTransferID returns the events observed for the given transfer, oldest first
+func (r Repository) GetByTransferID(transferID string) ([]*entity.EvmEvent, error) {
+	var events []*entity.EvmEvent
+	err := r.dbClient.
+		Where("transfer_id = ?", transferID).
+		Order("timestamp").
+		Find(&events).
+		Error
+	return events, err
+}
+
+// GetFromBlock returns the events, which are not orphaned, observed on the given chain from the given block onwards
+func (r Repository) GetFromBlock(chainID, blockNumber uint64) ([]*entity.EvmEvent, error) {
+	var events []*entity.EvmEvent
+	err := r.dbClient.
+		Where("chain_id = ? AND block_number >= ? AND orphaned = ?", chainID, blockNumber, false).
+		Order("block_number").
+		Find(&events).
+		Error
+	return events, err
+}
+
+// MarkOrphaned marks the given event as no longer part of the canonical chain
+func (r Repository) MarkOrphaned(transactionHash string, logIndex uint) error {
+	return r.dbClient.
+		Model(entity.EvmEvent{}).
+		Where("transaction_hash = ? AND log_index = ?", transactionHash, logIndex).
+		UpdateColumn("orphaned", true).
+		Error
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	upSuffix   = ".up.sql"
+	downSuffix = ".down.sql"
+)
+
+// files holds the migrations of the validator schema. Every migration consists of a
+// <version>_<name>.up.sql and a <version>_<name>.down.sql file
+//
+//go:embed sql/*.sql
+var files embed.FS
+
+// Migration is a versioned change of the schema, along with the statements reverting it
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load reads the migrations in the root of the given file system, ordered by version
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		fileName := entry.Name()
+
+		var base string
+		var up bool
+		switch {
+		case strings.HasSuffix(fileName, upSuffix):
+			base, up = strings.TrimSuffix(fileName, upSuffix), true
+		case strings.HasSuffix(fileName, downSuffix):
+			base = strings.TrimSuffix(fileName, downSuffix)
+		default:
+			return nil, errors.New(fmt.Sprintf("migration file [%s] must end with [%s] or [%s]", fileName, upSuffix, downSuffix))
+		}
+
+		parts := strings.SplitN(base, "_", 2)
+		version, err := strconv.ParseUint(parts[0], 10, 64)
+		if len(parts) != 2 || parts[1] == "" || err != nil || version == 0 {
+			return nil, errors.New(fmt.Sprintf("migration file [%s] must be named <version>_<name>, with a positive version", fileName))
+		}
+
+		content, err := fs.ReadFile(fsys, fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: parts[1]}
+			byVersion[version] = migration
+		}
+		if migration.Name != parts[1] {
+			return nil, errors.New(fmt.Sprintf("migrations [%s] and [%s] share version [%d]", migration.Name, parts[1], version))
+		}
+		if up {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, errors.New(fmt.Sprintf("migration [%d_%s] must have both an up and a down file", migration.Version, migration.Name))
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Embedded returns the migrations of the validator schema, ordered by version
+func Embedded() ([]Migration, error) {
+	fsys, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return Load(fsys)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func file(content string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(content)}
+}
+
+func Test_Load(t *testing.T) {
+	migrations, err := Load(fstest.MapFS{
+		"0002_add_column.up.sql":   file("ALTER TABLE a ADD COLUMN b text;"),
+		"0002_add_column.down.sql": file("ALTER TABLE a DROP COLUMN b;"),
+		"0001_baseline.up.sql":     file("CREATE TABLE a (id bigint);"),
+		"0001_baseline.down.sql":   file("DROP TABLE a;"),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Migration{
+		{Version: 1, Name: "baseline", Up: "CREATE TABLE a (id bigint);", Down: "DROP TABLE a;"},
+		{Version: 2, Name: "add_column", Up: "ALTER TABLE a ADD COLUMN b text;", Down: "ALTER TABLE a DROP COLUMN b;"},
+	}, migrations)
+}
+
+func Test_Load_Invalid(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"missing down":       {"0001_baseline.up.sql": file("SELECT 1;")},
+		"missing up":         {"0001_baseline.down.sql": file("SELECT 1;")},
+		"unknown suffix":     {"0001_baseline.sql": file("SELECT 1;")},
+		"missing name":       {"0001.up.sql": file("SELECT 1;"), "0001.down.sql": file("SELECT 1;")},
+		"invalid version":    {"first_baseline.up.sql": file("SELECT 1;"), "first_baseline.down.sql": file("SELECT 1;")},
+		"zero version":       {"0000_baseline.up.sql": file("SELECT 1;"), "0000_baseline.down.sql": file("SELECT 1;")},
+		"duplicated version": {"0001_a.up.sql": file("SELECT 1;"), "0001_a.down.sql": file("SELECT 1;"), "0001_b.up.sql": file("SELECT 1;"), "0001_b.down.sql": file("SELECT 1;")},
+	} {
+		_, err := Load(fsys)
+		assert.Error(t, err, name)
+	}
+}
+
+func Test_Embedded(t *testing.T) {
+	migrations, err := Embedded()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), migrations[0].Version)
+	assert.Equal(t, "baseline", migrations[0].Name)
+	for _, table := range []string{
+		"transfers", "erc1155_items", "fees", "messages", "schedules", "statuses", "queue_messages", "dead_letters",
+		"reviews", "pauses", "paused_messages", "screening_hits", "supply_snapshots", "evm_events", "evm_blocks",
+	} {
+		assert.True(t, strings.Contains(migrations[0].Up, `CREATE TABLE IF NOT EXISTS "`+table+`"`), table)
+		assert.True(t, strings.Contains(migrations[0].Down, `DROP TABLE IF EXISTS "`+table+`"`), table)
+	}
+	assert.Equal(t, uint64(2), migrations[1].Version)
+	assert.Equal(t, "backfill_transfers_created_at", migrations[1].Name)
+	// Only the transfers without a creation time are backfilled
+	assert.Equal(t, strings.Count(migrations[1].Up, "UPDATE"), strings.Count(migrations[1].Up, `"created_at" IS NULL`))
+	assert.Equal(t, uint64(3), migrations[2].Version)
+	assert.Equal(t, "track_queue_message_leases", migrations[2].Name)
+	assert.True(t, strings.Contains(migrations[2].Up, `ADD COLUMN IF NOT EXISTS "leased"`))
+	assert.True(t, strings.Contains(migrations[2].Down, `DROP COLUMN IF EXISTS "leased"`))
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"sort"
+	"time"
+)
+
+// lockKey identifies the Postgres advisory lock held while migrating, so that nodes sharing
+// a database do not apply the same migrations concurrently
+const lockKey = 4809124570
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS "schema_migrations" (
+    "version" bigint PRIMARY KEY,
+    "name" text NOT NULL,
+    "applied_at" bigint NOT NULL
+)`
+
+// Status is the state of a migration in the database
+type Status struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt int64 // Unix nanoseconds at which the migration was applied
+	Known     bool  // false if the migration was applied by another release and is missing in this one
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     *log.Entry
+}
+
+// NewMigrator creates a Migrator applying the given migrations. The migrations must be ordered by version
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     config.GetLoggerFor("Migrator"),
+	}
+}
+
+// Up applies all pending migrations in order, each in its own transaction. Returns the number of applied migrations
+func (m *Migrator) Up() (int, error) {
+	count := 0
+	err := m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			m.logger.Infof("Applying migration [%d_%s].", migration.Version, migration.Name)
+			err := m.apply(conn, migration.Up, func(tx *sql.Tx) error {
+				_, err := tx.Exec(`INSERT INTO "schema_migrations" ("version", "name", "applied_at") VALUES ($1, $2, $3)`,
+					migration.Version, migration.Name, time.Now().UnixNano())
+				return err
+			})
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to apply migration [%d_%s]: %s", migration.Version, migration.Name, err))
+			}
+			count++
+		}
+		return nil
+	})
+
+	return count, err
+}
+
+// Down reverts the latest applied migration. Returns nil if no migration is applied
+func (m *Migrator) Down() (*Migration, error) {
+	var reverted *Migration
+	err := m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			m.logger.Infof("Reverting migration [%d_%s].", migration.Version, migration.Name)
+			err := m.apply(conn, migration.Down, func(tx *sql.Tx) error {
+				_, err := tx.Exec(`DELETE FROM "schema_migrations" WHERE "version" = $1`, migration.Version)
+				return err
+			})
+			if err != nil {
+				return errors.New(fmt.Sprintf("failed to revert migration [%d_%s]: %s", migration.Version, migration.Name, err))
+			}
+			reverted = &migration
+			return nil
+		}
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status returns the state of every known and every applied migration, ordered by version
+func (m *Migrator) Status() ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(func(conn *sql.Conn) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name, Known: true}
+			if a, ok := applied[migration.Version]; ok {
+				status.Applied = true
+				status.AppliedAt = a.AppliedAt
+				delete(applied, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+		for _, a := range applied {
+			statuses = append(statuses, a)
+		}
+		sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+		return nil
+	})
+
+	return statuses, err
+}
+
+// withLock runs the given function on a single connection, holding the advisory lock and
+// with the migrations table created
+func (m *Migrator) withLock(fn func(conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			m.logger.Errorf("Failed to release the migration lock. Error: [%s].", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createMigrationsTable); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// applied returns the migrations recorded in the database by version
+func (m *Migrator) applied(conn *sql.Conn) (map[uint64]Status, error) {
+	rows, err := conn.QueryContext(context.Background(), `SELECT "version", "name", "applied_at" FROM "schema_migrations"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[uint64]Status)
+	for rows.Next() {
+		status := Status{Applied: true}
+		if err := rows.Scan(&status.Version, &status.Name, &status.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied[status.Version] = status
+	}
+	return applied, rows.Err()
+}
+
+// checkKnown fails if the database holds migrations missing in this release, which means that the schema
+// is newer than the one this release works with
+func (m *Migrator) checkKnown(applied map[uint64]Status) error {
+	known := make(map[uint64]bool)
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+	}
+	for version, status := range applied {
+		if !known[version] {
+			return errors.New(fmt.Sprintf("migration [%d_%s] is applied, but unknown to this release. Revert it with the release which applied it", version, status.Name))
+		}
+	}
+	return nil
+}
+
+// apply executes the given statements and records the change in a single transaction
+func (m *Migrator) apply(conn *sql.Conn, statements string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(statements); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := record(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
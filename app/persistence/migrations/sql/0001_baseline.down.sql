@@ -0,0 +1,16 @@
+-- Drops the whole schema, including all of its data
+DROP TABLE IF EXISTS "evm_blocks";
+DROP TABLE IF EXISTS "evm_events";
+DROP TABLE IF EXISTS "supply_snapshots";
+DROP TABLE IF EXISTS "screening_hits";
+DROP TABLE IF EXISTS "paused_messages";
+DROP TABLE IF EXISTS "pauses";
+DROP TABLE IF EXISTS "reviews";
+DROP TABLE IF EXISTS "dead_letters";
+DROP TABLE IF EXISTS "queue_messages";
+DROP TABLE IF EXISTS "statuses";
+DROP TABLE IF EXISTS "schedules";
+DROP TABLE IF EXISTS "messages";
+DROP TABLE IF EXISTS "fees";
+DROP TABLE IF EXISTS "erc1155_items";
+DROP TABLE IF EXISTS "transfers";
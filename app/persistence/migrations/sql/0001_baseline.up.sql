@@ -0,0 +1,172 @@
+-- Baseline of the schema previously created by gorm AutoMigrate. Every statement is idempotent, so that databases
+-- created by AutoMigrate adopt the baseline without changes.
+
+CREATE TABLE IF NOT EXISTS "transfers" (
+    "transaction_id" text,
+    "source_chain_id" bigint,
+    "target_chain_id" bigint,
+    "native_chain_id" bigint,
+    "source_asset" text,
+    "target_asset" text,
+    "native_asset" text,
+    "receiver" text,
+    "amount" text,
+    "fee" text,
+    "status" text,
+    "serial_number" bigint,
+    "metadata" text,
+    "is_nft" boolean DEFAULT false,
+    "created_at" bigint,
+    "config_version" text,
+    "wrapped_serial_number" bigint,
+    "is_erc1155" boolean DEFAULT false,
+    PRIMARY KEY ("transaction_id")
+);
+-- Columns added after the first releases, missing in databases created by their AutoMigrate
+ALTER TABLE "transfers" ADD COLUMN IF NOT EXISTS "created_at" bigint;
+ALTER TABLE "transfers" ADD COLUMN IF NOT EXISTS "config_version" text;
+ALTER TABLE "transfers" ADD COLUMN IF NOT EXISTS "wrapped_serial_number" bigint;
+ALTER TABLE "transfers" ADD COLUMN IF NOT EXISTS "is_erc1155" boolean DEFAULT false;
+CREATE INDEX IF NOT EXISTS "idx_transfers_created_at" ON "transfers" ("created_at");
+
+CREATE TABLE IF NOT EXISTS "erc1155_items" (
+    "id" bigserial,
+    "transfer_id" text,
+    "token_id" bigint,
+    "amount" text,
+    "wrapped_asset" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_transfers_erc1155_items" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("transaction_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_erc1155_items_transfer_id" ON "erc1155_items" ("transfer_id");
+
+CREATE TABLE IF NOT EXISTS "fees" (
+    "transaction_id" text,
+    "schedule_id" text,
+    "amount" text,
+    "status" text,
+    "transfer_id" text,
+    PRIMARY KEY ("transaction_id"),
+    CONSTRAINT "fk_transfers_fees" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("transaction_id")
+);
+
+CREATE TABLE IF NOT EXISTS "messages" (
+    "transfer_id" text,
+    "hash" text,
+    "signature" text UNIQUE,
+    "signer" text,
+    "transaction_timestamp" bigint,
+    CONSTRAINT "fk_messages_transfer" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("transaction_id"),
+    CONSTRAINT "fk_transfers_messages" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("transaction_id")
+);
+
+CREATE TABLE IF NOT EXISTS "schedules" (
+    "transaction_id" text,
+    "schedule_id" text,
+    "has_receiver" boolean,
+    "operation" text,
+    "status" text,
+    "transfer_id" text,
+    PRIMARY KEY ("transaction_id"),
+    CONSTRAINT "fk_transfers_schedules" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("transaction_id")
+);
+
+CREATE TABLE IF NOT EXISTS "statuses" (
+    "entity_id" text,
+    "last" bigint
+);
+
+CREATE TABLE IF NOT EXISTS "queue_messages" (
+    "id" bigserial,
+    "topic" text,
+    "payload_type" text,
+    "payload" text,
+    "attempts" bigint,
+    "visible_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_queue_messages_visible_at" ON "queue_messages" ("visible_at");
+
+CREATE TABLE IF NOT EXISTS "dead_letters" (
+    "id" bigserial,
+    "topic" text,
+    "payload_type" text,
+    "payload" text,
+    "error" text,
+    "attempts" bigint,
+    "created_at" bigint,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "reviews" (
+    "transfer_id" text,
+    "topic" text,
+    "payload_type" text,
+    "payload" text,
+    "reason" text,
+    "approved" boolean DEFAULT false,
+    "created_at" bigint,
+    PRIMARY KEY ("transfer_id")
+);
+
+CREATE TABLE IF NOT EXISTS "pauses" (
+    "scope" text,
+    "value" text,
+    "created_at" bigint,
+    PRIMARY KEY ("scope", "value")
+);
+
+CREATE TABLE IF NOT EXISTS "paused_messages" (
+    "transfer_id" text,
+    "topic" text,
+    "payload_type" text,
+    "payload" text,
+    "created_at" bigint,
+    PRIMARY KEY ("transfer_id")
+);
+
+CREATE TABLE IF NOT EXISTS "screening_hits" (
+    "id" bigserial,
+    "transfer_id" text,
+    "party" text,
+    "role" text,
+    "created_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_screening_hits_transfer_id" ON "screening_hits" ("transfer_id");
+
+CREATE TABLE IF NOT EXISTS "supply_snapshots" (
+    "id" bigserial,
+    "chain_id" bigint,
+    "asset" text,
+    "locked" text,
+    "supply" text,
+    "in_flight" text,
+    "difference" text,
+    "diverged" boolean,
+    "created_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_supply_snapshots_asset" ON "supply_snapshots" ("chain_id", "asset");
+
+CREATE TABLE IF NOT EXISTS "evm_events" (
+    "transaction_hash" text,
+    "log_index" bigint,
+    "transfer_id" text,
+    "name" text,
+    "chain_id" bigint,
+    "block_number" bigint,
+    "block_hash" text,
+    "timestamp" bigint,
+    "orphaned" boolean,
+    PRIMARY KEY ("transaction_hash", "log_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_evm_events_chain_block" ON "evm_events" ("chain_id", "block_number");
+CREATE INDEX IF NOT EXISTS "idx_evm_events_transfer_id" ON "evm_events" ("transfer_id");
+
+CREATE TABLE IF NOT EXISTS "evm_blocks" (
+    "entity_id" text,
+    "number" bigint,
+    "hash" text,
+    PRIMARY KEY ("entity_id", "number")
+);
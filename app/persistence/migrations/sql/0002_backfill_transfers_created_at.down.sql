@@ -0,0 +1,2 @@
+-- The backfilled creation times cannot be told apart from the recorded ones, so they are kept
+SELECT 1;
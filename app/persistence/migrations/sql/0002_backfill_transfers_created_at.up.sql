@@ -0,0 +1,35 @@
+-- Backfills the creation time of the transfers recorded before it was tracked, so that they are listed and paginated in
+-- their original order. Only transfers without a creation time are updated.
+
+-- Transfers originating on Hedera are identified by their transaction ID <account>-<seconds>-<nanoseconds>
+UPDATE "transfers"
+SET "created_at" = split_part("transaction_id", '-', 2)::bigint * 1000000000 + split_part("transaction_id", '-', 3)::bigint
+WHERE "created_at" IS NULL
+  AND "transaction_id" ~ '^[0-9]+\.[0-9]+\.[0-9]+-[0-9]+-[0-9]+$';
+
+-- Transfers originating on EVM networks use the block timestamp of their source event
+UPDATE "transfers"
+SET "created_at" = "source"."timestamp"
+FROM (
+    SELECT "transfer_id", MIN("timestamp") AS "timestamp"
+    FROM "evm_events"
+    WHERE "name" IN ('lock', 'burn', 'burn_erc721', 'lock_erc721', 'lock_erc1155') AND "timestamp" > 0
+    GROUP BY "transfer_id"
+) AS "source"
+WHERE "transfers"."created_at" IS NULL
+  AND "source"."transfer_id" = "transfers"."transaction_id";
+
+-- Remaining transfers use the consensus timestamp of their earliest signature message
+UPDATE "transfers"
+SET "created_at" = "signed"."timestamp"
+FROM (
+    SELECT "transfer_id", MIN("transaction_timestamp") AS "timestamp"
+    FROM "messages"
+    WHERE "transaction_timestamp" > 0
+    GROUP BY "transfer_id"
+) AS "signed"
+WHERE "transfers"."created_at" IS NULL
+  AND "signed"."transfer_id" = "transfers"."transaction_id";
+
+-- Transfers without any of the above are ordered before all others
+UPDATE "transfers" SET "created_at" = 0 WHERE "created_at" IS NULL;
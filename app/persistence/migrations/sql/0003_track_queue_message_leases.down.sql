@@ -0,0 +1 @@
+ALTER TABLE "queue_messages" DROP COLUMN IF EXISTS "leased";
@@ -0,0 +1,3 @@
+-- Tracks the leases of queue messages apart from the delays before their retries, so that only the leases of a previous
+-- run are released on start. Messages leased before the upgrade are delivered again once their visibility timeout passes.
+ALTER TABLE "queue_messages" ADD COLUMN IF NOT EXISTS "leased" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pause
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Pause Repository"),
+	}
+}
+
+func (r Repository) Create(pause *entity.Pause) error {
+	return r.dbClient.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(pause).
+		Error
+}
+
+func (r Repository) GetAll() ([]*entity.Pause, error) {
+	var pauses []*entity.Pause
+	err := r.dbClient.
+		Order("created_at").
+		Find(&pauses).
+		Error
+	return pauses, err
+}
+
+// Returns Pause. Returns nil if not found
+func (r Repository) Get(scope, value string) (*entity.Pause, error) {
+	record := &entity.Pause{}
+
+	result := r.dbClient.
+		Model(entity.Pause{}).
+		Where("scope = ? AND value = ?", scope, value).
+		First(record)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return record, nil
+}
+
+func (r Repository) Delete(scope, value string) error {
+	return r.dbClient.Delete(&entity.Pause{}, "scope = ? AND value = ?", scope, value).Error
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package paused_message
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Paused Message Repository"),
+	}
+}
+
+func (r Repository) Create(message *entity.PausedMessage) error {
+	return r.dbClient.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(message).
+		Error
+}
+
+func (r Repository) GetAll() ([]*entity.PausedMessage, error) {
+	var messages []*entity.PausedMessage
+	err := r.dbClient.
+		Order("created_at").
+		Find(&messages).
+		Error
+	return messages, err
+}
+
+func (r Repository) Delete(transferID string) error {
+	return r.dbClient.Delete(&entity.PausedMessage{}, "transfer_id = ?", transferID).Error
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Queue Repository"),
+	}
+}
+
+func (r Repository) Create(messages []*entity.QueueMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	return r.dbClient.Create(&messages).Error
+}
+
+func (r Repository) CreateWithStatus(entityID string, timestampOrBlockNumber int64, messages []*entity.QueueMessage) error {
+	return r.dbClient.Transaction(func(tx *gorm.DB) error {
+		if len(messages) > 0 {
+			err := tx.Create(&messages).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.
+			Model(entity.Status{}).
+			Where("entity_id = ?", entityID).
+			UpdateColumn("last", timestampOrBlockNumber).
+			Error
+	})
+}
+
+// Lease atomically marks up to limit available messages as leased.
+// `FOR UPDATE SKIP LOCKED` guarantees that concurrent leases never return the same message.
+func (r Repository) Lease(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error) {
+	now := time.Now().UnixNano()
+	var messages []*entity.QueueMessage
+
+	err := r.dbClient.Raw(
+		`UPDATE queue_messages SET visible_at = ?, attempts = attempts + 1, leased = true
+		WHERE id IN (
+			SELECT id FROM queue_messages WHERE visible_at <= ? ORDER BY id LIMIT ? FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now+visibilityTimeout.Nanoseconds(), now, limit).
+		Scan(&messages).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not preserve the order of the sub-query
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ID < messages[j].ID
+	})
+
+	return messages, nil
+}
+
+func (r Repository) Delete(id uint64) error {
+	return r.dbClient.Delete(&entity.QueueMessage{}, id).Error
+}
+
+func (r Repository) Delay(id uint64, delay time.Duration) error {
+	return r.dbClient.
+		Model(entity.QueueMessage{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"visible_at": time.Now().Add(delay).UnixNano(),
+			"leased":     false,
+		}).
+		Error
+}
+
+func (r Repository) DeadLetter(id uint64, reason string) error {
+	return r.dbClient.Transaction(func(tx *gorm.DB) error {
+		message := &entity.QueueMessage{}
+		err := tx.First(message, id).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.DeadLetter{
+			Topic:       message.Topic,
+			PayloadType: message.PayloadType,
+			Payload:     message.Payload,
+			Error:       reason,
+			Attempts:    message.Attempts,
+			CreatedAt:   time.Now().UnixNano(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(message).Error
+	})
+}
+
+func (r Repository) Replay(deadLetterID uint64) error {
+	return r.dbClient.Transaction(func(tx *gorm.DB) error {
+		deadLetter := &entity.DeadLetter{}
+		err := tx.First(deadLetter, deadLetterID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.QueueMessage{
+			Topic:       deadLetter.Topic,
+			PayloadType: deadLetter.PayloadType,
+			Payload:     deadLetter.Payload,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(deadLetter).Error
+	})
+}
+
+func (r Repository) Resume(transferID string) error {
+	return r.dbClient.Transaction(func(tx *gorm.DB) error {
+		paused := &entity.PausedMessage{}
+		err := tx.Where("transfer_id = ?", transferID).First(paused).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.QueueMessage{
+			Topic:       paused.Topic,
+			PayloadType: paused.PayloadType,
+			Payload:     paused.Payload,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(paused).Error
+	})
+}
+
+func (r Repository) GetAll() ([]*entity.QueueMessage, error) {
+	var messages []*entity.QueueMessage
+	err := r.dbClient.
+		Order("id").
+		Find(&messages).
+		Error
+	return messages, err
+}
+
+func (r Repository) Release(id uint64) error {
+	return r.dbClient.
+		Model(entity.QueueMessage{}).
+		Where("id = ? AND attempts > 0", id).
+		UpdateColumns(map[string]interface{}{
+			"visible_at": 0,
+			"leased":     false,
+			"attempts":   gorm.Expr("attempts - 1"),
+		}).
+		Error
+}
+
+// ReleaseAll releases the leased messages only. Messages delayed before their retry keep their backoff.
+func (r Repository) ReleaseAll() error {
+	result := r.dbClient.
+		Model(entity.QueueMessage{}).
+		Where("leased = ?", true).
+		UpdateColumns(map[string]interface{}{
+			"visible_at": 0,
+			"leased":     false,
+		})
+	if result.Error == nil && result.RowsAffected > 0 {
+		r.logger.Infof("Released [%d] messages leased by a previous run", result.RowsAffected)
+	}
+	return result.Error
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package review
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Review Repository"),
+	}
+}
+
+func (r Repository) Create(review *entity.Review) error {
+	return r.dbClient.Create(review).Error
+}
+
+// Returns Review. Returns nil if not found
+func (r Repository) Get(transferID string) (*entity.Review, error) {
+	record := &entity.Review{}
+
+	result := r.dbClient.
+		Model(entity.Review{}).
+		Where("transfer_id = ?", transferID).
+		First(record)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return record, nil
+}
+
+func (r Repository) GetPending() ([]*entity.Review, error) {
+	var reviews []*entity.Review
+	err := r.dbClient.
+		Where("approved = ?", false).
+		Order("created_at").
+		Find(&reviews).
+		Error
+	return reviews, err
+}
+
+func (r Repository) UpdateApproved(transferID string) error {
+	err := r.dbClient.
+		Model(entity.Review{}).
+		Where("transfer_id = ?", transferID).
+		UpdateColumn("approved", true).
+		Error
+	if err == nil {
+		r.logger.Debugf("[%s] - Approved review.", transferID)
+	}
+	return err
+}
+
+func (r Repository) Delete(transferID string) error {
+	return r.dbClient.Delete(&entity.Review{}, "transfer_id = ?", transferID).Error
+}
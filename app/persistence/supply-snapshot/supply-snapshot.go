@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supply_snapshot
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	dbClient *gorm.DB
+	logger   *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		dbClient: dbClient,
+		logger:   config.GetLoggerFor("Supply Snapshot Repository"),
+	}
+}
+
+func (r Repository) Create(snapshot *entity.SupplySnapshot) error {
+	return r.dbClient.Create(snapshot).Error
+}
+
+func (r Repository) GetLatest() ([]*entity.SupplySnapshot, error) {
+	var snapshots []*entity.SupplySnapshot
+	err := r.dbClient.
+		Where("id IN (?)", r.dbClient.
+			Model(entity.SupplySnapshot{}).
+			Select("MAX(id)").
+			Group("chain_id, asset")).
+		Order("chain_id, asset").
+		Find(&snapshots).
+		Error
+	return snapshots, err
+}
+
+func (r Repository) GetHistory(chainID uint64, asset string, limit int) ([]*entity.SupplySnapshot, error) {
+	var snapshots []*entity.SupplySnapshot
+	err := r.dbClient.
+		Where("chain_id = ? AND asset = ?", chainID, asset).
+		Order("id DESC").
+		Limit(limit).
+		Find(&snapshots).
+		Error
+	return snapshots, err
+}
@@ -22,8 +22,10 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"time"
 )
 
 type Repository struct {
@@ -60,6 +62,8 @@ func (tr Repository) GetWithPreloads(txId string) (*entity.Transfer, error) {
 	result := tr.dbClient.
 		Preload("Fees").
 		Preload("Messages").
+		Preload("Schedules").
+		Preload("Erc1155Items", orderErc1155Items).
 		Model(entity.Transfer{}).
 		Where("transaction_id = ?", txId).
 		Find(tx)
@@ -92,6 +96,56 @@ func (tr Repository) GetWithFee(txId string) (*entity.Transfer, error) {
 	return tx, nil
 }
 
+// GetPage returns up to limit transfers matching the given filter with preloaded Messages and Schedules, newest first.
+// If a cursor is given, only the transfers after it are returned.
+func (tr Repository) GetPage(filter *model.Filter, after *model.Cursor, limit int) ([]*entity.Transfer, error) {
+	query := tr.dbClient.
+		Preload("Messages").
+		Preload("Schedules").
+		Model(entity.Transfer{})
+
+	if filter.SourceChainId != nil {
+		query = query.Where("source_chain_id = ?", *filter.SourceChainId)
+	}
+	if filter.TargetChainId != nil {
+		query = query.Where("target_chain_id = ?", *filter.TargetChainId)
+	}
+	if filter.Asset != "" {
+		query = query.Where("(source_asset = ? OR target_asset = ? OR native_asset = ?)", filter.Asset, filter.Asset, filter.Asset)
+	}
+	if filter.Receiver != "" {
+		query = query.Where("receiver = ?", filter.Receiver)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.IsNft != nil {
+		query = query.Where("is_nft = ?", *filter.IsNft)
+	}
+	if filter.From != 0 {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if filter.To != 0 {
+		query = query.Where("created_at < ?", filter.To)
+	}
+	if after != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND transaction_id < ?))", after.CreatedAt, after.CreatedAt, after.TransactionId)
+	}
+
+	var transfers []*entity.Transfer
+	err := query.
+		Order("created_at desc").
+		Order("transaction_id desc").
+		Limit(limit).
+		Find(&transfers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
 // Create creates new record of Transfer
 func (tr Repository) Create(ct *model.Transfer) (*entity.Transfer, error) {
 	return tr.create(ct, status.Initial)
@@ -114,6 +168,36 @@ func (tr Repository) UpdateFee(txId string, fee string) error {
 	return err
 }
 
+// UpdateWrappedSerialNumber sets the serial number of the wrapped Hedera NFT, minted for the given transfer
+func (tr Repository) UpdateWrappedSerialNumber(txId string, serialNum int64) error {
+	err := tr.dbClient.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ?", txId).
+		UpdateColumn("wrapped_serial_number", serialNum).
+		Error
+	if err == nil {
+		tr.logger.Debugf("Updated Wrapped Serial Number of TX [%s] to [%d]", txId, serialNum)
+	}
+	return err
+}
+
+// GetByWrappedNft returns the transfer, which minted the given wrapped Hedera NFT. Returns nil if not found
+func (tr Repository) GetByWrappedNft(asset string, serialNum int64) (*entity.Transfer, error) {
+	tx := &entity.Transfer{}
+	result := tr.dbClient.
+		Model(entity.Transfer{}).
+		Where("is_nft = ? AND target_asset = ? AND wrapped_serial_number = ?", true, asset, serialNum).
+		First(tx)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return tx, nil
+}
+
 func (tr Repository) UpdateStatusCompleted(txId string) error {
 	return tr.updateStatus(txId, status.Completed)
 }
@@ -122,21 +206,114 @@ func (tr Repository) UpdateStatusFailed(txId string) error {
 	return tr.updateStatus(txId, status.Failed)
 }
 
+// UpdateStatusRevoked revokes the transfer, if it is still INITIAL
+func (tr Repository) UpdateStatusRevoked(txId string) error {
+	return tr.updateStatusFrom(txId, status.Initial, status.Revoked)
+}
+
+// ReinstateRevoked moves the transfer back to INITIAL, if it is REVOKED
+func (tr Repository) ReinstateRevoked(txId string) error {
+	return tr.updateStatusFrom(txId, status.Revoked, status.Initial)
+}
+
+// UpdateStatusPendingReview holds the transfer for review, if it is still INITIAL
+func (tr Repository) UpdateStatusPendingReview(txId string) error {
+	return tr.updateStatusFrom(txId, status.Initial, status.PendingReview)
+}
+
+// ApprovePendingReview moves the transfer back to INITIAL, if it is PENDING_REVIEW
+func (tr Repository) ApprovePendingReview(txId string) error {
+	return tr.updateStatusFrom(txId, status.PendingReview, status.Initial)
+}
+
+// RejectPendingReview fails the transfer, if it is PENDING_REVIEW
+func (tr Repository) RejectPendingReview(txId string) error {
+	return tr.updateStatusFrom(txId, status.PendingReview, status.Failed)
+}
+
+// GetUnpaid returns the transfers to Hedera, which are not completed, and the transfers to EVM networks, for which
+// no mint or unlock event is recorded
+func (tr Repository) GetUnpaid(nativeChainId uint64, nativeAsset string) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	paidOut := tr.dbClient.
+		Model(entity.EvmEvent{}).
+		Select("1").
+		Where("evm_events.transfer_id = transfers.transaction_id AND evm_events.name IN ? AND evm_events.orphaned = ?",
+			[]string{entity.EvmEventMint, entity.EvmEventUnlock}, false)
+	err := tr.dbClient.
+		Model(entity.Transfer{}).
+		Where("native_chain_id = ? AND native_asset = ? AND is_nft = ? AND is_erc1155 = ? AND status <> ?",
+			nativeChainId, nativeAsset, false, false, status.Revoked).
+		Where("(target_chain_id = ? AND status <> ?) OR (target_chain_id <> ? AND NOT EXISTS (?))",
+			constants.HederaNetworkId, status.Completed, constants.HederaNetworkId, paidOut).
+		Find(&transfers).
+		Error
+	return transfers, err
+}
+
+func (tr Repository) GetInitial(since int64) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := tr.dbClient.
+		Preload("Fees").
+		Preload("Messages").
+		Preload("Schedules").
+		Preload("Erc1155Items", orderErc1155Items).
+		Model(entity.Transfer{}).
+		Where("status = ? AND created_at >= ?", status.Initial, since).
+		Order("created_at").
+		Find(&transfers).
+		Error
+	return transfers, err
+}
+
+// UpdateStatusBlocked blocks the transfer, if it is still INITIAL
+func (tr Repository) UpdateStatusBlocked(txId string) error {
+	return tr.updateStatusFrom(txId, status.Initial, status.Blocked)
+}
+
+func (tr Repository) GetOutflowSince(nativeChainId uint64, nativeAsset string, since int64) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	err := tr.dbClient.
+		Model(entity.Transfer{}).
+		Select("transaction_id", "target_chain_id", "target_asset", "amount").
+		Where("native_chain_id = ? AND native_asset = ? AND created_at >= ? AND is_nft = ? AND is_erc1155 = ?", nativeChainId, nativeAsset, since, false, false).
+		Where("status NOT IN ?", []string{status.Failed, status.Revoked, status.Blocked, status.PendingReview}).
+		Find(&transfers).
+		Error
+	return transfers, err
+}
+
+// orderErc1155Items keeps the items of an ERC-1155 batch in the order, in which they are signed
+func orderErc1155Items(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
 func (tr Repository) create(ct *model.Transfer, status string) (*entity.Transfer, error) {
 	tx := &entity.Transfer{
-		TransactionID: ct.TransactionId,
-		SourceChainID: ct.SourceChainId,
-		TargetChainID: ct.TargetChainId,
-		NativeChainID: ct.NativeChainId,
-		SourceAsset:   ct.SourceAsset,
-		TargetAsset:   ct.TargetAsset,
-		NativeAsset:   ct.NativeAsset,
-		Receiver:      ct.Receiver,
-		Amount:        ct.Amount,
-		SerialNumber:  ct.SerialNum,
-		Metadata:      ct.Metadata,
-		IsNft:         ct.IsNft,
-		Status:        status,
+		TransactionID:       ct.TransactionId,
+		SourceChainID:       ct.SourceChainId,
+		TargetChainID:       ct.TargetChainId,
+		NativeChainID:       ct.NativeChainId,
+		SourceAsset:         ct.SourceAsset,
+		TargetAsset:         ct.TargetAsset,
+		NativeAsset:         ct.NativeAsset,
+		Receiver:            ct.Receiver,
+		Amount:              ct.Amount,
+		SerialNumber:        ct.SerialNum,
+		Metadata:            ct.Metadata,
+		IsNft:               ct.IsNft,
+		Status:              status,
+		CreatedAt:           time.Now().UnixNano(),
+		ConfigVersion:       ct.ConfigVersion,
+		WrappedSerialNumber: ct.WrappedSerialNum,
+		IsErc1155:           ct.IsErc1155,
+	}
+	for _, item := range ct.Erc1155Items {
+		tx.Erc1155Items = append(tx.Erc1155Items, entity.Erc1155Item{
+			TokenID:      item.TokenId,
+			Amount:       item.Amount,
+			WrappedAsset: item.WrappedAsset,
+		})
 	}
 	err := tr.dbClient.Create(tx).Error
 
@@ -162,6 +339,19 @@ func (tr Repository) updateStatus(txId string, s string) error {
 	return err
 }
 
+// updateStatusFrom updates the status of the transfer only if it currently has the given one
+func (tr Repository) updateStatusFrom(txId, from, to string) error {
+	err := tr.dbClient.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ? AND status = ?", txId, from).
+		UpdateColumn("status", to).
+		Error
+	if err == nil {
+		tr.logger.Debugf("Updated Status of TX [%s] from [%s] to [%s]", txId, from, to)
+	}
+	return err
+}
+
 func (tr Repository) baseUpdateStatus(statusColumn, txId, status string, possibleStatuses []string) error {
 	if !isValidStatus(status, possibleStatuses) {
 		return errors.New("invalid status")
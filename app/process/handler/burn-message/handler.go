@@ -17,46 +17,57 @@
 package burn_message
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
 type Handler struct {
 	transfersService service.Transfers
+	limitsService    service.Limits
 	logger           *log.Entry
 }
 
-func NewHandler(transferService service.Transfers) *Handler {
+func NewHandler(transferService service.Transfers, limitsService service.Limits) *Handler {
 	return &Handler{
 		transfersService: transferService,
+		limitsService:    limitsService,
 		logger:           config.GetLoggerFor("Hedera Burn and Topic Message Handler"),
 	}
 }
 
-func (mhh Handler) Handle(payload interface{}) {
+func (mhh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		mhh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		mhh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = mhh.transfersService.ProcessWrappedTransfer(*transferMsg)
+	held, err := mhh.limitsService.Hold(ctx, *transferMsg, constants.HederaBurnMessageSubmission)
+	if err != nil || held {
+		return err
+	}
+
+	err = mhh.transfersService.ProcessWrappedTransfer(ctx, *transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+
+	return nil
 }
@@ -17,13 +17,16 @@
 package burn_message
 
 import (
+	"context"
 	"errors"
+	iservice "github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/service"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"testing"
 )
@@ -41,7 +44,7 @@ var (
 func InitializeHandler() (*Handler, *service.MockTransferService) {
 	mocks.Setup()
 
-	return NewHandler(mocks.MTransferService), mocks.MTransferService
+	return NewHandler(mocks.MTransferService, mocks.MLimitsService), mocks.MTransferService
 }
 
 func Test_Handle_ProcessWrappedTransfer_Fails(t *testing.T) {
@@ -55,9 +58,27 @@ func Test_Handle_ProcessWrappedTransfer_Fails(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
+	mocks.MLimitsService.On("Hold", mt, constants.HederaBurnMessageSubmission).Return(false, nil)
 	mockedService.On("ProcessWrappedTransfer", mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.Equal(t, errors.New("some-error"), err)
+}
+
+func Test_Handle_HeldForReview(t *testing.T) {
+	ctHandler, mockedService := InitializeHandler()
+
+	tx := &entity.Transfer{
+		TransactionID: mt.TransactionId,
+		Status:        status.Initial,
+	}
+
+	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
+	mocks.MLimitsService.On("Hold", mt, constants.HederaBurnMessageSubmission).Return(true, nil)
+
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.Nil(t, err)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
 }
 
 func Test_Handle_NotInitial(t *testing.T) {
@@ -71,20 +92,23 @@ func Test_Handle_NotInitial(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	ctHandler.Handle(&mt)
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.Nil(t, err)
 	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
 	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
-	ctHandler.Handle(&mt)
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.NotNil(t, err)
 	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
 }
 
 func Test_Handle_Payload_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
-	ctHandler.Handle("string")
+	err := ctHandler.Handle(context.Background(), "string")
+	assert.Equal(t, iservice.ErrInvalidPayload, err)
 	mockedService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
 	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
 }
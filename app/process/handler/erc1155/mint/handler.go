@@ -0,0 +1,47 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the handler of EVM native ERC-1155 batches, locked for Hedera
+type Handler struct {
+	lockService service.LockEvent
+	logger      *log.Entry
+}
+
+func NewHandler(lockService service.LockEvent) *Handler {
+	return &Handler{
+		lockService: lockService,
+		logger:      config.GetLoggerFor("Hedera ERC-1155 Mint and Transfer Handler"),
+	}
+}
+
+func (meh Handler) Handle(ctx context.Context, payload interface{}) error {
+	event, ok := payload.(*model.Transfer)
+	if !ok {
+		meh.logger.Errorf("Could not cast payload [%s]", payload)
+		return service.ErrInvalidPayload
+	}
+	return meh.lockService.ProcessErc1155Event(ctx, *event)
+}
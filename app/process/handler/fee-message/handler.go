@@ -17,6 +17,7 @@
 package fee_message
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
@@ -27,37 +28,49 @@ import (
 // Handler is transfers event handler
 type Handler struct {
 	transfersService service.Transfers
+	limitsService    service.Limits
+	topic            string
 	logger           *log.Entry
 }
 
-func NewHandler(transfersService service.Transfers) *Handler {
+// NewHandler creates the handler of the given topic
+func NewHandler(transfersService service.Transfers, limitsService service.Limits, topic string) *Handler {
 	return &Handler{
 		logger:           config.GetLoggerFor("Hedera Transfer and Topic Submission Handler"),
 		transfersService: transfersService,
+		limitsService:    limitsService,
+		topic:            topic,
 	}
 }
 
-func (fmh Handler) Handle(payload interface{}) {
+func (fmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = fmh.transfersService.ProcessNativeTransfer(*transferMsg)
+	held, err := fmh.limitsService.Hold(ctx, *transferMsg, fmh.topic)
+	if err != nil || held {
+		return err
+	}
+
+	err = fmh.transfersService.ProcessNativeTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+
+	return nil
 }
@@ -17,6 +17,7 @@
 package fee_message
 
 import (
+	"context"
 	"errors"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -24,6 +25,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/service"
+	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -40,7 +42,7 @@ var (
 func InitializeHandler() (*Handler, *service.MockTransferService) {
 	mocks.Setup()
 
-	return NewHandler(mocks.MTransferService), mocks.MTransferService
+	return NewHandler(mocks.MTransferService, mocks.MLimitsService, constants.HederaTransferMessageSubmission), mocks.MTransferService
 }
 
 func Test_Handle(t *testing.T) {
@@ -56,20 +58,38 @@ func Test_Handle(t *testing.T) {
 	}
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
+	mocks.MLimitsService.On("Hold", mt, constants.HederaTransferMessageSubmission).Return(false, nil)
 	mockedService.On("ProcessNativeTransfer", mt).Return(nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertCalled(t, "InitiateNewTransfer", mt)
 	mockedService.AssertCalled(t, "ProcessNativeTransfer", mt)
 }
 
+func Test_Handle_HeldForReview(t *testing.T) {
+	ctHandler, mockedService := InitializeHandler()
+
+	tx := &entity.Transfer{
+		TransactionID: mt.TransactionId,
+		Status:        status.Initial,
+	}
+
+	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
+	mocks.MLimitsService.On("Hold", mt, constants.HederaTransferMessageSubmission).Return(true, nil)
+
+	err := ctHandler.Handle(context.Background(), &mt)
+
+	assert.Nil(t, err)
+	mockedService.AssertNotCalled(t, "ProcessNativeTransfer", mt)
+}
+
 func Test_Handle_Encoding_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	ctHandler.Handle(invalidTransferPayload)
+	ctHandler.Handle(context.Background(), invalidTransferPayload)
 
 	mockedService.AssertNotCalled(t, "InitiateNewTransfer")
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
@@ -80,7 +100,7 @@ func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 
 	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -97,7 +117,7 @@ func Test_Handle_StatusNotInitial_Fails(t *testing.T) {
 
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -115,5 +135,5 @@ func Test_Handle_ProcessNativeTransfer_Fails(t *testing.T) {
 	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
 	mockedService.On("ProcessNativeTransfer", mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 }
@@ -17,6 +17,7 @@
 package fee_transfer
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -35,11 +36,11 @@ func NewHandler(burnService service.BurnEvent) *Handler {
 	}
 }
 
-func (fth Handler) Handle(payload interface{}) {
+func (fth Handler) Handle(ctx context.Context, payload interface{}) error {
 	event, ok := payload.(*transfer.Transfer)
 	if !ok {
 		fth.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
-	fth.burnService.ProcessEvent(*event)
+	return fth.burnService.ProcessEvent(ctx, *event)
 }
@@ -17,6 +17,7 @@
 package fee_transfer
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
@@ -50,9 +51,9 @@ func Test_Handle_Burn(t *testing.T) {
 		Receiver:      "",
 		Amount:        "0",
 	}
-	mocks.MBurnService.On("ProcessEvent", *someEvent).Return()
-	feeTransferHandler.Handle(someEvent)
-	mocks.MBurnService.AssertCalled(t, "ProcessEvent", *someEvent)
+	mocks.MBurnService.On("ProcessEvent", context.Background(), *someEvent).Return(nil)
+	feeTransferHandler.Handle(context.Background(), someEvent)
+	mocks.MBurnService.AssertCalled(t, "ProcessEvent", context.Background(), *someEvent)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -60,7 +61,7 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	feeTransferHandler.Handle(invalidTransferPayload)
+	feeTransferHandler.Handle(context.Background(), invalidTransferPayload)
 
 	mocks.MBurnService.AssertNotCalled(t, "ProcessEvent")
 }
@@ -17,6 +17,7 @@
 package message_submission
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -25,6 +26,7 @@ import (
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -36,6 +38,7 @@ type Handler struct {
 	transferRepository repository.Transfer
 	topicID            hedera.TopicID
 	messageService     service.Messages
+	limitsService      service.Limits
 	logger             *log.Entry
 }
 
@@ -45,6 +48,7 @@ func NewHandler(
 	transfersService service.Transfers,
 	transferRepository repository.Transfer,
 	messageService service.Messages,
+	limitsService service.Limits,
 	topicId string,
 ) *Handler {
 	topicID, err := hedera.TopicIDFromString(topicId)
@@ -59,36 +63,44 @@ func NewHandler(
 		transfersService:   transfersService,
 		transferRepository: transferRepository,
 		messageService:     messageService,
+		limitsService:      limitsService,
 		topicID:            topicID,
 	}
 }
 
-func (smh Handler) Handle(payload interface{}) {
+func (smh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		smh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := smh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		smh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	held, err := smh.limitsService.Hold(ctx, *transferMsg, constants.TopicMessageSubmission)
+	if err != nil || held {
+		return err
 	}
 
-	err = smh.submitMessage(transferMsg)
+	err = smh.submitMessage(ctx, transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+
+	return nil
 }
 
-func (smh Handler) submitMessage(tm *model.Transfer) error {
+func (smh Handler) submitMessage(ctx context.Context, tm *model.Transfer) error {
 	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(*tm)
 	if err != nil {
 		return err
@@ -105,7 +117,7 @@ func (smh Handler) submitMessage(tm *model.Transfer) error {
 	// Attach update callbacks on Signature HCS Message
 	smh.logger.Infof("[%s] - Submitted signature on Topic [%s]", tm.TransactionId, smh.topicID)
 	onSuccessfulAuthMessage, onFailedAuthMessage := smh.authMessageSubmissionCallbacks(tm.TransactionId)
-	smh.mirrorNode.WaitForTransaction(hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	smh.mirrorNode.WaitForTransaction(ctx, hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
@@ -17,18 +17,25 @@
 package message_submission
 
 import (
+	"context"
 	"errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/limits"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"math/big"
 	"testing"
 	"time"
 )
@@ -91,7 +98,7 @@ var (
 
 func Test_NewHandler(t *testing.T) {
 	mocks.Setup()
-	h := NewHandler(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService, "0.0.1111")
+	h := NewHandler(mocks.MHederaNodeClient, mocks.MHederaMirrorClient, mocks.MTransferService, mocks.MTransferRepository, mocks.MMessageService, mocks.MLimitsService, "0.0.1111")
 	assert.Equal(t, &Handler{
 		hederaNode:         mocks.MHederaNodeClient,
 		mirrorNode:         mocks.MHederaMirrorClient,
@@ -103,6 +110,7 @@ func Test_NewHandler(t *testing.T) {
 			Topic: 1111,
 		},
 		messageService: mocks.MMessageService,
+		limitsService:  mocks.MLimitsService,
 		logger:         config.GetLoggerFor("Topic Message Submission Handler"),
 	}, h)
 }
@@ -112,14 +120,14 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	msHandler.Handle(invalidTransferPayload)
+	msHandler.Handle(context.Background(), invalidTransferPayload)
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
 
 func Test_Invalid_Payload(t *testing.T) {
 	setup()
-	msHandler.Handle(tr)
+	msHandler.Handle(context.Background(), tr)
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
 }
 
@@ -133,25 +141,91 @@ func Test_AuthMessageSubmissionCallbacks(t *testing.T) {
 func Test_Handle(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.TopicMessageSubmission).Return(false, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
 	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, nil)
 	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
+}
+
+func Test_Handle_Held(t *testing.T) {
+	setup()
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.TopicMessageSubmission).Return(true, nil)
+
+	err := msHandler.Handle(context.Background(), &tr)
+
+	assert.Nil(t, err)
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
+}
+
+func Test_Handle_HoldFails(t *testing.T) {
+	setup()
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.TopicMessageSubmission).Return(false, errors.New("some-error"))
+
+	err := msHandler.Handle(context.Background(), &tr)
+
+	assert.Error(t, err)
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+}
+
+func Test_Handle_EvmToEvmOverLimitPendingReview(t *testing.T) {
+	setup()
+	nativeToken := "0x0000000000000000000000000000000000000001"
+	wrappedToken := "0x0000000000000000000000000000000000000002"
+	assets := config.LoadAssets(map[uint64]*parser.Network{
+		80001: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					nativeToken: {MaxAmount: "1000", Networks: map[uint64]string{80002: wrappedToken}},
+				},
+			},
+		},
+	})
+	evmToEvm := transfer.Transfer{
+		TransactionId: "0xhash-1",
+		SourceChainId: 80001,
+		TargetChainId: 80002,
+		NativeChainId: 80001,
+		SourceAsset:   nativeToken,
+		TargetAsset:   wrappedToken,
+		NativeAsset:   nativeToken,
+		Receiver:      "0xreceiver",
+		Amount:        "1001",
+	}
+	mocks.MTransferService.On("InitiateNewTransfer", evmToEvm).Return(&entity.Transfer{TransactionID: evmToEvm.TransactionId, Status: status.Initial}, nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(common.LeftPadBytes(big.NewInt(18).Bytes(), 32), nil)
+	mocks.MReviewRepository.On("Get", evmToEvm.TransactionId).Return(nil, nil)
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", evmToEvm.TransactionId).Return(nil)
+	msHandler.limitsService = limits.NewService(mocks.MTransferRepository, mocks.MReviewRepository, assets, mocks.MHederaMirrorClient,
+		map[uint64]client.EVM{80001: mocks.MEVMClient, 80002: mocks.MEVMClient})
+
+	err := msHandler.Handle(context.Background(), &evmToEvm)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusPendingReview", evmToEvm.TransactionId)
+	mocks.MMessageService.AssertNotCalled(t, "SignFungibleMessage", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
 }
 
 func Test_Handle_SubmitTopicConsensusMessageFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.TopicMessageSubmission).Return(false, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
 	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, errors.New("some-error"))
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, errors.New("some-error"))
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
 	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
@@ -162,7 +236,7 @@ func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
 	transferRecord.Status = "not-initial"
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
 	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
@@ -173,8 +247,9 @@ func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
 func Test_Handle_SignFungibleMessage_Fails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.TopicMessageSubmission).Return(false, nil)
 	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return([]byte{}, errors.New("some-error"))
-	msHandler.Handle(&tr)
+	msHandler.Handle(context.Background(), &tr)
 	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
@@ -187,6 +262,7 @@ func setup() {
 		transfersService:   mocks.MTransferService,
 		transferRepository: mocks.MTransferRepository,
 		messageService:     mocks.MMessageService,
+		limitsService:      mocks.MLimitsService,
 		topicID:            topicId,
 		logger:             config.GetLoggerFor("Hedera Mint and Transfer Handler"),
 	}
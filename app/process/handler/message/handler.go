@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"github.com/dariubs/percent"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -26,6 +27,7 @@ import (
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
@@ -44,6 +46,7 @@ type Handler struct {
 	participationRateGauge prometheus.Gauge
 	prometheusService      service.Prometheus
 	assetsConfig           config.Assets
+	transferEvents         service.TransferEvents
 }
 
 func NewHandler(
@@ -54,6 +57,7 @@ func NewHandler(
 	messages service.Messages,
 	prometheusService service.Prometheus,
 	assetsConfig config.Assets,
+	transferEvents service.TransferEvents,
 ) *Handler {
 	topicID, err := hedera.TopicIDFromString(topicId)
 	if err != nil {
@@ -79,93 +83,137 @@ func NewHandler(
 		prometheusService:      prometheusService,
 		participationRateGauge: participationRate,
 		assetsConfig:           assetsConfig,
+		transferEvents:         transferEvents,
 	}
 }
 
-func (cmh Handler) Handle(payload interface{}) {
+func (cmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	m, ok := payload.(*message.Message)
 	if !ok {
 		cmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	switch msg := m.Message.(type) {
 	case *proto.TopicMessage_FungibleSignatureMessage:
-		cmh.handleFungibleSignatureMessage(msg.FungibleSignatureMessage, m.TransactionTimestamp)
-		break
+		return cmh.handleFungibleSignatureMessage(msg.FungibleSignatureMessage, m.TransactionTimestamp)
 	case *proto.TopicMessage_NftSignatureMessage:
-		cmh.handleNftSignatureMessage(msg.NftSignatureMessage, m.TransactionTimestamp)
-		break
+		return cmh.handleNftSignatureMessage(msg.NftSignatureMessage, m.TransactionTimestamp)
+	case *proto.TopicMessage_Erc1155SignatureMessage:
+		return cmh.handleErc1155SignatureMessage(msg.Erc1155SignatureMessage, m.TransactionTimestamp)
 	default:
 		cmh.logger.Errorf("Invalid topic message provided: [%v]", msg)
-		break
+		return service.ErrInvalidPayload
 	}
 }
 
 // handleFungibleSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
-func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMessage, timestamp int64) {
+func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMessage, timestamp int64) error {
 	valid, err := cmh.messages.SanityCheckFungibleSignature(tsm)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
-		return
+		return err
 	}
 	if !valid {
 		cmh.logger.Errorf("[%s] - Incoming signature is invalid", tsm.TransferID)
-		return
+		return nil
 	}
 
 	// Parse incoming message
 	authMsgBytes, err := auth_message.EncodeFungibleBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tsm.Recipient, tsm.Amount)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tsm.TransferID, err)
-		return
+		return err
 	}
 
 	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process signature [%s]", tsm.TransferID, tsm.GetSignature())
-		return
+		return err
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false)
+	return cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false)
 }
 
 // handleNftSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
-func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMessage, timestamp int64) {
+func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMessage, timestamp int64) error {
 	valid, err := cmh.messages.SanityCheckNftSignature(tsm)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to perform sanity check on nft incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
-		return
+		return err
 	}
 	if !valid {
 		cmh.logger.Errorf("[%s] - Incoming nft signature is invalid", tsm.TransferID)
-		return
+		return nil
 	}
 
 	// Parse incoming message
 	authMsgBytes, err := auth_message.EncodeNftBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, int64(tsm.TokenId), tsm.Metadata, tsm.Recipient)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Failed to encode the authorisation nft signature. Error: [%s]", tsm.TransferID, err)
-		return
+		return err
 	}
 
 	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not process nft signature [%s]", tsm.TransferID, tsm.GetSignature())
-		return
+		return err
+	}
+
+	return cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
+}
+
+// handleErc1155SignatureMessage is the main component responsible for the processing of new incoming ERC-1155 Signature Messages
+func (cmh Handler) handleErc1155SignatureMessage(tsm *proto.TopicEthErc1155SignatureMessage, timestamp int64) error {
+	valid, err := cmh.messages.SanityCheckErc1155Signature(tsm)
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to perform sanity check on erc1155 incoming signature [%s].", tsm.TransferID, tsm.GetSignature())
+		return err
+	}
+	if !valid {
+		cmh.logger.Errorf("[%s] - Incoming erc1155 signature is invalid", tsm.TransferID)
+		return nil
+	}
+
+	tokenIds := make([]int64, len(tsm.TokenIds))
+	for i, tokenId := range tsm.TokenIds {
+		tokenIds[i] = int64(tokenId)
+	}
+
+	// Parse incoming message
+	authMsgBytes, err := auth_message.EncodeErc1155BytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tokenIds, tsm.Amounts, tsm.Recipient)
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Failed to encode the authorisation erc1155 signature. Error: [%s]", tsm.TransferID, err)
+		return err
+	}
+
+	err = cmh.messages.ProcessSignature(tsm.TransferID, tsm.Signature, tsm.TargetChainId, timestamp, authMsgBytes)
+	if err != nil {
+		cmh.logger.Errorf("[%s] - Could not process erc1155 signature [%s]", tsm.TransferID, tsm.GetSignature())
+		return err
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
+	return cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
 }
 
-func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool) {
+func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool) error {
 	majorityReached, err := cmh.checkMajority(transferID, targetChainId)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not determine whether majority was reached. Error: [%s]", transferID, err)
-		return
+		return err
 	}
 
 	if majorityReached {
+		t, err := cmh.transferRepository.GetByTransactionId(transferID)
+		if err != nil {
+			cmh.logger.Errorf("[%s] - Failed to query transfer. Error: [%s]", transferID, err)
+			return err
+		}
+		if t != nil && t.Status == status.Completed {
+			cmh.logger.Debugf("[%s] - Transfer already completed.", transferID)
+			return nil
+		}
+
 		if !isNFT { // metrics for fungible only
 			oppositeAsset := cmh.assetsConfig.GetOppositeAsset(sourceChainId, targetChainId, asset)
 			metrics.SetMajorityReached(
@@ -180,8 +228,16 @@ func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChai
 		err = cmh.transferRepository.UpdateStatusCompleted(transferID)
 		if err != nil {
 			cmh.logger.Errorf("[%s] - Failed to complete. Error: [%s]", transferID, err)
+			return err
 		}
+
+		cmh.transferEvents.Publish(service.TransferEvent{
+			TransferID: transferID,
+			Type:       service.TransferEventMajority,
+		})
 	}
+
+	return nil
 }
 
 func (cmh *Handler) checkMajority(transferID string, targetChainId uint64) (majorityReached bool, err error) {
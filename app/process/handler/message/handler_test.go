@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -24,6 +25,7 @@ import (
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/limechain/hedera-eth-bridge-validator/test/constants"
@@ -60,17 +62,19 @@ var (
 	}
 	assets               config.Assets
 	transactionTimestamp = int64(0)
+	majorityEvent        = service.TransferEvent{TransferID: tesm.TransferID, Type: service.TransferEventMajority}
 	authMsgBytes, _      = auth_message.EncodeFungibleBytesFrom(tesm.SourceChainId, tesm.TargetChainId, tesm.TransferID, tesm.Asset, tesm.Recipient, tesm.Amount)
 )
 
 func Test_NewHandler(t *testing.T) {
 	setup()
-	assert.Equal(t, h, NewHandler(topicId.String(), mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, assets))
+	assert.Equal(t, h, NewHandler(topicId.String(), mocks.MTransferRepository, mocks.MMessageRepository, map[uint64]service.Contracts{1: mocks.MBridgeContractService}, mocks.MMessageService, mocks.MPrometheusService, assets, mocks.MTransferEventsService))
 }
 
 func Test_Handle_Fails(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
+	err := h.Handle(context.Background(), "invalid-payload")
+	assert.Equal(t, service.ErrInvalidPayload, err)
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything)
 	mocks.MMessageRepository.AssertNotCalled(t, "Get", mock.Anything)
 	mocks.MBridgeContractService.AssertNotCalled(t, "GetMembers")
@@ -79,14 +83,16 @@ func Test_Handle_Fails(t *testing.T) {
 func Test_HandleSignatureMessage_SanityCheckFails(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, errors.New("some-error"))
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	assert.NotNil(t, err)
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", tsm)
 }
 
 func Test_HandleSignatureMessage_SanityCheckIsNotValid(t *testing.T) {
 	setup()
 	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(false, nil)
-	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	err := h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	assert.Nil(t, err)
 	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", tsm)
 }
 
@@ -107,10 +113,40 @@ func Test_HandleSignatureMessage_MajorityReached(t *testing.T) {
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tsm.GetFungibleSignatureMessage().TransferID).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferEventsService.On("Publish", majorityEvent).Return()
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
+	mocks.MTransferEventsService.AssertCalled(t, "Publish", majorityEvent)
+}
+
+func Test_HandleSignatureMessage_MajorityAlreadyReached(t *testing.T) {
+	setup()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tsm.GetFungibleSignatureMessage().TransferID).Return(&entity.Transfer{Status: status.Completed}, nil)
+	err := h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", majorityEvent)
+}
+
+func Test_HandleSignatureMessage_GetTransfer_Fails(t *testing.T) {
+	setup()
+	mocks.MMessageService.On("SanityCheckFungibleSignature", tsm.GetFungibleSignatureMessage()).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tsm.GetFungibleSignatureMessage().TransferID, tsm.GetFungibleSignatureMessage().Signature, tsm.GetFungibleSignatureMessage().TargetChainId, transactionTimestamp, authMsgBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tsm.GetFungibleSignatureMessage().TransferID).Return(nil, errors.New("some-error"))
+	err := h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
+	assert.Error(t, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
 
 func Test_Handle(t *testing.T) {
@@ -120,8 +156,11 @@ func Test_Handle(t *testing.T) {
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tsm.GetFungibleSignatureMessage().TransferID).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferEventsService.On("Publish", majorityEvent).Return()
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(nil)
-	h.Handle(&tsm)
+	err := h.Handle(context.Background(), &tsm)
+	assert.Nil(t, err)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
@@ -133,6 +172,8 @@ func Test_HandleSignatureMessage_UpdateStatusCompleted_Fails(t *testing.T) {
 	mocks.MMessageRepository.On("Get", tsm.GetFungibleSignatureMessage().TransferID).Return([]entity.Message{{}, {}, {}}, nil)
 	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
 	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tsm.GetFungibleSignatureMessage().TransferID).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferEventsService.On("Publish", majorityEvent).Return()
 	mocks.MTransferRepository.On("UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID).Return(errors.New("some-error"))
 	h.handleFungibleSignatureMessage(tsm.GetFungibleSignatureMessage(), transactionTimestamp)
 	mocks.MBridgeContractService.AssertCalled(t, "HasValidSignaturesLength", big.NewInt(3))
@@ -149,6 +190,49 @@ func Test_HandleSignatureMessage_CheckMajority_Fails(t *testing.T) {
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", tsm.GetFungibleSignatureMessage().TransferID)
 }
 
+func Test_Handle_Erc1155SignatureMessage(t *testing.T) {
+	setup()
+	erc1155Message := &proto.TopicEthErc1155SignatureMessage{
+		SourceChainId: 0,
+		TargetChainId: 1,
+		TransferID:    tesm.TransferID,
+		Asset:         "0x0000000000000000000000000000000000000aBc",
+		TokenIds:      []uint64{1, 2},
+		Amounts:       []string{"10", "20"},
+		Recipient:     tesm.Recipient,
+		Signature:     tesm.Signature,
+	}
+	erc1155AuthMsgBytes, err := auth_message.EncodeErc1155BytesFrom(0, 1, tesm.TransferID, erc1155Message.Asset, []int64{1, 2}, []string{"10", "20"}, tesm.Recipient)
+	assert.Nil(t, err)
+	mocks.MMessageService.On("SanityCheckErc1155Signature", erc1155Message).Return(true, nil)
+	mocks.MMessageService.On("ProcessSignature", tesm.TransferID, tesm.Signature, uint64(1), transactionTimestamp, erc1155AuthMsgBytes).Return(nil)
+	mocks.MMessageRepository.On("Get", tesm.TransferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("GetMembers").Return([]string{"", "", ""})
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", tesm.TransferID).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MTransferEventsService.On("Publish", majorityEvent).Return()
+	mocks.MTransferRepository.On("UpdateStatusCompleted", tesm.TransferID).Return(nil)
+
+	err = h.Handle(context.Background(), &message.Message{
+		TopicMessage: &proto.TopicMessage{
+			Message: &proto.TopicMessage_Erc1155SignatureMessage{Erc1155SignatureMessage: erc1155Message},
+		},
+	})
+
+	assert.Nil(t, err)
+	mocks.MMessageService.AssertCalled(t, "ProcessSignature", tesm.TransferID, tesm.Signature, uint64(1), transactionTimestamp, erc1155AuthMsgBytes)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", tesm.TransferID)
+}
+
+func Test_HandleErc1155SignatureMessage_SanityCheckIsNotValid(t *testing.T) {
+	setup()
+	erc1155Message := &proto.TopicEthErc1155SignatureMessage{TransferID: tesm.TransferID, TokenIds: []uint64{1}, Amounts: []string{"10"}}
+	mocks.MMessageService.On("SanityCheckErc1155Signature", erc1155Message).Return(false, nil)
+	err := h.handleErc1155SignatureMessage(erc1155Message, transactionTimestamp)
+	assert.Nil(t, err)
+	mocks.MMessageService.AssertNotCalled(t, "ProcessSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
@@ -163,5 +247,6 @@ func setup() {
 		prometheusService:      mocks.MPrometheusService,
 		assetsConfig:           assets,
 		participationRateGauge: nil,
+		transferEvents:         mocks.MTransferEventsService,
 	}
 }
@@ -17,6 +17,7 @@
 package mint_hts
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -35,11 +36,11 @@ func NewHandler(lockService service.LockEvent) *Handler {
 	}
 }
 
-func (mhh Handler) Handle(payload interface{}) {
+func (mhh Handler) Handle(ctx context.Context, payload interface{}) error {
 	event, ok := payload.(*model.Transfer)
 	if !ok {
 		mhh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
-	mhh.lockService.ProcessEvent(*event)
+	return mhh.lockService.ProcessEvent(ctx, *event)
 }
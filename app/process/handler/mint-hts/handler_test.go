@@ -17,6 +17,8 @@
 package mint_hts
 
 import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
@@ -50,9 +52,10 @@ func Test_Handle_Lock(t *testing.T) {
 		Receiver:      "",
 		Amount:        "0",
 	}
-	mocks.MLockService.On("ProcessEvent", *tr).Return()
-	mintHtsHandler.Handle(tr)
-	mocks.MLockService.AssertCalled(t, "ProcessEvent", *tr)
+	mocks.MLockService.On("ProcessEvent", context.Background(), *tr).Return(nil)
+	err := mintHtsHandler.Handle(context.Background(), tr)
+	assert.Nil(t, err)
+	mocks.MLockService.AssertCalled(t, "ProcessEvent", context.Background(), *tr)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -60,7 +63,8 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	mintHtsHandler.Handle(invalidTransferPayload)
+	err := mintHtsHandler.Handle(context.Background(), invalidTransferPayload)
+	assert.Equal(t, service.ErrInvalidPayload, err)
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
@@ -17,6 +17,7 @@
 package fee_message
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
@@ -37,27 +38,29 @@ func NewHandler(transfersService service.Transfers) *Handler {
 	}
 }
 
-func (fmh Handler) Handle(payload interface{}) {
+func (fmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = fmh.transfersService.ProcessNativeNftTransfer(*transferMsg)
+	err = fmh.transfersService.ProcessNativeNftTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+
+	return nil
 }
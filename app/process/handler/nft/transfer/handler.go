@@ -17,6 +17,7 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -61,23 +62,23 @@ func NewHandler(
 	}
 }
 
-func (nth Handler) Handle(payload interface{}) {
+func (nth Handler) Handle(ctx context.Context, payload interface{}) error {
 	transfer, ok := payload.(*model.Transfer)
 	if !ok {
 		nth.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transfer.TransactionId, transfer.Receiver, err)
-		return
+		return err
 	}
 
 	token, err := hedera.TokenIDFromString(transfer.TargetAsset)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", transfer.TransactionId, transfer.TargetAsset, err)
-		return
+		return err
 	}
 	nftID := hedera.NftID{
 		TokenID:      token,
@@ -87,18 +88,20 @@ func (nth Handler) Handle(payload interface{}) {
 	transactionRecord, err := nth.transfersService.InitiateNewTransfer(*transfer)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		nth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	onExecutionSuccess, onExecutionFail := nth.scheduledTxExecutionCallbacks(transfer.TransactionId, true)
 	onSuccess, onFail := nth.scheduledTxMinedCallbacks(transfer.TransactionId)
 
-	nth.scheduledService.ExecuteScheduledNftTransferTransaction(transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	nth.scheduledService.ExecuteScheduledNftTransferTransaction(ctx, transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+
+	return nil
 }
 
 func (nth *Handler) scheduledTxExecutionCallbacks(id string, hasReceiver bool) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
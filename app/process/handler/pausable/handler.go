@@ -0,0 +1,74 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pausable
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler records the transfers of the wrapped handler and defers the ones, which are paused, instead of handling them
+type Handler struct {
+	topic            string
+	handler          server.Handler
+	transfersService service.Transfers
+	pauseService     service.Pause
+	logger           *log.Entry
+}
+
+// NewHandler wraps the handler of the given topic, which signs, submits or schedules transactions for transfers
+func NewHandler(topic string, handler server.Handler, transfersService service.Transfers, pauseService service.Pause) *Handler {
+	return &Handler{
+		topic:            topic,
+		handler:          handler,
+		transfersService: transfersService,
+		pauseService:     pauseService,
+		logger:           config.GetLoggerFor("Pausable Handler"),
+	}
+}
+
+func (ph Handler) Handle(ctx context.Context, payload interface{}) error {
+	transferMsg, ok := payload.(*model.Transfer)
+	if !ok {
+		return ph.handler.Handle(ctx, payload)
+	}
+
+	transactionRecord, err := ph.transfersService.InitiateNewTransfer(*transferMsg)
+	if err != nil {
+		ph.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+
+	// Redelivered transfers, which are already in progress, are paused as well
+	if !isTerminal(transactionRecord.Status) {
+		deferred, err := ph.pauseService.Defer(ph.topic, *transferMsg)
+		if err != nil || deferred {
+			return err
+		}
+	}
+
+	return ph.handler.Handle(ctx, payload)
+}
+
+func isTerminal(s string) bool {
+	return s == status.Completed || s == status.Failed || s == status.Blocked
+}
@@ -17,6 +17,7 @@
 package burn
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
@@ -60,27 +61,27 @@ func NewHandler(
 	}
 }
 
-func (mhh Handler) Handle(payload interface{}) {
+func (mhh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		mhh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		mhh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	mhh.readOnlyService.FindTransfer(transferMsg.TransactionId,
+	err = mhh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
 		func() (*mirror_node.Response, error) {
-			return mhh.mirrorNode.GetAccountTokenBurnTransactionsAfterTimestampString(mhh.bridgeAccount, transferMsg.Timestamp)
+			return mhh.mirrorNode.GetAccountTokenBurnTransactionsAfterTimestampString(ctx, mhh.bridgeAccount, transferMsg.Timestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 			return mhh.scheduleRepository.Create(&entity.Schedule{
@@ -94,4 +95,9 @@ func (mhh Handler) Handle(payload interface{}) {
 				},
 			})
 		})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"database/sql"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirrorNode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the read-only handler of EVM native ERC-1155 batches, locked for Hedera
+type Handler struct {
+	bridgeAccount      hedera.AccountID
+	mirrorNode         client.MirrorNode
+	scheduleRepository repository.Schedule
+	transfersService   service.Transfers
+	readOnlyService    service.ReadOnly
+	logger             *log.Entry
+}
+
+func NewHandler(
+	bridgeAccount string,
+	mirrorNode client.MirrorNode,
+	scheduleRepository repository.Schedule,
+	transfersService service.Transfers,
+	readOnlyService service.ReadOnly) *Handler {
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid account id [%s]. Error: [%s]", bridgeAccount, err)
+	}
+	return &Handler{
+		bridgeAccount:      bridgeAcc,
+		mirrorNode:         mirrorNode,
+		scheduleRepository: scheduleRepository,
+		transfersService:   transfersService,
+		readOnlyService:    readOnlyService,
+		logger:             config.GetLoggerFor("Read-only Hedera ERC-1155 Mint and Transfer Handler"),
+	}
+}
+
+func (rmeh Handler) Handle(ctx context.Context, payload interface{}) error {
+	transferMsg, ok := payload.(*model.Transfer)
+	if !ok {
+		rmeh.logger.Errorf("Could not cast payload [%s]", payload)
+		return service.ErrInvalidPayload
+	}
+
+	transactionRecord, err := rmeh.transfersService.InitiateNewTransfer(*transferMsg)
+	if err != nil {
+		rmeh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		rmeh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	// The scheduled transactions of the batch share the same memo, hence they are told apart by the token they transfer
+	for _, item := range transferMsg.Erc1155Items {
+		wrappedAsset := item.WrappedAsset
+		err = rmeh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
+			func() (*mirrorNode.Response, error) {
+				response, err := rmeh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(ctx, rmeh.bridgeAccount, transferMsg.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				return response.WithTokenTransfers(wrappedAsset), nil
+			},
+			rmeh.saveSchedule(transferMsg.TransactionId, schedule.MINT, false))
+		if err != nil {
+			return err
+		}
+
+		err = rmeh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
+			func() (*mirrorNode.Response, error) {
+				response, err := rmeh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(ctx, rmeh.bridgeAccount, transferMsg.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				return response.WithTokenTransfers(wrappedAsset), nil
+			},
+			rmeh.saveSchedule(transferMsg.TransactionId, schedule.TRANSFER, true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rmeh Handler) saveSchedule(transferID, operation string, hasReceiver bool) func(transactionID, scheduleID, status string) error {
+	return func(transactionID, scheduleID, status string) error {
+		return rmeh.scheduleRepository.Create(&entity.Schedule{
+			TransactionID: transactionID,
+			ScheduleID:    scheduleID,
+			Operation:     operation,
+			Status:        status,
+			HasReceiver:   hasReceiver,
+			TransferID: sql.NullString{
+				String: transferID,
+				Valid:  true,
+			},
+		})
+	}
+}
@@ -17,6 +17,7 @@
 package fee_transfer
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
@@ -82,34 +83,34 @@ func NewHandler(
 	}
 }
 
-func (fmh *Handler) Handle(payload interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	receiver, err := hedera.AccountIDFromString(transferMsg.Receiver)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
-		return
+		return err
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	calculatedFee, remainder := fmh.feeService.CalculateFee(transferMsg.TargetAsset, intAmount)
@@ -122,7 +123,7 @@ func (fmh *Handler) Handle(payload interface{}) {
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, err := fmh.distributorService.CalculateMemberDistribution(validFee)
@@ -151,8 +152,8 @@ func (fmh *Handler) Handle(payload interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount, hasReceiver := util.GetTotalFeeFromTransfers(splitTransfer, receiver)
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirror_node.Response, error) {
-			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.Timestamp)
+		err = fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirror_node.Response, error) {
+			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(ctx, fmh.bridgeAccount, transferMsg.Timestamp)
 		}, func(transactionID, scheduleID, status string) error {
 			result := false
 			if status == entityStatus.Completed {
@@ -194,9 +195,14 @@ func (fmh *Handler) Handle(payload interface{}) {
 			}
 			return err
 		})
+		if err != nil {
+			return err
+		}
 	}
 
 	fmh.startAwaitingFunctionsForMetrics(userOutParams, transferMsg, feeOutParams)
+
+	return nil
 }
 
 func (fmh *Handler) startAwaitingFunctionsForMetrics(userOutParams *hederaHelper.UserOutParams, transferMsg *model.Transfer, feeOutParams *hederaHelper.FeeOutParams) {
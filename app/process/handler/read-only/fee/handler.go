@@ -17,6 +17,7 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
@@ -81,37 +82,37 @@ func NewHandler(
 	}
 }
 
-func (fmh Handler) Handle(payload interface{}) {
+func (fmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
-	calculatedFee, _ := fmh.feeService.CalculateFee(transferMsg.SourceAsset, intAmount)
+	calculatedFee, _ := fmh.feeService.CalculateFee(transferMsg.NativeAsset, intAmount)
 	validFee := fmh.distributor.ValidAmount(calculatedFee)
 
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, err := fmh.distributor.CalculateMemberDistribution(validFee)
@@ -133,9 +134,9 @@ func (fmh Handler) Handle(payload interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
+		err = fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
 			func() (*mirror_node.Response, error) {
-				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.Timestamp)
+				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(ctx, fmh.bridgeAccount, transferMsg.Timestamp)
 			},
 
 			func(transactionID, scheduleID, status string) error {
@@ -177,6 +178,9 @@ func (fmh Handler) Handle(payload interface{}) {
 				}
 				return err
 			})
+		if err != nil {
+			return err
+		}
 	}
 
 	if fmh.prometheusService.GetIsMonitoringEnabled() {
@@ -189,6 +193,8 @@ func (fmh Handler) Handle(payload interface{}) {
 			fmh.onMinedFeeTransactionsSetMetrics,
 		)
 	}
+
+	return nil
 }
 
 func (fmh *Handler) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transferID string, isTransferSuccessful bool) {
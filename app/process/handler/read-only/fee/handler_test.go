@@ -17,6 +17,7 @@
 package fee
 
 import (
+	"context"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -84,27 +85,27 @@ func Test_Handle(t *testing.T) {
 		Schedules:     nil,
 	}
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(tr, nil)
-	mocks.MFeeService.On("CalculateFee", tr.SourceAsset, int64(100)).Return(int64(10), int64(0))
+	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, int64(100)).Return(int64(10), int64(0))
 	mocks.MDistributorService.On("ValidAmount", 10).Return(int64(3))
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_FindTransfer(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MFeeService.On("CalculateFee", tr.SourceAsset, int64(100)).Return(int64(10), int64(0))
+	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, int64(100)).Return(int64(10), int64(0))
 	mocks.MDistributorService.On("ValidAmount", int64(10)).Return(int64(3))
 	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, "3").Return(nil)
 	mocks.MDistributorService.On("CalculateMemberDistribution", int64(3)).Return([]model.Hedera{}, nil)
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mock.Anything)
@@ -112,7 +113,7 @@ func Test_Handle_NotInitialFails(t *testing.T) {
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
+	h.Handle(context.Background(), "invalid-payload")
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
 	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
@@ -122,7 +123,7 @@ func Test_Handle_InvalidPayload(t *testing.T) {
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mock.Anything)
@@ -17,6 +17,7 @@
 package mint_hts
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
@@ -67,27 +68,27 @@ func NewHandler(
 	}
 }
 
-func (fmh *Handler) Handle(payload interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	fmh.readOnlyService.FindTransfer(transferMsg.TransactionId,
+	err = fmh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
 		func() (*mirrorNode.Response, error) {
-			return fmh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.Timestamp)
+			return fmh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(ctx, fmh.bridgeAccount, transferMsg.Timestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 			return fmh.scheduleRepository.Create(&entity.Schedule{
@@ -101,11 +102,15 @@ func (fmh *Handler) Handle(payload interface{}) {
 				},
 			})
 		})
+	if err != nil {
+		return err
+	}
 
-	fmh.readOnlyService.FindTransfer(
+	err = fmh.readOnlyService.FindTransfer(
+		ctx,
 		transferMsg.TransactionId,
 		func() (*mirrorNode.Response, error) {
-			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.Timestamp)
+			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(ctx, fmh.bridgeAccount, transferMsg.Timestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 
@@ -132,4 +137,9 @@ func (fmh *Handler) Handle(payload interface{}) {
 				},
 			})
 		})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -17,6 +17,7 @@
 package mint_hts
 
 import (
+	"context"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -59,33 +60,33 @@ func Test_NewHandler(t *testing.T) {
 func Test_Handle(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_FindTransfer(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
+	h.Handle(context.Background(), "invalid-payload")
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 }
 
 func setup() {
@@ -17,6 +17,7 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
@@ -44,7 +45,7 @@ type Handler struct {
 	distributor        service.Distributor
 	transfersService   service.Transfers
 	readOnlyService    service.ReadOnly
-	hederaNftFees      map[string]int64
+	assets             config.Assets
 	logger             *log.Entry
 }
 
@@ -56,7 +57,7 @@ func NewHandler(
 	bridgeAccount string,
 	distributor service.Distributor,
 	transfersService service.Transfers,
-	hederaNftFees map[string]int64,
+	assets config.Assets,
 	readOnlyService service.ReadOnly) *Handler {
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
 	if err != nil {
@@ -72,34 +73,34 @@ func NewHandler(
 		transfersService:   transfersService,
 		distributor:        distributor,
 		readOnlyService:    readOnlyService,
-		hederaNftFees:      hederaNftFees,
+		assets:             assets,
 	}
 }
 
-func (fmh Handler) Handle(payload interface{}) {
+func (fmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	transferMsg, ok := payload.(*model.Transfer)
 	if !ok {
 		fmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	validFee := fmh.distributor.ValidAmount(fmh.hederaNftFees[transferMsg.SourceAsset])
+	validFee := fmh.distributor.ValidAmount(fmh.assets.NftFee(transferMsg.SourceAsset))
 
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, err := fmh.distributor.CalculateMemberDistribution(validFee)
@@ -113,9 +114,9 @@ func (fmh Handler) Handle(payload interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, constants.Hbar, splitTransfer,
+		err = fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, constants.Hbar, splitTransfer,
 			func() (*mirror_node.Response, error) {
-				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.Timestamp)
+				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(ctx, fmh.bridgeAccount, transferMsg.Timestamp)
 			},
 			func(transactionID, scheduleID, status string) error {
 				err := fmh.scheduleRepository.Create(&entity.Schedule{
@@ -147,5 +148,10 @@ func (fmh Handler) Handle(payload interface{}) {
 				}
 				return err
 			})
+		if err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"database/sql"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirrorNode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the read-only handler of EVM native NFTs, locked for Hedera
+type Handler struct {
+	bridgeAccount      hedera.AccountID
+	mirrorNode         client.MirrorNode
+	transferRepository repository.Transfer
+	scheduleRepository repository.Schedule
+	transfersService   service.Transfers
+	readOnlyService    service.ReadOnly
+	logger             *log.Entry
+}
+
+func NewHandler(
+	bridgeAccount string,
+	mirrorNode client.MirrorNode,
+	transferRepository repository.Transfer,
+	scheduleRepository repository.Schedule,
+	transfersService service.Transfers,
+	readOnlyService service.ReadOnly) *Handler {
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid account id [%s]. Error: [%s]", bridgeAccount, err)
+	}
+	return &Handler{
+		bridgeAccount:      bridgeAcc,
+		mirrorNode:         mirrorNode,
+		transferRepository: transferRepository,
+		scheduleRepository: scheduleRepository,
+		transfersService:   transfersService,
+		readOnlyService:    readOnlyService,
+		logger:             config.GetLoggerFor("Read-only Hedera NFT Mint and Transfer Handler"),
+	}
+}
+
+func (rmnh Handler) Handle(ctx context.Context, payload interface{}) error {
+	transferMsg, ok := payload.(*model.Transfer)
+	if !ok {
+		rmnh.logger.Errorf("Could not cast payload [%s]", payload)
+		return service.ErrInvalidPayload
+	}
+
+	receiver, err := hedera.AccountIDFromString(transferMsg.Receiver)
+	if err != nil {
+		rmnh.logger.Errorf("[%s] - Failed to parse event receiver account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
+		return err
+	}
+
+	transactionRecord, err := rmnh.transfersService.InitiateNewTransfer(*transferMsg)
+	if err != nil {
+		rmnh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		rmnh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	serialNum := int64(0)
+	err = rmnh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
+		func() (*mirrorNode.Response, error) {
+			return rmnh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(ctx, rmnh.bridgeAccount, transferMsg.Timestamp)
+		},
+		func(transactionID, scheduleID, txStatus string) error {
+			err := rmnh.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				ScheduleID:    scheduleID,
+				Operation:     schedule.MINT,
+				Status:        txStatus,
+				TransferID: sql.NullString{
+					String: transferMsg.TransactionId,
+					Valid:  true,
+				},
+			})
+			if err != nil || txStatus != status.Completed {
+				return err
+			}
+
+			mintTransaction, err := rmnh.mirrorNode.GetSuccessfulTransaction(ctx, transactionID)
+			if err != nil {
+				return err
+			}
+			serialNum, err = mintTransaction.GetMintedNftSerialNumber(transferMsg.TargetAsset)
+			if err != nil {
+				return err
+			}
+			return rmnh.transferRepository.UpdateWrappedSerialNumber(transferMsg.TransactionId, serialNum)
+		})
+	if err != nil {
+		return err
+	}
+
+	if serialNum == 0 {
+		rmnh.logger.Errorf("[%s] - Failed to find the minted serial number of [%s].", transferMsg.TransactionId, transferMsg.TargetAsset)
+		return nil
+	}
+
+	err = rmnh.readOnlyService.FindNftTransfer(ctx, transferMsg.TransactionId,
+		transferMsg.TargetAsset,
+		serialNum,
+		rmnh.bridgeAccount.String(),
+		receiver.String(),
+		func(transactionID, scheduleID, txStatus string) error {
+			return rmnh.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				ScheduleID:    scheduleID,
+				Operation:     schedule.TRANSFER,
+				Status:        txStatus,
+				HasReceiver:   true,
+				TransferID: sql.NullString{
+					String: transferMsg.TransactionId,
+					Valid:  true,
+				},
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -59,37 +60,37 @@ func NewHandler(
 	}
 }
 
-func (rnth Handler) Handle(payload interface{}) {
+func (rnth Handler) Handle(ctx context.Context, payload interface{}) error {
 	transfer, ok := payload.(*model.Transfer)
 	if !ok {
 		rnth.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return service.ErrInvalidPayload
 	}
 
 	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
 	if err != nil {
 		rnth.logger.Errorf("[%s] - Failed to parse event receiver account [%s]. Error [%s].", transfer.TransactionId, transfer.Receiver, err)
-		return
+		return err
 	}
 
 	token, err := hedera.TokenIDFromString(transfer.TargetAsset)
 	if err != nil {
 		rnth.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", transfer.TransactionId, transfer.TargetAsset, err)
-		return
+		return err
 	}
 
 	transactionRecord, err := rnth.transfersService.InitiateNewTransfer(*transfer)
 	if err != nil {
 		rnth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		rnth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	rnth.readOnlyService.FindNftTransfer(transfer.TransactionId,
+	err = rnth.readOnlyService.FindNftTransfer(ctx, transfer.TransactionId,
 		token.String(),
 		transfer.SerialNum,
 		rnth.bridgeAccount.String(),
@@ -113,4 +114,9 @@ func (rnth Handler) Handle(payload interface{}) {
 
 			return rnth.transferRepository.UpdateStatusCompleted(transfer.TransactionId)
 		})
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -17,6 +17,7 @@
 package transfer
 
 import (
+	"context"
 	"errors"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -52,25 +53,25 @@ func Test_NewHandler(t *testing.T) {
 func Test_Handle(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
+	h.Handle(context.Background(), "invalid-payload")
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
 	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
+	h.Handle(context.Background(), tr)
 }
 
 func setup() {
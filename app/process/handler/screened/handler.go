@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screened
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler records the transfers of the wrapped handler and blocks the ones, whose sender or receiver is on the screening list, instead of handling them
+type Handler struct {
+	handler          server.Handler
+	transfersService service.Transfers
+	screeningService service.Screening
+	logger           *log.Entry
+}
+
+// NewHandler wraps a handler, which signs, submits or schedules transactions for transfers
+func NewHandler(handler server.Handler, transfersService service.Transfers, screeningService service.Screening) *Handler {
+	return &Handler{
+		handler:          handler,
+		transfersService: transfersService,
+		screeningService: screeningService,
+		logger:           config.GetLoggerFor("Screened Handler"),
+	}
+}
+
+func (sh Handler) Handle(ctx context.Context, payload interface{}) error {
+	transferMsg, ok := payload.(*model.Transfer)
+	if !ok {
+		return sh.handler.Handle(ctx, payload)
+	}
+
+	transactionRecord, err := sh.transfersService.InitiateNewTransfer(*transferMsg)
+	if err != nil {
+		sh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status == status.Initial {
+		blocked, err := sh.screeningService.Screen(*transferMsg)
+		if err != nil || blocked {
+			return err
+		}
+	}
+
+	return sh.handler.Handle(ctx, payload)
+}
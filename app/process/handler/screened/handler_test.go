@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screened
+
+import (
+	"context"
+	"errors"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	mt = model.Transfer{
+		TransactionId: "0.0.0-0000000-1234",
+		SourceChainId: 0,
+		TargetChainId: 80001,
+		Receiver:      "0x12345",
+		Amount:        "10000000000",
+		NativeAsset:   constants.Hbar,
+		SourceAsset:   constants.Hbar,
+		TargetAsset:   "0x45678",
+	}
+)
+
+type mockHandler struct {
+	mock.Mock
+}
+
+func (mh *mockHandler) Handle(ctx context.Context, payload interface{}) error {
+	args := mh.Called(payload)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func setup() (*Handler, *mockHandler) {
+	mocks.Setup()
+	inner := &mockHandler{}
+	return NewHandler(inner, mocks.MTransferService, mocks.MScreeningService), inner
+}
+
+func Test_Handle(t *testing.T) {
+	handler, inner := setup()
+	mocks.MTransferService.On("InitiateNewTransfer", mt).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MScreeningService.On("Screen", mt).Return(false, nil)
+	inner.On("Handle", &mt).Return(nil)
+
+	err := handler.Handle(context.Background(), &mt)
+
+	assert.Nil(t, err)
+	inner.AssertCalled(t, "Handle", &mt)
+}
+
+func Test_Handle_Blocked(t *testing.T) {
+	handler, inner := setup()
+	mocks.MTransferService.On("InitiateNewTransfer", mt).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MScreeningService.On("Screen", mt).Return(true, nil)
+
+	err := handler.Handle(context.Background(), &mt)
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mt)
+	inner.AssertNotCalled(t, "Handle", mock.Anything)
+}
+
+func Test_Handle_ScreenFails(t *testing.T) {
+	handler, inner := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MTransferService.On("InitiateNewTransfer", mt).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MScreeningService.On("Screen", mt).Return(false, expectedErr)
+
+	err := handler.Handle(context.Background(), &mt)
+
+	assert.Equal(t, expectedErr, err)
+	inner.AssertNotCalled(t, "Handle", mock.Anything)
+}
+
+func Test_Handle_NotInitial(t *testing.T) {
+	handler, inner := setup()
+	mocks.MTransferService.On("InitiateNewTransfer", mt).Return(&entity.Transfer{Status: status.Completed}, nil)
+	inner.On("Handle", &mt).Return(nil)
+
+	err := handler.Handle(context.Background(), &mt)
+
+	assert.Nil(t, err)
+	mocks.MScreeningService.AssertNotCalled(t, "Screen", mock.Anything)
+	inner.AssertCalled(t, "Handle", &mt)
+}
+
+func Test_Handle_InitiateFails(t *testing.T) {
+	handler, inner := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MTransferService.On("InitiateNewTransfer", mt).Return(nil, expectedErr)
+
+	err := handler.Handle(context.Background(), &mt)
+
+	assert.Equal(t, expectedErr, err)
+	inner.AssertNotCalled(t, "Handle", mock.Anything)
+}
+
+func Test_Handle_OtherPayload(t *testing.T) {
+	handler, inner := setup()
+	inner.On("Handle", "payload").Return(nil)
+
+	err := handler.Handle(context.Background(), "payload")
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
+	inner.AssertCalled(t, "Handle", "payload")
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"strings"
+	"time"
+)
+
+// external is the state of the transfers outside the database of the validator, looked up on the mirror node
+type external struct {
+	schedules  map[string]scheduledTransaction // by transfer ID
+	signatures map[string][]topicSignature     // by transfer ID
+}
+
+// scheduledTransaction is an executed scheduled transaction of the bridge account, whose schedule memo is a transfer ID
+type scheduledTransaction struct {
+	transactionID string
+	scheduleID    string
+}
+
+// topicSignature is an authorisation signature of a transfer submitted to the topic
+type topicSignature struct {
+	signer    string
+	authHash  [32]byte
+	timestamp string
+}
+
+// lookupExternal looks up the scheduled transactions of the bridge account and the signatures on the topic of the given
+// transfers. The mirror node is read once, starting from the oldest of the transfers, as the validator records a
+// transfer before scheduling transactions or submitting signatures for it
+func (r Recovery) lookupExternal(ctx context.Context, transfers []*entity.Transfer) (*external, error) {
+	ext := &external{
+		schedules:  make(map[string]scheduledTransaction),
+		signatures: make(map[string][]topicSignature),
+	}
+	if len(transfers) == 0 {
+		return ext, nil
+	}
+
+	from := transfers[0].CreatedAt
+	ids := make(map[string]bool)
+	scheduled, signed := false, false
+	for _, transfer := range transfers {
+		if transfer.CreatedAt < from {
+			from = transfer.CreatedAt
+		}
+		ids[transfer.TransactionID] = true
+		scheduled = scheduled || transfer.SourceChainID == constants.HederaNetworkId || transfer.TargetChainID == constants.HederaNetworkId
+		signed = signed || transfer.TargetChainID != constants.HederaNetworkId
+	}
+
+	var err error
+	if scheduled {
+		ext.schedules, err = r.scheduledTransactions(ctx, from, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if signed {
+		ext.signatures, err = r.topicSignatures(ctx, from, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ext, nil
+}
+
+// scheduledTransactions returns the executed scheduled transactions of the bridge account since the given timestamp,
+// whose schedule memo is one of the given transfer IDs. Schedules, which are not executed yet, are not returned, as
+// re-enqueuing the transfer only adds the validator's signature to them
+func (r Recovery) scheduledTransactions(ctx context.Context, from int64, ids map[string]bool) (map[string]scheduledTransaction, error) {
+	fetches := []func() (*model.Response, error){
+		func() (*model.Response, error) {
+			return r.mirrorClient.GetAccountDebitTransactionsAfterTimestampString(ctx, r.bridgeAccount, timestamp.String(from))
+		},
+		func() (*model.Response, error) {
+			return r.mirrorClient.GetAccountTokenMintTransactionsAfterTimestamp(ctx, r.bridgeAccount, from)
+		},
+		func() (*model.Response, error) {
+			return r.mirrorClient.GetAccountTokenBurnTransactionsAfterTimestamp(ctx, r.bridgeAccount, from)
+		},
+	}
+
+	schedules := make(map[string]scheduledTransaction)
+	for _, fetch := range fetches {
+		response, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, transaction := range response.Transactions {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !transaction.Scheduled {
+				continue
+			}
+
+			scheduledTx, err := r.mirrorClient.GetScheduledTransaction(ctx, transaction.TransactionID)
+			if err != nil {
+				return nil, err
+			}
+			for _, tx := range scheduledTx.Transactions {
+				if tx.Result != hedera.StatusSuccess.String() || tx.EntityId == "" {
+					continue
+				}
+				schedule, err := r.mirrorClient.GetSchedule(ctx, tx.EntityId)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := schedules[schedule.Memo]; !ok && ids[schedule.Memo] {
+					schedules[schedule.Memo] = scheduledTransaction{transactionID: transaction.TransactionID, scheduleID: tx.EntityId}
+				}
+			}
+		}
+	}
+	return schedules, nil
+}
+
+// topicSignatures returns the signatures of the given transfer IDs, submitted to the topic since the given timestamp
+func (r Recovery) topicSignatures(ctx context.Context, from int64, ids map[string]bool) (map[string][]topicSignature, error) {
+	messages, err := r.mirrorClient.GetMessagesForTopicBetween(ctx, r.topicID, from, time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make(map[string][]topicSignature)
+	for _, topicMsg := range messages {
+		msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
+		if err != nil {
+			r.logger.Debugf("Skipping topic message [%s], which cannot be decoded. Error: [%s].", topicMsg.ConsensusTimestamp, err)
+			continue
+		}
+		transferID, signature, authMsg, err := authorisation(msg)
+		if err != nil {
+			r.logger.Debugf("[%s] - Skipping topic message [%s], whose authorisation cannot be encoded. Error: [%s].", transferID, topicMsg.ConsensusTimestamp, err)
+			continue
+		}
+		if !ids[transferID] {
+			continue
+		}
+
+		signatureBytes, _, err := evm.DecodeSignature(signature)
+		if err != nil {
+			r.logger.Debugf("[%s] - Skipping invalid signature [%s] on the topic. Error: [%s].", transferID, signature, err)
+			continue
+		}
+		signer, err := evm.RecoverSignerFromBytes(authMsg, signatureBytes)
+		if err != nil {
+			r.logger.Debugf("[%s] - Skipping signature [%s] on the topic, whose signer cannot be recovered. Error: [%s].", transferID, signature, err)
+			continue
+		}
+
+		topicSig := topicSignature{signer: signer, timestamp: topicMsg.ConsensusTimestamp}
+		copy(topicSig.authHash[:], authMsg)
+		signatures[transferID] = append(signatures[transferID], topicSig)
+	}
+	return signatures, nil
+}
+
+// authorisation returns the transfer ID, the signature and the signed authorisation message of the given topic message
+func authorisation(msg *message.Message) (transferID, signature string, authMsg []byte, err error) {
+	switch m := msg.Message.(type) {
+	case *proto.TopicMessage_FungibleSignatureMessage:
+		tsm := m.FungibleSignatureMessage
+		authMsg, err = auth_message.EncodeFungibleBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tsm.Recipient, tsm.Amount)
+		return tsm.TransferID, tsm.Signature, authMsg, err
+	case *proto.TopicMessage_NftSignatureMessage:
+		tsm := m.NftSignatureMessage
+		authMsg, err = auth_message.EncodeNftBytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, int64(tsm.TokenId), tsm.Metadata, tsm.Recipient)
+		return tsm.TransferID, tsm.Signature, authMsg, err
+	case *proto.TopicMessage_Erc1155SignatureMessage:
+		tsm := m.Erc1155SignatureMessage
+		tokenIds := make([]int64, len(tsm.TokenIds))
+		for i, tokenId := range tsm.TokenIds {
+			tokenIds[i] = int64(tokenId)
+		}
+		authMsg, err = auth_message.EncodeErc1155BytesFrom(tsm.SourceChainId, tsm.TargetChainId, tsm.TransferID, tsm.Asset, tokenIds, tsm.Amounts, tsm.Recipient)
+		return tsm.TransferID, tsm.Signature, authMsg, err
+	default:
+		return "", "", nil, fmt.Errorf("unexpected topic message [%T]", m)
+	}
+}
+
+// externalProgress returns the reason, why the transfer does not need to be re-enqueued according to its external
+// state, or an empty string if it does, together with the findings of every check
+func (r Recovery) externalProgress(transfer *entity.Transfer, ext *external) (string, []string, error) {
+	var findings []string
+	if transfer.TargetChainID != constants.HederaNetworkId {
+		signer := r.signers[transfer.TargetChainID].Address()
+		signatures := ext.signatures[transfer.TransactionID]
+		for _, signature := range signatures {
+			if strings.EqualFold(signature.signer, signer) {
+				findings = append(findings, fmt.Sprintf("signature of the validator found on the topic at [%s]", signature.timestamp))
+				return reasonSignedOnTopic, findings, nil
+			}
+		}
+		findings = append(findings, fmt.Sprintf("[%d] signatures found on the topic, none of them by the validator", len(signatures)))
+
+		if contracts, ok := r.contractServices[transfer.TargetChainID]; ok && len(signatures) > 0 {
+			checked := make(map[[32]byte]bool)
+			for _, signature := range signatures {
+				if checked[signature.authHash] {
+					continue
+				}
+				checked[signature.authHash] = true
+
+				used, err := contracts.IsHashUsed(signature.authHash)
+				if err != nil {
+					return "", findings, err
+				}
+				if used {
+					findings = append(findings, fmt.Sprintf("mint or unlock authorised by [0x%x] executed on chain [%d]", signature.authHash, transfer.TargetChainID))
+					return reasonPaidOutOnChain, findings, nil
+				}
+			}
+			findings = append(findings, fmt.Sprintf("no mint or unlock of the transfer executed on chain [%d]", transfer.TargetChainID))
+		}
+	}
+
+	if transfer.SourceChainID == constants.HederaNetworkId || transfer.TargetChainID == constants.HederaNetworkId {
+		if schedule, ok := ext.schedules[transfer.TransactionID]; ok {
+			findings = append(findings, fmt.Sprintf("scheduled transaction [%s] of schedule [%s] found on the mirror node", schedule.transactionID, schedule.scheduleID))
+			return reasonScheduledOnMirrorNode, findings, nil
+		}
+		findings = append(findings, "no scheduled transaction found on the mirror node")
+	}
+
+	return "", findings, nil
+}
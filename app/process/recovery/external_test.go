@@ -0,0 +1,224 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var otherKey, _ = crypto.HexToECDSA("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+
+// signatureMessage returns the topic message with the signature of the given transfer by the given key
+func signatureMessage(t *testing.T, key *ecdsa.PrivateKey, transfer *entity.Transfer, consensusTimestamp string) (mirror_node.Message, [32]byte) {
+	authMsg, err := auth_message.EncodeFungibleBytesFrom(transfer.SourceChainID, transfer.TargetChainID, transfer.TransactionID, transfer.TargetAsset, transfer.Receiver, transfer.Amount)
+	assert.Nil(t, err)
+	signature, err := crypto.Sign(authMsg, key)
+	assert.Nil(t, err)
+	signature[64] += 27
+
+	bytes, err := message.NewFungibleSignature(&proto.TopicEthSignatureMessage{
+		SourceChainId: transfer.SourceChainID,
+		TargetChainId: transfer.TargetChainID,
+		TransferID:    transfer.TransactionID,
+		Asset:         transfer.TargetAsset,
+		Recipient:     transfer.Receiver,
+		Amount:        transfer.Amount,
+		Signature:     hex.EncodeToString(signature),
+	}).ToBytes()
+	assert.Nil(t, err)
+
+	var authHash [32]byte
+	copy(authHash[:], authMsg)
+	return mirror_node.Message{ConsensusTimestamp: consensusTimestamp, Contents: base64.StdEncoding.EncodeToString(bytes)}, authHash
+}
+
+func Test_LookupExternal_NoTransfers(t *testing.T) {
+	setup()
+
+	ext, err := r.lookupExternal(context.Background(), nil)
+
+	assert.Nil(t, err)
+	assert.Empty(t, ext.schedules)
+	assert.Empty(t, ext.signatures)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetMessagesForTopicBetween", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_LookupExternal_EvmToEvm(t *testing.T) {
+	setup()
+	evmToEvm := *hederaToEvm
+	evmToEvm.SourceChainID = 80002
+	evmToEvm.CreatedAt = 10
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(10), mock.Anything).Return([]mirror_node.Message{}, nil)
+
+	_, err := r.lookupExternal(context.Background(), []*entity.Transfer{&evmToEvm})
+
+	assert.Nil(t, err)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetAccountDebitTransactionsAfterTimestampString", mock.Anything, mock.Anything)
+}
+
+func Test_ScheduledTransactions(t *testing.T) {
+	setup()
+	mocks.MHederaMirrorClient.On("GetAccountDebitTransactionsAfterTimestampString", bridgeAccount, "0.10").Return(&mirror_node.Response{
+		Transactions: []mirror_node.Transaction{
+			{TransactionID: "0.0.5-1-1"},
+			{TransactionID: "0.0.5-1-2", Scheduled: true},
+		},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetAccountTokenMintTransactionsAfterTimestamp", bridgeAccount, int64(10)).Return(&mirror_node.Response{
+		Transactions: []mirror_node.Transaction{{TransactionID: "0.0.5-1-3", Scheduled: true}},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetAccountTokenBurnTransactionsAfterTimestamp", bridgeAccount, int64(10)).Return(&mirror_node.Response{}, nil)
+	mocks.MHederaMirrorClient.On("GetScheduledTransaction", "0.0.5-1-2").Return(&mirror_node.Response{
+		Transactions: []mirror_node.Transaction{
+			{Result: hedera.StatusSuccess.String(), EntityId: "0.0.9"},
+			{Result: hedera.StatusSuccess.String()},
+		},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetScheduledTransaction", "0.0.5-1-3").Return(&mirror_node.Response{
+		Transactions: []mirror_node.Transaction{{Result: hedera.StatusSuccess.String(), EntityId: "0.0.10"}},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetSchedule", "0.0.9").Return(&mirror_node.Schedule{Memo: evmToHedera.TransactionID}, nil)
+	mocks.MHederaMirrorClient.On("GetSchedule", "0.0.10").Return(&mirror_node.Schedule{Memo: "other-transfer"}, nil)
+
+	schedules, err := r.scheduledTransactions(context.Background(), 10, map[string]bool{evmToHedera.TransactionID: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]scheduledTransaction{
+		evmToHedera.TransactionID: {transactionID: "0.0.5-1-2", scheduleID: "0.0.9"},
+	}, schedules)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetScheduledTransaction", "0.0.5-1-1")
+}
+
+func Test_ScheduledTransactions_Fails(t *testing.T) {
+	setup()
+	mocks.MHederaMirrorClient.On("GetAccountDebitTransactionsAfterTimestampString", bridgeAccount, mock.Anything).Return(&mirror_node.Response{}, errors.New("some-error"))
+
+	schedules, err := r.scheduledTransactions(context.Background(), 0, map[string]bool{})
+
+	assert.Nil(t, schedules)
+	assert.Error(t, err)
+}
+
+func Test_TopicSignatures(t *testing.T) {
+	setup()
+	signed, authHash := signatureMessage(t, signerKey, hederaToEvm, "1.000000001")
+	other := *hederaToEvm
+	other.TransactionID = "0.0.1-1-2"
+	unrelated, _ := signatureMessage(t, otherKey, &other, "1.000000002")
+	invalid := mirror_node.Message{ConsensusTimestamp: "1.000000003", Contents: "invalid"}
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(10), mock.Anything).Return([]mirror_node.Message{signed, unrelated, invalid}, nil)
+
+	signatures, err := r.topicSignatures(context.Background(), 10, map[string]bool{hederaToEvm.TransactionID: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]topicSignature{
+		hederaToEvm.TransactionID: {{signer: signerAddress, authHash: authHash, timestamp: "1.000000001"}},
+	}, signatures)
+}
+
+func Test_TopicSignatures_Fails(t *testing.T) {
+	setup()
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(0), mock.Anything).Return([]mirror_node.Message{}, errors.New("some-error"))
+
+	signatures, err := r.topicSignatures(context.Background(), 0, map[string]bool{})
+
+	assert.Nil(t, signatures)
+	assert.Error(t, err)
+}
+
+func Test_Authorisation_UnexpectedMessage(t *testing.T) {
+	_, _, _, err := authorisation(&message.Message{TopicMessage: &proto.TopicMessage{}})
+
+	assert.Error(t, err)
+}
+
+func Test_ExternalProgress(t *testing.T) {
+	setup()
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	ownHash, otherHash, unusedHash := [32]byte{1}, [32]byte{2}, [32]byte{3}
+	mocks.MBridgeContractService.On("IsHashUsed", otherHash).Return(true, nil)
+	mocks.MBridgeContractService.On("IsHashUsed", unusedHash).Return(false, nil)
+
+	paidOut := *hederaToEvm
+	paidOut.TransactionID = "paid-out"
+	unpaid := *hederaToEvm
+	unpaid.TransactionID = "unpaid"
+	ext := &external{
+		schedules: map[string]scheduledTransaction{
+			evmToHedera.TransactionID: {transactionID: "0.0.5-1-2", scheduleID: "0.0.9"},
+		},
+		signatures: map[string][]topicSignature{
+			hederaToEvm.TransactionID: {{signer: signerAddress, authHash: ownHash, timestamp: "1.000000001"}},
+			"paid-out":                {{signer: "0xother", authHash: otherHash}, {signer: "0xanother", authHash: otherHash}},
+			"unpaid":                  {{signer: "0xother", authHash: unusedHash}},
+		},
+	}
+
+	for _, test := range []struct {
+		transfer *entity.Transfer
+		reason   string
+		findings []string
+	}{
+		{hederaToEvm, reasonSignedOnTopic, []string{"signature of the validator found on the topic at [1.000000001]"}},
+		{&paidOut, reasonPaidOutOnChain, []string{
+			"[2] signatures found on the topic, none of them by the validator",
+			"mint or unlock authorised by [0x0200000000000000000000000000000000000000000000000000000000000000] executed on chain [80001]",
+		}},
+		{&unpaid, "", []string{
+			"[1] signatures found on the topic, none of them by the validator",
+			"no mint or unlock of the transfer executed on chain [80001]",
+			"no scheduled transaction found on the mirror node",
+		}},
+		{evmToHedera, reasonScheduledOnMirrorNode, []string{"scheduled transaction [0.0.5-1-2] of schedule [0.0.9] found on the mirror node"}},
+	} {
+		reason, findings, err := r.externalProgress(test.transfer, ext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, test.reason, reason, test.transfer.TransactionID)
+		assert.Equal(t, test.findings, findings, test.transfer.TransactionID)
+	}
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "IsHashUsed", 2)
+}
+
+func Test_ExternalProgress_IsHashUsedFails(t *testing.T) {
+	setup()
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MBridgeContractService.On("IsHashUsed", [32]byte{1}).Return(false, errors.New("some-error"))
+	ext := &external{signatures: map[string][]topicSignature{
+		hederaToEvm.TransactionID: {{signer: "0xother", authHash: [32]byte{1}}},
+	}}
+
+	reason, _, err := r.externalProgress(hederaToEvm, ext)
+
+	assert.Error(t, err)
+	assert.Empty(t, reason)
+}
@@ -0,0 +1,297 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"strings"
+	"time"
+)
+
+// Reasons for not re-enqueuing a transfer stuck in INITIAL
+const (
+	reasonQueued       = "a message of the transfer is queued"
+	reasonPaused       = "the transfer is paused"
+	reasonDeadLettered = "a message of the transfer is dead-lettered"
+	reasonScheduled    = "scheduled transactions of the transfer are recorded"
+	reasonSigned       = "the transfer is already signed"
+	reasonPaidOut      = "the transfer is already paid out"
+	reasonNoSigner     = "no signer is configured for the target chain"
+
+	reasonScheduledOnMirrorNode = "scheduled transactions of the transfer are found on the mirror node"
+	reasonSignedOnTopic         = "the signature of the validator is found on the topic"
+	reasonPaidOutOnChain        = "the transfer is paid out on the target chain"
+)
+
+// initialResult is the outcome of the recovery of a single transfer stuck in INITIAL.
+// Either the topic the transfer is re-enqueued to, or the reason it is skipped is set. The findings describe the
+// external state of the transfer, if it is checked
+type initialResult struct {
+	transferID string
+	topic      string
+	reason     string
+	findings   []string
+}
+
+// recoverInitialTransfers re-enqueues the transfers stuck in INITIAL to the handler topic of their watcher, unless
+// a queued, paused or dead-lettered message, or a recorded signature, scheduled transaction or pay-out event shows
+// that the transfer is already taken care of. The remaining transfers are re-enqueued only if neither the mirror node
+// nor the target chain show that the transfer is already taken care of. In dry-run mode, the transfers are only reported
+func (r Recovery) recoverInitialTransfers(ctx context.Context) []initialResult {
+	since := int64(0)
+	if r.config.MaxAge > 0 {
+		since = time.Now().Add(-r.config.MaxAge * time.Second).UnixNano()
+	}
+
+	transfers, err := r.transferRepository.GetInitial(since)
+	if err != nil {
+		r.logger.Errorf("Failed to get the transfers in INITIAL. Error: [%s].", err)
+		return nil
+	}
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	pending, err := r.pendingTransfers()
+	if err != nil {
+		r.logger.Errorf("Failed to get the pending messages of transfers. Error: [%s].", err)
+		return nil
+	}
+
+	var checked, candidates []*entity.Transfer
+	reasons := make(map[string]string)
+	for _, transfer := range transfers {
+		reason, ok := pending[transfer.TransactionID]
+		if !ok {
+			reason, err = r.progress(transfer)
+			if err != nil {
+				r.logger.Errorf("[%s] - Failed to determine the progress of the transfer. Error: [%s].", transfer.TransactionID, err)
+				continue
+			}
+		}
+		checked = append(checked, transfer)
+		reasons[transfer.TransactionID] = reason
+		if reason == "" {
+			candidates = append(candidates, transfer)
+		}
+	}
+
+	ext, err := r.lookupExternal(ctx, candidates)
+	if err != nil {
+		r.logger.Errorf("Failed to look up the transfers in INITIAL on the mirror node. Error: [%s].", err)
+		return nil
+	}
+
+	var results []initialResult
+	requeued := 0
+	for _, transfer := range checked {
+		result := initialResult{transferID: transfer.TransactionID, reason: reasons[transfer.TransactionID]}
+		if result.reason == "" {
+			result.reason, result.findings, err = r.externalProgress(transfer, ext)
+			if err != nil {
+				r.logger.Errorf("[%s] - Failed to determine the progress of the transfer on the target chain. Error: [%s].", transfer.TransactionID, err)
+				continue
+			}
+		}
+		findings := strings.Join(result.findings, "; ")
+
+		if result.reason != "" {
+			if r.config.DryRun {
+				r.logger.Infof("[%s] - Dry run. Would skip transfer in INITIAL, because %s. Findings: [%s].", transfer.TransactionID, result.reason, findings)
+			} else {
+				r.logger.Debugf("[%s] - Skipping recovery of transfer in INITIAL, because %s. Findings: [%s].", transfer.TransactionID, result.reason, findings)
+			}
+			results = append(results, result)
+			continue
+		}
+
+		result.topic = initialTopic(transfer)
+		if r.config.DryRun {
+			r.logger.Infof("[%s] - Dry run. Would re-enqueue transfer in INITIAL to [%s]. Findings: [%s].", transfer.TransactionID, result.topic, findings)
+		} else {
+			r.logger.Infof("[%s] - Re-enqueuing transfer in INITIAL to [%s]. Findings: [%s].", transfer.TransactionID, result.topic, findings)
+			err = r.queue.Push(ctx, &queue.Message{Payload: toModel(transfer), Topic: result.topic})
+			if err != nil {
+				r.logger.Errorf("[%s] - Failed to re-enqueue transfer in INITIAL. Error: [%s].", transfer.TransactionID, err)
+				if ctx.Err() != nil {
+					return results
+				}
+				continue
+			}
+		}
+		requeued++
+		results = append(results, result)
+	}
+
+	if r.config.DryRun {
+		r.logger.Infof("Dry run. Found [%d] transfers in INITIAL, [%d] would be re-enqueued.", len(results), requeued)
+	} else {
+		r.logger.Infof("Found [%d] transfers in INITIAL, [%d] were re-enqueued.", len(results), requeued)
+	}
+	return results
+}
+
+// pendingTransfers returns the transfers with queued, paused or dead-lettered messages and the respective reason
+func (r Recovery) pendingTransfers() (map[string]string, error) {
+	pending := make(map[string]string)
+
+	queued, err := r.queueRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range queued {
+		if id := payloadTransferID(message.PayloadType, message.Payload); id != "" {
+			pending[id] = reasonQueued
+		}
+	}
+
+	deadLetters, err := r.deadLetterRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, deadLetter := range deadLetters {
+		if id := payloadTransferID(deadLetter.PayloadType, deadLetter.Payload); id != "" {
+			pending[id] = reasonDeadLettered
+		}
+	}
+
+	paused, err := r.pausedMessageRepository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range paused {
+		pending[message.TransferID] = reasonPaused
+	}
+
+	return pending, nil
+}
+
+// progress returns the reason, why the transfer does not need to be re-enqueued, or an empty string if it does.
+// Transfers to Hedera are done once their scheduled transactions are recorded, as those are tracked by the recovery
+// of submitted schedules. Transfers to EVM networks are done once the validator's signature is recorded from the topic
+// or the pay-out event is recorded from the target chain
+func (r Recovery) progress(transfer *entity.Transfer) (string, error) {
+	if transfer.TargetChainID == constants.HederaNetworkId {
+		if len(transfer.Schedules) > 0 || len(transfer.Fees) > 0 {
+			return reasonScheduled, nil
+		}
+		return "", nil
+	}
+
+	signer, ok := r.signers[transfer.TargetChainID]
+	if !ok {
+		return reasonNoSigner, nil
+	}
+	for _, message := range transfer.Messages {
+		if strings.EqualFold(message.Signer, signer.Address()) {
+			return reasonSigned, nil
+		}
+	}
+
+	events, err := r.evmEventRepository.GetByTransferID(transfer.TransactionID)
+	if err != nil {
+		return "", err
+	}
+	for _, event := range events {
+		if !event.Orphaned && !event.IsSource() {
+			return reasonPaidOut, nil
+		}
+	}
+
+	return "", nil
+}
+
+// payloadTransferID returns the ID of the transfer carried by the given queue payload, or an empty string if the
+// payload is not a transfer
+func payloadTransferID(payloadType, payload string) string {
+	if payloadType != queue.TransferPayload {
+		return ""
+	}
+	decoded, err := queue.DecodePayload(payloadType, payload)
+	if err != nil {
+		return ""
+	}
+	return decoded.(*model.Transfer).TransactionId
+}
+
+// initialTopic returns the topic, to which the watchers push the given transfer when processed by a validator
+func initialTopic(transfer *entity.Transfer) string {
+	if transfer.SourceChainID == constants.HederaNetworkId {
+		switch {
+		case transfer.IsErc1155:
+			return constants.HederaBurnErc1155MessageSubmission
+		case transfer.NativeChainID == constants.HederaNetworkId && transfer.IsNft:
+			return constants.HederaNativeNftTransfer
+		case transfer.NativeChainID == constants.HederaNetworkId:
+			return constants.HederaTransferMessageSubmission
+		case transfer.IsNft:
+			return constants.HederaBurnNftMessageSubmission
+		default:
+			return constants.HederaBurnMessageSubmission
+		}
+	}
+
+	switch {
+	case transfer.IsErc1155:
+		return constants.HederaMintErc1155Transfer
+	case transfer.IsNft && transfer.NativeChainID == constants.HederaNetworkId:
+		return constants.HederaNftTransfer
+	case transfer.IsNft:
+		return constants.HederaMintNftTransfer
+	case transfer.TargetChainID == constants.HederaNetworkId && transfer.NativeChainID == constants.HederaNetworkId:
+		return constants.HederaFeeTransfer
+	case transfer.TargetChainID == constants.HederaNetworkId:
+		return constants.HederaMintHtsTransfer
+	case transfer.NativeChainID == constants.HederaNetworkId:
+		return constants.WrappedFeeMessageSubmission
+	default:
+		return constants.TopicMessageSubmission
+	}
+}
+
+// toModel recreates the payload, which the watchers pushed for the given transfer
+func toModel(transfer *entity.Transfer) *model.Transfer {
+	tm := &model.Transfer{
+		TransactionId:    transfer.TransactionID,
+		SourceChainId:    transfer.SourceChainID,
+		TargetChainId:    transfer.TargetChainID,
+		NativeChainId:    transfer.NativeChainID,
+		SourceAsset:      transfer.SourceAsset,
+		TargetAsset:      transfer.TargetAsset,
+		NativeAsset:      transfer.NativeAsset,
+		Receiver:         transfer.Receiver,
+		Amount:           transfer.Amount,
+		SerialNum:        transfer.SerialNumber,
+		Metadata:         transfer.Metadata,
+		IsNft:            transfer.IsNft,
+		ConfigVersion:    transfer.ConfigVersion,
+		WrappedSerialNum: transfer.WrappedSerialNumber,
+		IsErc1155:        transfer.IsErc1155,
+	}
+	for _, item := range transfer.Erc1155Items {
+		tm.Erc1155Items = append(tm.Erc1155Items, model.Erc1155Item{
+			TokenId:      item.TokenID,
+			Amount:       item.Amount,
+			WrappedAsset: item.WrappedAsset,
+		})
+	}
+	return tm
+}
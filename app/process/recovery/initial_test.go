@@ -0,0 +1,273 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+)
+
+var (
+	signerKey, _  = crypto.HexToECDSA("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	signerAddress = crypto.PubkeyToAddress(signerKey.PublicKey).String()
+
+	hederaToEvm = &entity.Transfer{
+		TransactionID: "0.0.1-1-1",
+		SourceChainID: constants.HederaNetworkId,
+		TargetChainID: 80001,
+		NativeChainID: constants.HederaNetworkId,
+		SourceAsset:   constants.Hbar,
+		TargetAsset:   "0xwrapped",
+		NativeAsset:   constants.Hbar,
+		Receiver:      "0xreceiver",
+		Amount:        "100",
+		ConfigVersion: "some-version",
+	}
+	evmToHedera = &entity.Transfer{
+		TransactionID: "0xhash-0",
+		SourceChainID: 80001,
+		TargetChainID: constants.HederaNetworkId,
+		NativeChainID: 80001,
+		SourceAsset:   "0xnative",
+		TargetAsset:   "0.0.2",
+		NativeAsset:   "0xnative",
+		Receiver:      "0.0.3",
+		Amount:        "100",
+	}
+)
+
+func setupInitial(transfers ...*entity.Transfer) {
+	setup()
+	mocks.MSignerService.On("Address").Return(signerAddress)
+	mocks.MTransferRepository.On("GetInitial", mock.Anything).Return(transfers, nil)
+	mocks.MQueueRepository.On("GetAll").Return([]*entity.QueueMessage{}, nil)
+	mocks.MDeadLetterRepository.On("GetAll").Return([]*entity.DeadLetter{}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{}, nil)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+}
+
+func setupExternal(messages []mirror_node.Message, debit *mirror_node.Response) {
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, mock.Anything, mock.Anything).Return(messages, nil)
+	mocks.MHederaMirrorClient.On("GetAccountDebitTransactionsAfterTimestampString", bridgeAccount, mock.Anything).Return(debit, nil)
+	mocks.MHederaMirrorClient.On("GetAccountTokenMintTransactionsAfterTimestamp", bridgeAccount, mock.Anything).Return(&mirror_node.Response{}, nil)
+	mocks.MHederaMirrorClient.On("GetAccountTokenBurnTransactionsAfterTimestamp", bridgeAccount, mock.Anything).Return(&mirror_node.Response{}, nil)
+}
+
+func Test_RecoverInitialTransfers_Requeues(t *testing.T) {
+	setupInitial(hederaToEvm, evmToHedera)
+	mocks.MEvmEventRepository.On("GetByTransferID", hederaToEvm.TransactionID).Return([]*entity.EvmEvent{
+		{Name: entity.EvmEventLock},
+		{Name: entity.EvmEventMint, Orphaned: true},
+	}, nil)
+	setupExternal([]mirror_node.Message{}, &mirror_node.Response{})
+
+	results := r.recoverInitialTransfers(context.Background())
+
+	assert.Equal(t, []initialResult{
+		{
+			transferID: hederaToEvm.TransactionID,
+			topic:      constants.HederaTransferMessageSubmission,
+			findings: []string{
+				"[0] signatures found on the topic, none of them by the validator",
+				"no scheduled transaction found on the mirror node",
+			},
+		},
+		{
+			transferID: evmToHedera.TransactionID,
+			topic:      constants.HederaMintHtsTransfer,
+			findings:   []string{"no scheduled transaction found on the mirror node"},
+		},
+	}, results)
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{
+		Topic: constants.HederaTransferMessageSubmission,
+		Payload: &model.Transfer{
+			TransactionId: hederaToEvm.TransactionID,
+			SourceChainId: constants.HederaNetworkId,
+			TargetChainId: 80001,
+			NativeChainId: constants.HederaNetworkId,
+			SourceAsset:   constants.Hbar,
+			TargetAsset:   "0xwrapped",
+			NativeAsset:   constants.Hbar,
+			Receiver:      "0xreceiver",
+			Amount:        "100",
+			ConfigVersion: "some-version",
+		},
+	})
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 2)
+}
+
+func Test_RecoverInitialTransfers_DryRun(t *testing.T) {
+	setupInitial(evmToHedera)
+	setupExternal([]mirror_node.Message{}, &mirror_node.Response{})
+	r.config.DryRun = true
+
+	results := r.recoverInitialTransfers(context.Background())
+
+	assert.Equal(t, []initialResult{{
+		transferID: evmToHedera.TransactionID,
+		topic:      constants.HederaMintHtsTransfer,
+		findings:   []string{"no scheduled transaction found on the mirror node"},
+	}}, results)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RecoverInitialTransfers_SkipsDone(t *testing.T) {
+	signed := *hederaToEvm
+	signed.TransactionID = "signed"
+	signed.Messages = []entity.Message{{Signer: strings.ToUpper(signerAddress)}}
+	paidOut := *hederaToEvm
+	paidOut.TransactionID = "paid-out"
+	scheduled := *evmToHedera
+	scheduled.Schedules = []entity.Schedule{{TransactionID: "some-schedule"}}
+	setupInitial(&signed, &paidOut, &scheduled)
+	mocks.MEvmEventRepository.On("GetByTransferID", "paid-out").Return([]*entity.EvmEvent{{Name: entity.EvmEventUnlock}}, nil)
+
+	results := r.recoverInitialTransfers(context.Background())
+
+	assert.Equal(t, []initialResult{
+		{transferID: "signed", reason: reasonSigned},
+		{transferID: "paid-out", reason: reasonPaidOut},
+		{transferID: evmToHedera.TransactionID, reason: reasonScheduled},
+	}, results)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RecoverInitialTransfers_SkipsDoneExternally(t *testing.T) {
+	setupInitial(hederaToEvm, evmToHedera)
+	mocks.MEvmEventRepository.On("GetByTransferID", hederaToEvm.TransactionID).Return([]*entity.EvmEvent{}, nil)
+	signed, _ := signatureMessage(t, signerKey, hederaToEvm, "1.000000001")
+	setupExternal([]mirror_node.Message{signed}, &mirror_node.Response{
+		Transactions: []mirror_node.Transaction{{TransactionID: "0.0.5-1-2", Scheduled: true}},
+	})
+	mocks.MHederaMirrorClient.On("GetScheduledTransaction", "0.0.5-1-2").Return(&mirror_node.Response{
+		Transactions: []mirror_node.Transaction{{Result: hedera.StatusSuccess.String(), EntityId: "0.0.9"}},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetSchedule", "0.0.9").Return(&mirror_node.Schedule{Memo: evmToHedera.TransactionID}, nil)
+	r.config.DryRun = true
+
+	results := r.recoverInitialTransfers(context.Background())
+
+	assert.Equal(t, []initialResult{
+		{
+			transferID: hederaToEvm.TransactionID,
+			reason:     reasonSignedOnTopic,
+			findings:   []string{"signature of the validator found on the topic at [1.000000001]"},
+		},
+		{
+			transferID: evmToHedera.TransactionID,
+			reason:     reasonScheduledOnMirrorNode,
+			findings:   []string{"scheduled transaction [0.0.5-1-2] of schedule [0.0.9] found on the mirror node"},
+		},
+	}, results)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RecoverInitialTransfers_LookupFails(t *testing.T) {
+	setupInitial(evmToHedera)
+	mocks.MHederaMirrorClient.On("GetAccountDebitTransactionsAfterTimestampString", bridgeAccount, mock.Anything).Return(&mirror_node.Response{}, errors.New("some-error"))
+
+	assert.Nil(t, r.recoverInitialTransfers(context.Background()))
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RecoverInitialTransfers_SkipsPending(t *testing.T) {
+	queued := *evmToHedera
+	queued.TransactionID = "queued"
+	deadLettered := *evmToHedera
+	deadLettered.TransactionID = "dead-lettered"
+	paused := *evmToHedera
+	paused.TransactionID = "paused"
+	setup()
+	mocks.MTransferRepository.On("GetInitial", mock.Anything).Return([]*entity.Transfer{&queued, &deadLettered, &paused}, nil)
+	mocks.MQueueRepository.On("GetAll").Return([]*entity.QueueMessage{
+		{PayloadType: queue.TransferPayload, Payload: `{"TransactionId":"queued"}`},
+		{PayloadType: queue.TopicMessagePayload, Payload: `{}`},
+	}, nil)
+	mocks.MDeadLetterRepository.On("GetAll").Return([]*entity.DeadLetter{
+		{PayloadType: queue.TransferPayload, Payload: `{"TransactionId":"dead-lettered"}`},
+	}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{{TransferID: "paused"}}, nil)
+
+	results := r.recoverInitialTransfers(context.Background())
+
+	assert.Equal(t, []initialResult{
+		{transferID: "queued", reason: reasonQueued},
+		{transferID: "dead-lettered", reason: reasonDeadLettered},
+		{transferID: "paused", reason: reasonPaused},
+	}, results)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RecoverInitialTransfers_GetInitialFails(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetInitial", mock.Anything).Return(nil, errors.New("some-error"))
+
+	assert.Nil(t, r.recoverInitialTransfers(context.Background()))
+	mocks.MQueueRepository.AssertNotCalled(t, "GetAll")
+}
+
+func Test_RecoverInitialTransfers_MaxAge(t *testing.T) {
+	setupInitial()
+	r.config.MaxAge = 0
+
+	r.recoverInitialTransfers(context.Background())
+
+	mocks.MTransferRepository.AssertCalled(t, "GetInitial", int64(0))
+}
+
+func Test_InitialTopic(t *testing.T) {
+	for topic, transfer := range map[string]entity.Transfer{
+		constants.HederaTransferMessageSubmission:    {SourceChainID: 0, TargetChainID: 1, NativeChainID: 0},
+		constants.HederaNativeNftTransfer:            {SourceChainID: 0, TargetChainID: 1, NativeChainID: 0, IsNft: true},
+		constants.HederaBurnMessageSubmission:        {SourceChainID: 0, TargetChainID: 1, NativeChainID: 1},
+		constants.HederaBurnNftMessageSubmission:     {SourceChainID: 0, TargetChainID: 1, NativeChainID: 1, IsNft: true},
+		constants.HederaBurnErc1155MessageSubmission: {SourceChainID: 0, TargetChainID: 1, NativeChainID: 1, IsErc1155: true},
+		constants.HederaMintHtsTransfer:              {SourceChainID: 1, TargetChainID: 0, NativeChainID: 1},
+		constants.HederaMintNftTransfer:              {SourceChainID: 1, TargetChainID: 0, NativeChainID: 1, IsNft: true},
+		constants.HederaMintErc1155Transfer:          {SourceChainID: 1, TargetChainID: 0, NativeChainID: 1, IsErc1155: true},
+		constants.HederaFeeTransfer:                  {SourceChainID: 1, TargetChainID: 0, NativeChainID: 0},
+		constants.HederaNftTransfer:                  {SourceChainID: 1, TargetChainID: 0, NativeChainID: 0, IsNft: true},
+		constants.WrappedFeeMessageSubmission:        {SourceChainID: 1, TargetChainID: 2, NativeChainID: 0},
+		constants.TopicMessageSubmission:             {SourceChainID: 1, TargetChainID: 2, NativeChainID: 1},
+	} {
+		transfer := transfer
+		assert.Equal(t, topic, initialTopic(&transfer))
+	}
+}
+
+func Test_ToModel_Erc1155(t *testing.T) {
+	tm := toModel(&entity.Transfer{
+		TransactionID: "0xhash-0",
+		IsErc1155:     true,
+		Erc1155Items:  []entity.Erc1155Item{{TokenID: 1, Amount: "10", WrappedAsset: "0.0.5"}},
+	})
+
+	assert.True(t, tm.IsErc1155)
+	assert.Equal(t, []model.Erc1155Item{{TokenId: 1, Amount: "10", WrappedAsset: "0.0.5"}}, tm.Erc1155Items)
+}
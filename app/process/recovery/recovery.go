@@ -17,37 +17,90 @@
 package recovery
 
 import (
+	"context"
+	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 )
 
 type Recovery struct {
-	feeRepository      repository.Fee
-	scheduleRepository repository.Schedule
-	mirrorClient       client.MirrorNode
-	logger             *log.Entry
+	feeRepository           repository.Fee
+	scheduleRepository      repository.Schedule
+	mirrorClient            client.MirrorNode
+	transferRepository      repository.Transfer
+	evmEventRepository      repository.EvmEvent
+	queueRepository         repository.Queue
+	pausedMessageRepository repository.PausedMessage
+	deadLetterRepository    repository.DeadLetter
+	signers                 map[uint64]service.Signer
+	contractServices        map[uint64]service.Contracts
+	topicID                 hedera.TopicID
+	bridgeAccount           hedera.AccountID
+	queue                   qi.Queue
+	validator               bool
+	config                  config.Recovery
+	logger                  *log.Entry
 }
 
 func New(
 	feeRepository repository.Fee,
 	scheduleRepository repository.Schedule,
-	mirrorClient client.MirrorNode) *Recovery {
+	mirrorClient client.MirrorNode,
+	transferRepository repository.Transfer,
+	evmEventRepository repository.EvmEvent,
+	queueRepository repository.Queue,
+	pausedMessageRepository repository.PausedMessage,
+	deadLetterRepository repository.DeadLetter,
+	signers map[uint64]service.Signer,
+	contractServices map[uint64]service.Contracts,
+	topicID string,
+	bridgeAccount string,
+	queue qi.Queue,
+	validator bool,
+	recoveryConfig config.Recovery) *Recovery {
+	parsedTopicID, err := hedera.TopicIDFromString(topicID)
+	if err != nil {
+		log.Fatalf("Could not start Recovery for topic [%s] - Error: [%s]", topicID, err)
+	}
+	parsedBridgeAccount, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Could not start Recovery for bridge account [%s] - Error: [%s]", bridgeAccount, err)
+	}
+
 	return &Recovery{
-		feeRepository:      feeRepository,
-		scheduleRepository: scheduleRepository,
-		mirrorClient:       mirrorClient,
-		logger:             config.GetLoggerFor("Recovery"),
+		feeRepository:           feeRepository,
+		scheduleRepository:      scheduleRepository,
+		mirrorClient:            mirrorClient,
+		transferRepository:      transferRepository,
+		evmEventRepository:      evmEventRepository,
+		queueRepository:         queueRepository,
+		pausedMessageRepository: pausedMessageRepository,
+		deadLetterRepository:    deadLetterRepository,
+		signers:                 signers,
+		contractServices:        contractServices,
+		topicID:                 parsedTopicID,
+		bridgeAccount:           parsedBridgeAccount,
+		queue:                   queue,
+		validator:               validator,
+		config:                  recoveryConfig,
+		logger:                  config.GetLoggerFor("Recovery"),
 	}
 }
 
-func (r Recovery) Execute() {
-	go r.checkSubmittedFees()
-	go r.checkSubmittedSchedules()
+// Execute starts the recovery in the background. Re-enqueuing stops once the given context is done
+func (r Recovery) Execute(ctx context.Context) {
+	go r.checkSubmittedFees(ctx)
+	go r.checkSubmittedSchedules(ctx)
+	if r.validator {
+		go r.recoverInitialTransfers(ctx)
+	}
 }
 
-func (r Recovery) checkSubmittedFees() {
+func (r Recovery) checkSubmittedFees(ctx context.Context) {
 	fees, err := r.feeRepository.GetAllSubmittedIds()
 	if err != nil {
 		r.logger.Errorf("Failed to get all submitted fees. Error: [%s].", err)
@@ -56,11 +109,11 @@ func (r Recovery) checkSubmittedFees() {
 
 	for _, fee := range fees {
 		onSuccess, onRevert := r.callbacks(fee.TransactionID, true)
-		r.mirrorClient.WaitForScheduledTransaction(fee.TransactionID, onSuccess, onRevert)
+		r.mirrorClient.WaitForScheduledTransaction(ctx, fee.TransactionID, onSuccess, onRevert)
 	}
 }
 
-func (r Recovery) checkSubmittedSchedules() {
+func (r Recovery) checkSubmittedSchedules(ctx context.Context) {
 	schedules, err := r.scheduleRepository.GetAllSubmittedIds()
 	if err != nil {
 		r.logger.Errorf("Failed to get all submitted fees. Error: [%s].", err)
@@ -69,7 +122,7 @@ func (r Recovery) checkSubmittedSchedules() {
 
 	for _, schedule := range schedules {
 		onSuccess, onRevert := r.callbacks(schedule.TransactionID, false)
-		r.mirrorClient.WaitForScheduledTransaction(schedule.TransactionID, onSuccess, onRevert)
+		r.mirrorClient.WaitForScheduledTransaction(ctx, schedule.TransactionID, onSuccess, onRevert)
 	}
 }
 
@@ -17,7 +17,10 @@
 package recovery
 
 import (
+	"context"
 	"errors"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
@@ -27,12 +30,32 @@ import (
 )
 
 var (
-	r Recovery
+	r                Recovery
+	signers          map[uint64]service.Signer
+	contractServices map[uint64]service.Contracts
+	topicID          = hedera.TopicID{Topic: 100}
+	bridgeAccount    = hedera.AccountID{Account: 200}
+	recoveryConfig   = config.Recovery{MaxAge: 86400}
 )
 
 func Test_New(t *testing.T) {
 	setup()
-	assert.Equal(t, &r, New(mocks.MFeeRepository, mocks.MScheduleRepository, mocks.MHederaMirrorClient))
+	assert.Equal(t, &r, New(
+		mocks.MFeeRepository,
+		mocks.MScheduleRepository,
+		mocks.MHederaMirrorClient,
+		mocks.MTransferRepository,
+		mocks.MEvmEventRepository,
+		mocks.MQueueRepository,
+		mocks.MPausedMessageRepository,
+		mocks.MDeadLetterRepository,
+		signers,
+		contractServices,
+		topicID.String(),
+		bridgeAccount.String(),
+		mocks.MQueue,
+		true,
+		recoveryConfig))
 }
 
 func Test_CheckSubmittedFees(t *testing.T) {
@@ -44,14 +67,14 @@ func Test_CheckSubmittedFees(t *testing.T) {
 		Status:        "some-status",
 	}}, nil)
 	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
-	r.checkSubmittedFees()
+	r.checkSubmittedFees(context.Background())
 	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
 }
 
 func Test_CheckSubmittedFees_GetAllSubmitedIds_Fails(t *testing.T) {
 	setup()
 	mocks.MFeeRepository.On("GetAllSubmittedIds").Return(nil, errors.New("some-error"))
-	r.checkSubmittedFees()
+	r.checkSubmittedFees(context.Background())
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything)
 }
 
@@ -64,14 +87,14 @@ func Test_CheckSubmittedSchedules(t *testing.T) {
 		Status:        "some-status",
 	}}, nil)
 	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
-	r.checkSubmittedSchedules()
+	r.checkSubmittedSchedules(context.Background())
 	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
 }
 
 func Test_CheckSubmittedSchedules_GetAllSubmitedIds_Fails(t *testing.T) {
 	setup()
 	mocks.MScheduleRepository.On("GetAllSubmittedIds").Return(nil, errors.New("some-error"))
-	r.checkSubmittedSchedules()
+	r.checkSubmittedSchedules(context.Background())
 	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything)
 }
 
@@ -133,10 +156,24 @@ func Test_CallBacks_IsNotFee_Fails(t *testing.T) {
 
 func setup() {
 	mocks.Setup()
+	signers = map[uint64]service.Signer{80001: mocks.MSignerService}
+	contractServices = map[uint64]service.Contracts{80001: mocks.MBridgeContractService}
 	r = Recovery{
-		feeRepository:      mocks.MFeeRepository,
-		scheduleRepository: mocks.MScheduleRepository,
-		mirrorClient:       mocks.MHederaMirrorClient,
-		logger:             config.GetLoggerFor("Recovery"),
+		feeRepository:           mocks.MFeeRepository,
+		scheduleRepository:      mocks.MScheduleRepository,
+		mirrorClient:            mocks.MHederaMirrorClient,
+		transferRepository:      mocks.MTransferRepository,
+		evmEventRepository:      mocks.MEvmEventRepository,
+		queueRepository:         mocks.MQueueRepository,
+		pausedMessageRepository: mocks.MPausedMessageRepository,
+		deadLetterRepository:    mocks.MDeadLetterRepository,
+		signers:                 signers,
+		contractServices:        contractServices,
+		topicID:                 topicID,
+		bridgeAccount:           bridgeAccount,
+		queue:                   mocks.MQueue,
+		validator:               true,
+		config:                  recoveryConfig,
+		logger:                  config.GetLoggerFor("Recovery"),
 	}
 }
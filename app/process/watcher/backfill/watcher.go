@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backfill
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// Watcher periodically audits the recent Hedera history for transfers and topic messages, which the watchers skipped
+type Watcher struct {
+	interval time.Duration
+	window   time.Duration
+	delay    time.Duration
+	dryRun   bool
+	backfill service.Backfill
+	logger   *log.Entry
+}
+
+// NewWatcher creates the audit watcher. Every run re-scans the given window, which ends the given delay before now,
+// so that the entries still being processed by the watchers are not reported
+func NewWatcher(interval, window, delay time.Duration, dryRun bool, backfill service.Backfill) *Watcher {
+	return &Watcher{
+		interval: interval,
+		window:   window,
+		delay:    delay,
+		dryRun:   dryRun,
+		backfill: backfill,
+		logger:   config.GetLoggerFor("Backfill Watcher"),
+	}
+}
+
+// Watch audits on every interval, until the given context is done
+func (w *Watcher) Watch(ctx context.Context, q queue.Queue) {
+	w.logger.Infof("Auditing the last [%s] every [%s].", w.window, w.interval)
+	for wait.Sleep(ctx, w.interval) {
+		w.audit(ctx, time.Now())
+	}
+}
+
+func (w *Watcher) audit(ctx context.Context, now time.Time) {
+	to := now.Add(-w.delay).UnixNano()
+	from := to - w.window.Nanoseconds()
+
+	_, err := w.backfill.Backfill(ctx, from, to, w.dryRun)
+	if err != nil {
+		w.logger.Errorf("Failed to audit. Error: [%s].", err)
+	}
+}
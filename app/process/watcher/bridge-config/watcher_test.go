@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bridge_config
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const bridgeYml = `bridge:
+  topic_id: 0.0.2001
+`
+
+func setup(t *testing.T) (string, func()) {
+	mocks.Setup()
+	dir, err := ioutil.TempDir("", "bridge-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, "bridge.yml")
+	if err := ioutil.WriteFile(path, []byte(bridgeYml), 0600); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return path, cleanup
+}
+
+func Test_Check_Unchanged(t *testing.T) {
+	path, cleanup := setup(t)
+	defer cleanup()
+	w := NewWatcher(path, time.Second, mocks.MBridgeConfigService)
+
+	w.check()
+
+	mocks.MBridgeConfigService.AssertNotCalled(t, "Apply", mock.Anything)
+}
+
+func Test_Check_Changed(t *testing.T) {
+	path, cleanup := setup(t)
+	defer cleanup()
+	w := NewWatcher(path, time.Second, mocks.MBridgeConfigService)
+	ioutil.WriteFile(path, []byte("bridge:\n  topic_id: 0.0.2002\n"), 0600)
+	mocks.MBridgeConfigService.On("Apply", parser.Bridge{TopicId: "0.0.2002"}).Return(nil)
+
+	w.check()
+	w.check()
+
+	mocks.MBridgeConfigService.AssertNumberOfCalls(t, "Apply", 1)
+}
+
+func Test_Check_Malformed(t *testing.T) {
+	path, cleanup := setup(t)
+	defer cleanup()
+	w := NewWatcher(path, time.Second, mocks.MBridgeConfigService)
+	ioutil.WriteFile(path, []byte("bridge: ["), 0600)
+
+	w.check()
+
+	mocks.MBridgeConfigService.AssertNotCalled(t, "Apply", mock.Anything)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
@@ -33,7 +34,9 @@ import (
 	helper "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	c "github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
@@ -45,13 +48,17 @@ import (
 )
 
 type Watcher struct {
-	repository repository.Status
+	repository         repository.Status
+	evmEventRepository repository.EvmEvent
+	evmBlockRepository repository.EvmBlock
+	transferRepository repository.Transfer
 	// A unique database identifier, used as a key to track the progress
 	// of the given EVM watcher. Given that addresses between different
 	// EVM networks might be the same, a concatenation between
 	// <chain-id>-<contract-address> removes possible duplication.
 	dbIdentifier      string
 	contracts         service.Contracts
+	contractServices  map[uint64]service.Contracts
 	prometheusService service.Prometheus
 	evmClient         client.EVM
 	logger            *log.Entry
@@ -72,6 +79,17 @@ const defaultMaxLogsBlocks = int64(500)
 // The default polling interval (in seconds) when querying for upcoming events/logs
 const defaultSleepDuration = 15 * time.Second
 
+// The hashes of the processed blocks are kept for the given amount of blocks,
+// so that chain reorganisations up to that depth can be rolled back
+const reorgHistoryBlocks = int64(10000)
+
+// The maximum length in bytes of the metadata of an HTS NFT
+const maxNftMetadataLength = 100
+
+// The maximum amount of processed blocks compared against the canonical chain
+// when looking for the common ancestor of a chain reorganisation
+const maxReorgCheckpoints = 256
+
 type FilterConfig struct {
 	abi               abi.ABI
 	topics            [][]common.Hash
@@ -81,13 +99,21 @@ type FilterConfig struct {
 	lockHash          common.Hash
 	unlockHash        common.Hash
 	burnERC721Hash    common.Hash
+	lockERC721Hash    common.Hash
+	unlockERC721Hash  common.Hash
+	lockERC1155Hash   common.Hash
+	unlockERC1155Hash common.Hash
 	memberUpdatedHash common.Hash
 	maxLogsBlocks     int64
 }
 
 func NewWatcher(
 	repository repository.Status,
+	evmEventRepository repository.EvmEvent,
+	evmBlockRepository repository.EvmBlock,
+	transferRepository repository.Transfer,
 	contracts service.Contracts,
+	contractServices map[uint64]service.Contracts,
 	prometheusService service.Prometheus,
 	evmClient client.EVM,
 	mappings c.Assets,
@@ -96,7 +122,7 @@ func NewWatcher(
 	validator bool,
 	pollingInterval time.Duration,
 	maxLogsBlocks int64) *Watcher {
-	currentBlock, err := evmClient.RetryBlockNumber()
+	currentBlock, err := evmClient.RetryBlockNumber(context.Background())
 	if err != nil {
 		log.Fatalf("Could not retrieve latest block. Error: [%s].", err)
 	}
@@ -113,6 +139,10 @@ func NewWatcher(
 	unlockHash := abi.Events["Unlock"].ID
 	memberUpdatedHash := abi.Events["MemberUpdated"].ID
 	burnERC721Hash := abi.Events["BurnERC721"].ID
+	lockERC721Hash := abi.Events["LockERC721"].ID
+	unlockERC721Hash := abi.Events["UnlockERC721"].ID
+	lockERC1155Hash := abi.Events["LockERC1155"].ID
+	unlockERC1155Hash := abi.Events["UnlockERC1155"].ID
 
 	topics := [][]common.Hash{
 		{
@@ -122,6 +152,10 @@ func NewWatcher(
 			unlockHash,
 			memberUpdatedHash,
 			burnERC721Hash,
+			lockERC721Hash,
+			unlockERC721Hash,
+			lockERC1155Hash,
+			unlockERC1155Hash,
 		},
 	}
 
@@ -142,6 +176,10 @@ func NewWatcher(
 		lockHash:          lockHash,
 		unlockHash:        unlockHash,
 		burnERC721Hash:    burnERC721Hash,
+		lockERC721Hash:    lockERC721Hash,
+		unlockERC721Hash:  unlockERC721Hash,
+		lockERC1155Hash:   lockERC1155Hash,
+		unlockERC1155Hash: unlockERC1155Hash,
 		memberUpdatedHash: memberUpdatedHash,
 		maxLogsBlocks:     maxLogsBlocks,
 	}
@@ -174,54 +212,73 @@ func NewWatcher(
 		log.Tracef("[%s] - Updated Transfer Watcher timestamp to [%s]", dbIdentifier, timestamp.ToHumanReadable(startBlock))
 	}
 	return &Watcher{
-		repository:        repository,
-		dbIdentifier:      dbIdentifier,
-		contracts:         contracts,
-		prometheusService: prometheusService,
-		evmClient:         evmClient,
-		logger:            c.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          mappings,
-		targetBlock:       targetBlock,
-		validator:         validator,
-		sleepDuration:     pollingInterval,
-		filterConfig:      filterConfig,
+		repository:         repository,
+		evmEventRepository: evmEventRepository,
+		evmBlockRepository: evmBlockRepository,
+		transferRepository: transferRepository,
+		dbIdentifier:       dbIdentifier,
+		contracts:          contracts,
+		contractServices:   contractServices,
+		prometheusService:  prometheusService,
+		evmClient:          evmClient,
+		logger:             c.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           mappings,
+		targetBlock:        targetBlock,
+		validator:          validator,
+		sleepDuration:      pollingInterval,
+		filterConfig:       filterConfig,
 	}
 }
 
-func (ew *Watcher) Watch(queue qi.Queue) {
-	go ew.beginWatching(queue)
-
+// Watch processes the logs of the contract until the given context is done
+func (ew *Watcher) Watch(ctx context.Context, queue qi.Queue) {
 	ew.logger.Infof("Listening for events at contract [%s]", ew.dbIdentifier)
+
+	ew.beginWatching(ctx, queue)
+
+	ew.logger.Infof("Stopped listening for events at contract [%s]", ew.dbIdentifier)
 }
 
-func (ew Watcher) beginWatching(queue qi.Queue) {
+func (ew Watcher) beginWatching(ctx context.Context, queue qi.Queue) {
 	fromBlock, err := ew.repository.Get(ew.dbIdentifier)
-	if err != nil {
+	for err != nil {
 		ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
-		time.Sleep(ew.sleepDuration)
-		ew.beginWatching(queue)
-		return
+		if !wait.Sleep(ctx, ew.sleepDuration) {
+			return
+		}
+		fromBlock, err = ew.repository.Get(ew.dbIdentifier)
 	}
 
 	ew.logger.Infof("Processing events from [%d]", fromBlock)
 
-	for {
+	for ctx.Err() == nil {
 		fromBlock, err := ew.repository.Get(ew.dbIdentifier)
 		if err != nil {
 			ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
+			wait.Sleep(ctx, ew.sleepDuration)
 			continue
 		}
 
-		currentBlock, err := ew.evmClient.RetryBlockNumber()
+		currentBlock, err := ew.evmClient.RetryBlockNumber(ctx)
 		if err != nil {
 			ew.logger.Errorf("Failed to retrieve latest block number. Error [%s]", err)
-			time.Sleep(ew.sleepDuration)
+			wait.Sleep(ctx, ew.sleepDuration)
+			continue
+		}
+
+		reorganised, err := ew.handleReorganisation(ctx)
+		if err != nil {
+			ew.logger.Errorf("Failed to check for chain reorganisation. Error [%s]", err)
+			wait.Sleep(ctx, ew.sleepDuration)
+			continue
+		}
+		if reorganised {
 			continue
 		}
 
 		toBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
 		if fromBlock > toBlock {
-			time.Sleep(ew.sleepDuration)
+			wait.Sleep(ctx, ew.sleepDuration)
 			continue
 		}
 
@@ -229,18 +286,18 @@ func (ew Watcher) beginWatching(queue qi.Queue) {
 			toBlock = fromBlock + ew.filterConfig.maxLogsBlocks
 		}
 
-		err = ew.processLogs(fromBlock, toBlock, queue)
+		err = ew.processLogs(ctx, fromBlock, toBlock, queue)
 		if err != nil {
 			ew.logger.Errorf("Failed to process logs. Error: [%s].", err)
-			time.Sleep(ew.sleepDuration)
+			wait.Sleep(ctx, ew.sleepDuration)
 			continue
 		}
 
-		time.Sleep(ew.sleepDuration)
+		wait.Sleep(ctx, ew.sleepDuration)
 	}
 }
 
-func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
+func (ew Watcher) processLogs(ctx context.Context, fromBlock, endBlock int64, q qi.Queue) error {
 	query := ethereum.FilterQuery{
 		FromBlock: new(big.Int).SetInt64(fromBlock),
 		ToBlock:   new(big.Int).SetInt64(endBlock),
@@ -248,12 +305,27 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 		Topics:    ew.filterConfig.topics,
 	}
 
-	logs, err := ew.evmClient.RetryFilterLogs(query)
+	// The header is retrieved before the logs, so that a reorganisation
+	// of the last block while filtering is detected
+	header, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(endBlock))
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve header of block [%d]. Error: [%s]", endBlock, err)
+		return err
+	}
+
+	logs, err := ew.evmClient.RetryFilterLogs(ctx, query)
 	if err != nil {
 		ew.logger.Errorf("Failed to filter logs. Error: [%s]", err)
 		return err
 	}
 
+	for _, log := range logs {
+		if log.BlockNumber == uint64(endBlock) && log.BlockHash != header.Hash() {
+			return errors.New(fmt.Sprintf("block [%d] was reorganised while filtering logs", endBlock))
+		}
+	}
+
+	batch := &queue.Batch{}
 	for _, log := range logs {
 		if len(log.Topics) > 0 {
 			if log.Topics[0] == ew.filterConfig.lockHash {
@@ -262,28 +334,28 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 					ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", lock.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleLockLog(lock, queue)
+				ew.handleLockLog(ctx, lock, batch)
 			} else if log.Topics[0] == ew.filterConfig.unlockHash {
 				unlock, err := ew.contracts.ParseUnlockLog(log)
 				if err != nil {
 					ew.logger.Errorf("Could not parse unlock log [%s]. Error [%s].", unlock.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleUnlockLog(unlock)
+				ew.handleUnlockLog(ctx, unlock)
 			} else if log.Topics[0] == ew.filterConfig.mintHash {
 				mint, err := ew.contracts.ParseMintLog(log)
 				if err != nil {
 					ew.logger.Errorf("Could not parse mint log [%s]. Error [%s].", mint.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleMintLog(mint)
+				ew.handleMintLog(ctx, mint)
 			} else if log.Topics[0] == ew.filterConfig.burnHash {
 				burn, err := ew.contracts.ParseBurnLog(log)
 				if err != nil {
 					ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", burn.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleBurnLog(burn, queue)
+				ew.handleBurnLog(ctx, burn, batch)
 			} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
 				go ew.contracts.ReloadMembers()
 			} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
@@ -292,7 +364,35 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 					ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", event.Raw.TxHash.String(), err)
 					continue
 				}
-				ew.handleBurnERC721(event, queue)
+				ew.handleBurnERC721(ctx, event, batch)
+			} else if log.Topics[0] == ew.filterConfig.lockERC721Hash {
+				event, err := ew.contracts.ParseLockERC721Log(log)
+				if err != nil {
+					ew.logger.Errorf("Could not parse lock ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+					continue
+				}
+				ew.handleLockERC721(ctx, event, batch)
+			} else if log.Topics[0] == ew.filterConfig.unlockERC721Hash {
+				event, err := ew.contracts.ParseUnlockERC721Log(log)
+				if err != nil {
+					ew.logger.Errorf("Could not parse unlock ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+					continue
+				}
+				ew.handleUnlockERC721(ctx, event)
+			} else if log.Topics[0] == ew.filterConfig.lockERC1155Hash {
+				event, err := ew.contracts.ParseLockERC1155Log(log)
+				if err != nil {
+					ew.logger.Errorf("Could not parse lock ERC-1155 log [%s]. Error [%s].", log.TxHash.String(), err)
+					continue
+				}
+				ew.handleLockERC1155(ctx, event, batch)
+			} else if log.Topics[0] == ew.filterConfig.unlockERC1155Hash {
+				event, err := ew.contracts.ParseUnlockERC1155Log(log)
+				if err != nil {
+					ew.logger.Errorf("Could not parse unlock ERC-1155 log [%s]. Error [%s].", log.TxHash.String(), err)
+					continue
+				}
+				ew.handleUnlockERC1155(ctx, event)
 			}
 		}
 	}
@@ -302,16 +402,162 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 	// so that processing of duplicate events does not occur
 	blockToBeUpdated := endBlock + 1
 
-	err = ew.repository.Update(ew.dbIdentifier, blockToBeUpdated)
+	err = q.Commit(ctx, ew.dbIdentifier, blockToBeUpdated, batch.Messages()...)
 	if err != nil {
 		ew.logger.Errorf("Failed to update latest processed block [%d]. Error: [%s]", blockToBeUpdated, err)
 		return err
 	}
 
+	ew.recordBlock(endBlock, header.Hash())
+
 	return nil
 }
 
-func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
+// recordBlock persists the hash of the last processed block, so that a reorganisation of the chain can be detected
+func (ew Watcher) recordBlock(number int64, hash common.Hash) {
+	err := ew.evmBlockRepository.Create(&entity.EvmBlock{
+		EntityID: ew.dbIdentifier,
+		Number:   number,
+		Hash:     hash.String(),
+	})
+	if err != nil {
+		ew.logger.Errorf("Failed to persist hash of block [%d]. Error: [%s]", number, err)
+		return
+	}
+
+	err = ew.evmBlockRepository.DeleteBefore(ew.dbIdentifier, number-reorgHistoryBlocks)
+	if err != nil {
+		ew.logger.Errorf("Failed to prune hashes of blocks before [%d]. Error: [%s]", number-reorgHistoryBlocks, err)
+	}
+}
+
+// handleReorganisation compares the hash of the last processed block with the parent hash of its successor.
+// On mismatch, the events from the orphaned blocks are re-evaluated and the processing is rolled back
+// to the common ancestor of both chains. Returns whether a reorganisation was handled
+func (ew Watcher) handleReorganisation(ctx context.Context) (bool, error) {
+	checkpoints, err := ew.evmBlockRepository.GetLatest(ew.dbIdentifier, maxReorgCheckpoints)
+	if err != nil {
+		return false, err
+	}
+	if len(checkpoints) == 0 {
+		return false, nil
+	}
+
+	latest := checkpoints[0]
+	successor, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(latest.Number+1))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if successor.ParentHash.String() == latest.Hash {
+		return false, nil
+	}
+
+	ew.logger.Warnf("Chain reorganisation detected. Processed block [%d] has hash [%s], but the parent hash of its successor is [%s].",
+		latest.Number, latest.Hash, successor.ParentHash)
+
+	ancestor, err := ew.findCommonAncestor(ctx, checkpoints[1:])
+	if err != nil {
+		return false, err
+	}
+
+	err = ew.reevaluateEvents(ctx, ancestor+1)
+	if err != nil {
+		return false, err
+	}
+
+	err = ew.repository.Update(ew.dbIdentifier, ancestor+1)
+	if err != nil {
+		return false, err
+	}
+
+	err = ew.evmBlockRepository.DeleteAfter(ew.dbIdentifier, ancestor)
+	if err != nil {
+		return false, err
+	}
+
+	ew.logger.Infof("Rolled back processing to block [%d].", ancestor+1)
+	return true, nil
+}
+
+// findCommonAncestor returns the newest of the given processed blocks, which is still part of the canonical chain
+func (ew Watcher) findCommonAncestor(ctx context.Context, checkpoints []*entity.EvmBlock) (int64, error) {
+	for _, checkpoint := range checkpoints {
+		header, err := ew.evmClient.HeaderByNumber(ctx, big.NewInt(checkpoint.Number))
+		if err != nil {
+			return 0, err
+		}
+
+		if header.Hash().String() == checkpoint.Hash {
+			return checkpoint.Number, nil
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("chain reorganisation is deeper than the last [%d] processed ranges, start block must be configured manually", len(checkpoints)+1))
+}
+
+// reevaluateEvents checks whether the events observed from the given block onwards are still part of the canonical chain.
+// Orphaned events are marked as such and the transfers originating from them are revoked
+func (ew Watcher) reevaluateEvents(ctx context.Context, fromBlock int64) error {
+	chain, err := ew.evmClient.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	events, err := ew.evmEventRepository.GetFromBlock(chain.Uint64(), uint64(fromBlock))
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		canonical, err := ew.isCanonical(ctx, event)
+		if err != nil {
+			return err
+		}
+		if canonical {
+			continue
+		}
+
+		ew.logger.Warnf("[%s] - [%s] event [%s-%d] was orphaned.", event.TransferID, event.Name, event.TransactionHash, event.LogIndex)
+		err = ew.evmEventRepository.MarkOrphaned(event.TransactionHash, event.LogIndex)
+		if err != nil {
+			return err
+		}
+
+		if event.IsSource() {
+			err = ew.transferRepository.UpdateStatusRevoked(event.TransferID)
+			if err != nil {
+				return err
+			}
+			ew.logger.Warnf("[%s] - Revoked transfer originating from orphaned [%s] event.", event.TransferID, event.Name)
+		}
+	}
+
+	return nil
+}
+
+// isCanonical returns whether the transaction of the event is still included in the canonical chain and emits the event
+func (ew Watcher) isCanonical(ctx context.Context, event *entity.EvmEvent) (bool, error) {
+	receipt, err := ew.evmClient.TransactionReceipt(ctx, common.HexToHash(event.TransactionHash))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Index == event.LogIndex && log.Address == ew.contracts.Address() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (ew *Watcher) handleMintLog(ctx context.Context, eventLog *router.RouterMint) {
 	ew.logger.Infof("[%s] - New Mint Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -320,7 +566,7 @@ func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
 	}
 
 	var chain *big.Int
-	chain, e := ew.evmClient.ChainID(context.Background())
+	chain, e := ew.evmClient.ChainID(ctx)
 	if e != nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
 		return
@@ -330,10 +576,12 @@ func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
 	targetChainId := chain.Uint64()
 	oppositeToken := ew.mappings.GetOppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(eventLog.Raw.BlockNumber))
+	ew.recordEvmEvent(entity.EvmEventMint, transactionId, targetChainId, eventLog.Raw, blockTimestamp)
 	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
 }
 
-func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
+func (ew *Watcher) handleBurnLog(ctx context.Context, eventLog *router.RouterBurn, q qi.Pusher) {
 	ew.logger.Debugf("[%s] - New Burn Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -347,7 +595,7 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 	}
 
 	var chain *big.Int
-	chain, e := ew.evmClient.ChainID(context.Background())
+	chain, e := ew.evmClient.ChainID(ctx)
 	if e != nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
 		return
@@ -364,24 +612,27 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
 	token := eventLog.Token.String()
 
+	targetAsset := nativeAsset.Asset
+	// This is the case when you are bridging wrapped to wrapped
+	if targetChainId != nativeAsset.ChainId {
+		targetAsset = ew.mappings.NativeToWrapped(nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+		if targetAsset == "" {
+			ew.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s] - [%d] for [%d].", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+			return
+		}
+	}
+
 	if ew.prometheusService.GetIsMonitoringEnabled() {
 		if targetChainId != constants.HederaNetworkId {
 			metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
-		} else {
+		} else if nativeAsset.ChainId == constants.HederaNetworkId {
 			metrics.CreateFeeTransferredIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
 		}
 
 		metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
 	}
 
-	// This is the case when you are bridging wrapped to wrapped
-	if targetChainId != nativeAsset.ChainId {
-		ew.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, eventLog.TargetChain.Int64())
-		return
-	}
-
 	recipientAccount := ""
-	var err error
 	if targetChainId == constants.HederaNetworkId {
 		recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
 		if err != nil {
@@ -394,7 +645,8 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 	}
 
 	properAmount := eventLog.Amount
-	if targetChainId == constants.HederaNetworkId {
+	var err error
+	if nativeAsset.ChainId == constants.HederaNetworkId {
 		properAmount, err = ew.contracts.RemoveDecimals(properAmount, token)
 		if err != nil {
 			ew.logger.Errorf("[%s] - Failed to adjust [%s] amount [%s] decimals between chains.", eventLog.Raw.TxHash, eventLog.Token, properAmount)
@@ -410,13 +662,25 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		return
 	}
 
+	if targetChainId != nativeAsset.ChainId {
+		properAmount, err = ew.wrappedTargetAmount(properAmount, token, nativeAsset.ChainId, targetChainId, targetAsset)
+		if err != nil {
+			ew.logger.Errorf("[%s] - Failed to adjust [%s] amount [%s] decimals between chains. Error: [%s]", eventLog.Raw.TxHash, eventLog.Token, eventLog.Amount, err)
+			return
+		}
+		if properAmount.Cmp(big.NewInt(0)) == 0 {
+			ew.logger.Errorf("[%s] - Insufficient amount provided: Event Amount [%s] and Proper Amount [%s].", eventLog.Raw.TxHash, eventLog.Amount, properAmount)
+			return
+		}
+	}
+
 	burnEvent := &transfer.Transfer{
 		TransactionId: transactionId,
 		SourceChainId: sourceChainId,
 		TargetChainId: targetChainId,
 		NativeChainId: nativeAsset.ChainId,
 		SourceAsset:   token,
-		TargetAsset:   nativeAsset.Asset,
+		TargetAsset:   targetAsset,
 		NativeAsset:   nativeAsset.Asset,
 		Receiver:      recipientAccount,
 		Amount:        properAmount.String(),
@@ -428,26 +692,60 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		recipientAccount)
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+	ew.recordEvmEvent(entity.EvmEventBurn, transactionId, sourceChainId, eventLog.Raw, blockTimestamp)
 
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
-		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.HederaFeeTransfer})
-		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.TopicMessageSubmission})
-		}
+		q.Push(&queue.Message{Payload: burnEvent, Topic: burnTopic(burnEvent)})
 	} else {
-		blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
-
 		burnEvent.Timestamp = strconv.FormatUint(blockTimestamp, 10)
-		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyHederaTransfer})
-		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyTransferSave})
+		q.Push(&queue.Message{Payload: burnEvent, Topic: readOnlyBurnTopic(burnEvent)})
+	}
+}
+
+// wrappedTargetAmount converts the amount of a wrapped to wrapped transfer from the units of the native asset
+// to the units of the target asset. Amounts between EVM chains do not differ in decimals.
+func (ew *Watcher) wrappedTargetAmount(amount *big.Int, token string, nativeChainId, targetChainId uint64, targetAsset string) (*big.Int, error) {
+	if nativeChainId == constants.HederaNetworkId {
+		return ew.contractServices[targetChainId].AddDecimals(amount, targetAsset)
+	}
+	if targetChainId == constants.HederaNetworkId {
+		return ew.contracts.RemoveDecimals(amount, token)
+	}
+	return amount, nil
+}
+
+// burnTopic returns the handler topic of a burn event, processed by a validator
+func burnTopic(burnEvent *transfer.Transfer) string {
+	if burnEvent.TargetChainId == constants.HederaNetworkId {
+		if burnEvent.NativeChainId == constants.HederaNetworkId {
+			return constants.HederaFeeTransfer
+		}
+		return constants.HederaMintHtsTransfer
+	}
+
+	if burnEvent.NativeChainId == constants.HederaNetworkId {
+		return constants.WrappedFeeMessageSubmission
+	}
+	return constants.TopicMessageSubmission
+}
+
+// readOnlyBurnTopic returns the handler topic of a burn event, processed by a read-only node
+func readOnlyBurnTopic(burnEvent *transfer.Transfer) string {
+	if burnEvent.TargetChainId == constants.HederaNetworkId {
+		if burnEvent.NativeChainId == constants.HederaNetworkId {
+			return constants.ReadOnlyHederaTransfer
 		}
+		return constants.ReadOnlyHederaMintHtsTransfer
 	}
+
+	if burnEvent.NativeChainId == constants.HederaNetworkId {
+		return constants.ReadOnlyWrappedFeeTransfer
+	}
+	return constants.ReadOnlyTransferSave
 }
 
-func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
+func (ew *Watcher) handleLockLog(ctx context.Context, eventLog *router.RouterLock, q qi.Pusher) {
 	ew.logger.Debugf("[%s] - New Lock Event Log received.", eventLog.Raw.TxHash)
 
 	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
@@ -464,7 +762,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		return
 	}
 	var chain *big.Int
-	chain, e := ew.evmClient.ChainID(context.Background())
+	chain, e := ew.evmClient.ChainID(ctx)
 	sourceChainId := chain.Uint64()
 	if e != nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
@@ -533,6 +831,8 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		eventLog.TargetChain.Int64())
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+	ew.recordEvmEvent(entity.EvmEventLock, transactionId, sourceChainId, eventLog.Raw, blockTimestamp)
 
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
 		if tr.TargetChainId == constants.HederaNetworkId {
@@ -541,8 +841,6 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 			q.Push(&queue.Message{Payload: tr, Topic: constants.TopicMessageSubmission})
 		}
 	} else {
-		blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
-
 		tr.Timestamp = strconv.FormatUint(blockTimestamp, 10)
 		if tr.TargetChainId == constants.HederaNetworkId {
 			q.Push(&queue.Message{Payload: tr, Topic: constants.ReadOnlyHederaMintHtsTransfer})
@@ -552,7 +850,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 	}
 }
 
-func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queue) {
+func (ew *Watcher) handleBurnERC721(ctx context.Context, eventLog *router.RouterBurnERC721, q qi.Pusher) {
 	ew.logger.Debugf("[%s] - New Burn ERC-721 Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -566,7 +864,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 	}
 
 	var chain *big.Int
-	chain, e := ew.evmClient.ChainID(context.Background())
+	chain, e := ew.evmClient.ChainID(ctx)
 	if e != nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
 		return
@@ -580,7 +878,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 	targetAsset := nativeAsset.Asset
 	// This is the case when you are bridging wrapped to wrapped
 	if eventLog.TargetChain.Uint64() != nativeAsset.ChainId {
-		ew.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, eventLog.TargetChain.Int64())
+		ew.logger.Errorf("[%s] - Wrapped to Wrapped NFT transfers are not supported [%s] - [%d] for [%d]", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, eventLog.TargetChain.Int64())
 		return
 	}
 
@@ -615,6 +913,8 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 		recipientAccount)
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+	ew.recordEvmEvent(entity.EvmEventBurnERC721, transfer.TransactionId, transfer.SourceChainId, eventLog.Raw, blockTimestamp)
 
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
 		if transfer.TargetChainId == 0 {
@@ -624,8 +924,6 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 			return
 		}
 	} else {
-		blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
-
 		transfer.Timestamp = strconv.FormatUint(blockTimestamp, 10)
 		if transfer.TargetChainId == 0 {
 			q.Push(&queue.Message{Payload: transfer, Topic: constants.ReadOnlyHederaUnlockNftTransfer})
@@ -636,7 +934,211 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 	}
 }
 
-func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
+func (ew *Watcher) handleLockERC721(ctx context.Context, eventLog *router.RouterLockERC721, q qi.Pusher) {
+	ew.logger.Debugf("[%s] - New Lock ERC-721 Event Log received.", eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	if len(eventLog.Receiver) == 0 {
+		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+		return
+	}
+
+	if eventLog.TargetChain.Uint64() != constants.HederaNetworkId {
+		ew.logger.Errorf("[%s] - NFT Transfer to TargetChain different than [%d]. Not supported.", eventLog.Raw.TxHash, constants.HederaNetworkId)
+		return
+	}
+
+	chain, e := ew.evmClient.ChainID(ctx)
+	if e != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
+		return
+	}
+	sourceChainId := chain.Uint64()
+	token := eventLog.NativeToken.String()
+
+	wrappedAsset := ew.mappings.NativeToWrapped(token, sourceChainId, constants.HederaNetworkId)
+	if wrappedAsset == "" {
+		ew.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s].", eventLog.Raw.TxHash, token)
+		return
+	}
+
+	recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to parse account from bytes [%v]. Error: [%s].", eventLog.Raw.TxHash, eventLog.Receiver, err)
+		return
+	}
+
+	metadata, err := ew.contracts.TokenURI(token, eventLog.TokenId)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve the metadata of [%s] - [%s]. Error: [%s].", eventLog.Raw.TxHash, token, eventLog.TokenId, err)
+		return
+	}
+	if len(metadata) > maxNftMetadataLength {
+		ew.logger.Errorf("[%s] - Metadata of [%s] - [%s] exceeds [%d] bytes.", eventLog.Raw.TxHash, token, eventLog.TokenId, maxNftMetadataLength)
+		return
+	}
+
+	transfer := transfer.NewNft(
+		fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index),
+		sourceChainId,
+		constants.HederaNetworkId,
+		sourceChainId,
+		recipient.String(),
+		token,
+		wrappedAsset,
+		token,
+		eventLog.TokenId.Int64(),
+		metadata)
+
+	ew.logger.Infof("[%s] - New Lock ERC-721 Event Log with TokenId [%d], Receiver Account [%s] has been found.",
+		eventLog.Raw.TxHash.String(),
+		eventLog.TokenId.Int64(),
+		transfer.Receiver)
+
+	currentBlockNumber := eventLog.Raw.BlockNumber
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+	ew.recordEvmEvent(entity.EvmEventLockERC721, transfer.TransactionId, sourceChainId, eventLog.Raw, blockTimestamp)
+
+	if ew.validator && currentBlockNumber >= ew.targetBlock {
+		q.Push(&queue.Message{Payload: transfer, Topic: constants.HederaMintNftTransfer})
+	} else {
+		transfer.Timestamp = strconv.FormatUint(blockTimestamp, 10)
+		q.Push(&queue.Message{Payload: transfer, Topic: constants.ReadOnlyHederaMintNftTransfer})
+	}
+}
+
+func (ew *Watcher) handleUnlockERC721(ctx context.Context, eventLog *router.RouterUnlockERC721) {
+	ew.logger.Debugf("[%s] - New Unlock ERC-721 Event Log received.", eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	chain, e := ew.evmClient.ChainID(ctx)
+	if e != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
+		return
+	}
+
+	transactionId := string(eventLog.TransactionId)
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(eventLog.Raw.BlockNumber))
+	ew.recordEvmEvent(entity.EvmEventUnlockERC721, transactionId, chain.Uint64(), eventLog.Raw, blockTimestamp)
+}
+
+func (ew *Watcher) handleLockERC1155(ctx context.Context, eventLog *router.RouterLockERC1155, q qi.Pusher) {
+	ew.logger.Debugf("[%s] - New Lock ERC-1155 Event Log received.", eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	if len(eventLog.Receiver) == 0 {
+		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+		return
+	}
+
+	if eventLog.TargetChain.Uint64() != constants.HederaNetworkId {
+		ew.logger.Errorf("[%s] - ERC-1155 Transfer to TargetChain different than [%d]. Not supported.", eventLog.Raw.TxHash, constants.HederaNetworkId)
+		return
+	}
+
+	if len(eventLog.TokenIds) == 0 || len(eventLog.TokenIds) != len(eventLog.Amounts) {
+		ew.logger.Errorf("[%s] - Invalid batch of [%d] token IDs and [%d] amounts.", eventLog.Raw.TxHash, len(eventLog.TokenIds), len(eventLog.Amounts))
+		return
+	}
+
+	chain, e := ew.evmClient.ChainID(ctx)
+	if e != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
+		return
+	}
+	sourceChainId := chain.Uint64()
+	token := eventLog.NativeToken.String()
+
+	items := make([]transfer.Erc1155Item, len(eventLog.TokenIds))
+	seen := make(map[int64]bool)
+	for i, tokenId := range eventLog.TokenIds {
+		amount := eventLog.Amounts[i]
+		if !tokenId.IsInt64() || !amount.IsInt64() || amount.Sign() <= 0 {
+			ew.logger.Errorf("[%s] - Invalid token ID [%s] or amount [%s].", eventLog.Raw.TxHash, tokenId, amount)
+			return
+		}
+		if seen[tokenId.Int64()] {
+			ew.logger.Errorf("[%s] - Duplicate token ID [%s] in the batch.", eventLog.Raw.TxHash, tokenId)
+			return
+		}
+		seen[tokenId.Int64()] = true
+
+		wrappedAsset := ew.mappings.Erc1155Wrapped(sourceChainId, token, tokenId.Int64())
+		if wrappedAsset == "" {
+			ew.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s] - [%s].", eventLog.Raw.TxHash, token, tokenId)
+			return
+		}
+		items[i] = transfer.Erc1155Item{
+			TokenId:      tokenId.Int64(),
+			Amount:       amount.String(),
+			WrappedAsset: wrappedAsset,
+		}
+	}
+
+	recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to parse account from bytes [%v]. Error: [%s].", eventLog.Raw.TxHash, eventLog.Receiver, err)
+		return
+	}
+
+	transfer := transfer.NewErc1155(
+		fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index),
+		sourceChainId,
+		constants.HederaNetworkId,
+		sourceChainId,
+		recipient.String(),
+		token,
+		items)
+
+	ew.logger.Infof("[%s] - New Lock ERC-1155 Event Log with [%d] token IDs, Receiver Account [%s] has been found.",
+		eventLog.Raw.TxHash.String(),
+		len(items),
+		transfer.Receiver)
+
+	currentBlockNumber := eventLog.Raw.BlockNumber
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+	ew.recordEvmEvent(entity.EvmEventLockERC1155, transfer.TransactionId, sourceChainId, eventLog.Raw, blockTimestamp)
+
+	if ew.validator && currentBlockNumber >= ew.targetBlock {
+		q.Push(&queue.Message{Payload: transfer, Topic: constants.HederaMintErc1155Transfer})
+	} else {
+		transfer.Timestamp = strconv.FormatUint(blockTimestamp, 10)
+		q.Push(&queue.Message{Payload: transfer, Topic: constants.ReadOnlyHederaMintErc1155})
+	}
+}
+
+func (ew *Watcher) handleUnlockERC1155(ctx context.Context, eventLog *router.RouterUnlockERC1155) {
+	ew.logger.Debugf("[%s] - New Unlock ERC-1155 Event Log received.", eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	chain, e := ew.evmClient.ChainID(ctx)
+	if e != nil {
+		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
+		return
+	}
+
+	transactionId := string(eventLog.TransactionId)
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(eventLog.Raw.BlockNumber))
+	ew.recordEvmEvent(entity.EvmEventUnlockERC1155, transactionId, chain.Uint64(), eventLog.Raw, blockTimestamp)
+}
+
+func (ew *Watcher) handleUnlockLog(ctx context.Context, eventLog *router.RouterUnlock) {
 	ew.logger.Debugf("[%s] - New Unlock Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -644,7 +1146,7 @@ func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
 		return
 	}
 
-	chain, e := ew.evmClient.ChainID(context.Background())
+	chain, e := ew.evmClient.ChainID(ctx)
 	if e != nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve chain ID.", eventLog.Raw.TxHash)
 		return
@@ -655,5 +1157,25 @@ func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
 	targetChainId := chain.Uint64()
 	oppositeToken := ew.mappings.GetOppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
 
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(eventLog.Raw.BlockNumber))
+	ew.recordEvmEvent(entity.EvmEventUnlock, transactionId, targetChainId, eventLog.Raw, blockTimestamp)
 	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
 }
+
+// recordEvmEvent persists the observed event originating or paying out the given transfer,
+// so that it is part of the transfer timeline and can be re-evaluated on chain reorganisations
+func (ew *Watcher) recordEvmEvent(name, transferID string, chainID uint64, raw types.Log, blockTimestamp uint64) {
+	err := ew.evmEventRepository.Create(&entity.EvmEvent{
+		TransactionHash: raw.TxHash.String(),
+		LogIndex:        raw.Index,
+		TransferID:      transferID,
+		Name:            name,
+		ChainID:         chainID,
+		BlockNumber:     raw.BlockNumber,
+		BlockHash:       raw.BlockHash.String(),
+		Timestamp:       int64(blockTimestamp) * int64(time.Second),
+	})
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to persist [%s] event [%s]. Error: [%s]", transferID, name, raw.TxHash, err)
+	}
+}
@@ -27,8 +27,11 @@ import (
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
@@ -37,6 +40,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -48,6 +52,21 @@ var (
 		Amount:      big.NewInt(1),
 		ServiceFee:  big.NewInt(0),
 	}
+	lockERC721Log = &router.RouterLockERC721{
+		TargetChain: big.NewInt(0),
+		NativeToken: common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		TokenId:     big.NewInt(7),
+		Receiver:    hederaAcc.ToBytes(),
+		Fee:         big.NewInt(0),
+	}
+	lockERC1155Log = &router.RouterLockERC1155{
+		TargetChain: big.NewInt(0),
+		NativeToken: common.HexToAddress("0x0000000000000000000000000000000000000003"),
+		TokenIds:    []*big.Int{big.NewInt(1), big.NewInt(2)},
+		Amounts:     []*big.Int{big.NewInt(10), big.NewInt(20)},
+		Receiver:    hederaAcc.ToBytes(),
+		Fee:         big.NewInt(0),
+	}
 	burnLog = &router.RouterBurn{
 		TargetChain: big.NewInt(0),
 		Token:       common.HexToAddress("0x0000000000000000000000000000000000000001"),
@@ -55,16 +74,21 @@ var (
 		Amount:      big.NewInt(1),
 	}
 
-	hederaAcc, _   = hedera.AccountIDFromString("0.0.123456")
-	hederaBytes    = hederaAcc.ToBytes()
-	dbIdentifier   = "3-0x0000000000000000000000000000000000000001"
-	mintHash       = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
-	burnHash       = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
-	lockHash       = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
-	unlockHash     = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
-	membersHash    = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
-	burnERC721Hash = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
-	topics         = [][]common.Hash{
+	header            = &types.Header{Number: big.NewInt(0)}
+	hederaAcc, _      = hedera.AccountIDFromString("0.0.123456")
+	hederaBytes       = hederaAcc.ToBytes()
+	dbIdentifier      = "3-0x0000000000000000000000000000000000000001"
+	mintHash          = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
+	burnHash          = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
+	lockHash          = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
+	unlockHash        = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
+	membersHash       = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
+	burnERC721Hash    = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
+	lockERC721Hash    = common.HexToHash("7e80b4733394001f8b71df519a4e67595671c84da50e34157b5bd2d3c725a068")
+	unlockERC721Hash  = common.HexToHash("17b46aeb516812acd151ad4cb2b080d0a2773efbc8699cd37f050e3274dd75b5")
+	lockERC1155Hash   = common.HexToHash("96db10b61f698e73b1aaf60ec3b2f04ae7d55273c684ec25a71a6887438eee52")
+	unlockERC1155Hash = common.HexToHash("702cec6fdf7976dc6bf2b45d384cb647e28d0214e3100eff2d38e6d5037b3ea0")
+	topics            = [][]common.Hash{
 		{
 			mintHash,
 			burnHash,
@@ -72,6 +96,39 @@ var (
 			unlockHash,
 			membersHash,
 			burnERC721Hash,
+			lockERC721Hash,
+			unlockERC721Hash,
+			lockERC1155Hash,
+			unlockERC1155Hash,
+		},
+	}
+	// Hedera native asset 0.0.1 and EVM native asset 0x...b0 (chain 2), both wrapped on chains 1 and 33
+	wrappedToWrappedNetworks = map[uint64]*parser.Network{
+		0: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					"0.0.1": {
+						MinAmount: "10",
+						Networks: map[uint64]string{
+							1:  "0x00000000000000000000000000000000000000A2",
+							33: "0x00000000000000000000000000000000000000A1",
+						},
+					},
+				},
+			},
+		},
+		2: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					"0x00000000000000000000000000000000000000B0": {
+						Networks: map[uint64]string{
+							0:  "0.0.2",
+							1:  "0x00000000000000000000000000000000000000b2",
+							33: "0x00000000000000000000000000000000000000B1",
+						},
+					},
+				},
+			},
 		},
 	}
 	filterConfig = FilterConfig{
@@ -92,20 +149,20 @@ func Test_HandleLockLog_Removed_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Raw.Removed = true
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 	lockLog.Raw.Removed = false
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleLockLog_EmptyReceiver_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Receiver = []byte{}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 	lockLog.Receiver = hederaBytes
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleLockLog_InvalidReceiver_Fails(t *testing.T) {
@@ -113,19 +170,19 @@ func Test_HandleLockLog_InvalidReceiver_Fails(t *testing.T) {
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
 
 	lockLog.Receiver = []byte{1}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 	lockLog.Receiver = hederaBytes
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleLockLog_EmptyWrappedAsset_Fails(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(2), nil)
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleLockLog_HappyPath(t *testing.T) {
@@ -147,26 +204,28 @@ func Test_HandleLockLog_HappyPath(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.HederaMintHtsTransfer}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.HederaMintHtsTransfer}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 }
 
 func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 	mocks.Setup()
 	mocks.MBridgeContractService.On("RemoveDecimals", lockLog.Amount, lockLog.Token.String()).Return(lockLog.Amount, nil)
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MEvmEventRepository.On("Create", mock.Anything).Return(nil)
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          config.LoadAssets(testConstants.Networks),
-		validator:         false,
-		prometheusService: mocks.MPrometheusService,
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		contracts:          mocks.MBridgeContractService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           config.LoadAssets(testConstants.Networks),
+		validator:          false,
+		prometheusService:  mocks.MPrometheusService,
 	}
 
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
@@ -184,26 +243,28 @@ func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 }
 
 func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.Setup()
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MEvmEventRepository.On("Create", mock.Anything).Return(nil)
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	lockLog.TargetChain = big.NewInt(1)
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          config.LoadAssets(testConstants.Networks),
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           config.LoadAssets(testConstants.Networks),
+		validator:          false,
 	}
 
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
@@ -221,9 +282,9 @@ func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyTransferSave}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyTransferSave}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
@@ -246,9 +307,9 @@ func Test_HandleLockLog_TopicMessageSubmission(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.TopicMessageSubmission}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.TopicMessageSubmission}).Return()
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MPusher)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
@@ -271,9 +332,9 @@ func Test_HandleBurnLog_HappyPath(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaFeeTransfer}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaFeeTransfer}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 }
 
 func Test_HandleBurnLog_InvalidHederaRecipient(t *testing.T) {
@@ -281,7 +342,7 @@ func Test_HandleBurnLog_InvalidHederaRecipient(t *testing.T) {
 	defaultReceiver := burnLog.Receiver
 	burnLog.Receiver = []byte{1, 2, 3, 4}
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 	burnLog.Receiver = defaultReceiver
 }
 
@@ -307,9 +368,9 @@ func Test_HandleBurnLog_TopicMessageSubmission(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 	burnLog.TargetChain = big.NewInt(0)
 	burnLog.Token = defaultToken
 }
@@ -317,18 +378,20 @@ func Test_HandleBurnLog_TopicMessageSubmission(t *testing.T) {
 func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.Setup()
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MEvmEventRepository.On("Create", mock.Anything).Return(nil)
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	burnLog.TargetChain = big.NewInt(1)
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          config.LoadAssets(testConstants.Networks),
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           config.LoadAssets(testConstants.Networks),
+		validator:          false,
 	}
 
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
@@ -351,9 +414,9 @@ func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyTransferSave}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyTransferSave}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 	burnLog.TargetChain = big.NewInt(0)
 	burnLog.Token = defaultToken
 }
@@ -362,17 +425,19 @@ func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
 	mocks.Setup()
 	mocks.MBridgeContractService.On("RemoveDecimals", burnLog.Amount, burnLog.Token.String()).Return(lockLog.Amount, nil)
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MEvmEventRepository.On("Create", mock.Anything).Return(nil)
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          config.LoadAssets(testConstants.Networks),
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           config.LoadAssets(testConstants.Networks),
+		validator:          false,
 	}
 
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
@@ -390,9 +455,9 @@ func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyHederaTransfer}).Return()
+	mocks.MPusher.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyHederaTransfer}).Return()
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 }
 
 func Test_HandleBurnLog_Token_Not_Supported(t *testing.T) {
@@ -401,32 +466,144 @@ func Test_HandleBurnLog_Token_Not_Supported(t *testing.T) {
 
 	defaultToken := burnLog.Token
 	burnLog.Token = common.HexToAddress("0x0123123")
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 	burnLog.Token = defaultToken
 }
 
-func Test_HandleBurnLog_WrappedToWrapped_Not_Supported(t *testing.T) {
+func Test_HandleBurnLog_WrappedToWrapped_MissingTargetAsset(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
 
 	defaultTargetChain := burnLog.TargetChain
 	burnLog.TargetChain = big.NewInt(1)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 	burnLog.TargetChain = defaultTargetChain
 }
 
+func Test_HandleBurnLog_WrappedToWrapped_EvmNative(t *testing.T) {
+	setup()
+	w.mappings = config.LoadAssets(wrappedToWrappedNetworks)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+
+	wrappedBurnLog := &router.RouterBurn{
+		TargetChain: big.NewInt(1),
+		Token:       common.HexToAddress("0x00000000000000000000000000000000000000b1"),
+		Receiver:    common.HexToAddress("0x0000000000000000000000000000000000000abc").Bytes(),
+		Amount:      big.NewInt(100),
+	}
+	expected := &transfer.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", wrappedBurnLog.Raw.TxHash, wrappedBurnLog.Raw.Index),
+		SourceChainId: 33,
+		TargetChainId: 1,
+		NativeChainId: 2,
+		SourceAsset:   "0x00000000000000000000000000000000000000B1",
+		TargetAsset:   "0x00000000000000000000000000000000000000b2",
+		NativeAsset:   "0x00000000000000000000000000000000000000B0",
+		Receiver:      "0x0000000000000000000000000000000000000aBc",
+		Amount:        "100",
+	}
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.TopicMessageSubmission}).Return()
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.TopicMessageSubmission})
+	mocks.MBridgeContractService.AssertNotCalled(t, "RemoveDecimals", mock.Anything, mock.Anything)
+}
+
+func Test_HandleBurnLog_WrappedToWrapped_HederaNative(t *testing.T) {
+	setup()
+	w.mappings = config.LoadAssets(wrappedToWrappedNetworks)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MBridgeContractService.On("RemoveDecimals", big.NewInt(10000000000), "0x00000000000000000000000000000000000000A1").Return(big.NewInt(100), nil)
+	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(100), "0x00000000000000000000000000000000000000A2").Return(big.NewInt(1000000), nil)
+
+	wrappedBurnLog := &router.RouterBurn{
+		TargetChain: big.NewInt(1),
+		Token:       common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+		Receiver:    common.HexToAddress("0x0000000000000000000000000000000000000abc").Bytes(),
+		Amount:      big.NewInt(10000000000),
+	}
+	expected := &transfer.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", wrappedBurnLog.Raw.TxHash, wrappedBurnLog.Raw.Index),
+		SourceChainId: 33,
+		TargetChainId: 1,
+		NativeChainId: 0,
+		SourceAsset:   "0x00000000000000000000000000000000000000A1",
+		TargetAsset:   "0x00000000000000000000000000000000000000A2",
+		NativeAsset:   "0.0.1",
+		Receiver:      "0x0000000000000000000000000000000000000aBc",
+		Amount:        "1000000",
+	}
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.WrappedFeeMessageSubmission}).Return()
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.WrappedFeeMessageSubmission})
+}
+
+func Test_HandleBurnLog_WrappedToWrapped_HederaNative_BelowMinAmount(t *testing.T) {
+	setup()
+	w.mappings = config.LoadAssets(wrappedToWrappedNetworks)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MBridgeContractService.On("RemoveDecimals", big.NewInt(100000000), "0x00000000000000000000000000000000000000A1").Return(big.NewInt(1), nil)
+
+	wrappedBurnLog := &router.RouterBurn{
+		TargetChain: big.NewInt(1),
+		Token:       common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+		Receiver:    common.HexToAddress("0x0000000000000000000000000000000000000abc").Bytes(),
+		Amount:      big.NewInt(100000000),
+	}
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MPusher)
+
+	mocks.MBridgeContractService.AssertNotCalled(t, "AddDecimals", mock.Anything, mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleBurnLog_WrappedToWrapped_HederaTarget(t *testing.T) {
+	setup()
+	w.mappings = config.LoadAssets(wrappedToWrappedNetworks)
+	w.validator = false
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MBridgeContractService.On("RemoveDecimals", big.NewInt(10000000000), "0x00000000000000000000000000000000000000B1").Return(big.NewInt(100), nil)
+
+	wrappedBurnLog := &router.RouterBurn{
+		TargetChain: big.NewInt(0),
+		Token:       common.HexToAddress("0x00000000000000000000000000000000000000b1"),
+		Receiver:    hederaAcc.ToBytes(),
+		Amount:      big.NewInt(10000000000),
+	}
+	expected := &transfer.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", wrappedBurnLog.Raw.TxHash, wrappedBurnLog.Raw.Index),
+		SourceChainId: 33,
+		TargetChainId: 0,
+		NativeChainId: 2,
+		SourceAsset:   "0x00000000000000000000000000000000000000B1",
+		TargetAsset:   "0.0.2",
+		NativeAsset:   "0x00000000000000000000000000000000000000B0",
+		Receiver:      hederaAcc.String(),
+		Amount:        "100",
+		Timestamp:     "1",
+	}
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return()
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.ReadOnlyHederaMintHtsTransfer})
+}
+
 func Test_HandleBurnLog_Raw_Removed(t *testing.T) {
 	setup()
 	burnLog.Raw.Removed = true
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 	burnLog.Raw.Removed = false
 }
 
@@ -435,18 +612,251 @@ func Test_HandleBurnLog_No_Receivers(t *testing.T) {
 	receiver := burnLog.Receiver
 	burnLog.Receiver = []byte{}
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MPusher)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 	burnLog.Receiver = receiver
 }
 
+func Test_HandleMintLog_RecordsEvent(t *testing.T) {
+	setup()
+	mintLog := &router.RouterMint{
+		SourceChain:   big.NewInt(0),
+		Token:         common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		TransactionId: []byte("0.0.1-1-1"),
+		Raw: types.Log{
+			TxHash:      common.HexToHash("0x1"),
+			Index:       2,
+			BlockNumber: 3,
+		},
+	}
+	expectedEvent := &entity.EvmEvent{
+		TransactionHash: mintLog.Raw.TxHash.String(),
+		LogIndex:        2,
+		TransferID:      "0.0.1-1-1",
+		Name:            entity.EvmEventMint,
+		ChainID:         33,
+		BlockNumber:     3,
+		BlockHash:       common.Hash{}.String(),
+		Timestamp:       int64(4 * time.Second),
+	}
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(3)).Return(uint64(4))
+	mocks.MEvmEventRepository.On("Create", expectedEvent).Return(nil)
+
+	w.handleMintLog(context.Background(), mintLog)
+
+	mocks.MEvmEventRepository.AssertCalled(t, "Create", expectedEvent)
+}
+
+func Test_HandleUnlockLog_RecordsEvent(t *testing.T) {
+	setup()
+	unlockLog := &router.RouterUnlock{
+		SourceChain:   big.NewInt(0),
+		Token:         common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		TransactionId: []byte("0.0.1-1-1"),
+		Raw: types.Log{
+			TxHash:      common.HexToHash("0x1"),
+			Index:       2,
+			BlockNumber: 3,
+		},
+	}
+	expectedEvent := &entity.EvmEvent{
+		TransactionHash: unlockLog.Raw.TxHash.String(),
+		LogIndex:        2,
+		TransferID:      "0.0.1-1-1",
+		Name:            entity.EvmEventUnlock,
+		ChainID:         33,
+		BlockNumber:     3,
+		BlockHash:       common.Hash{}.String(),
+		Timestamp:       int64(4 * time.Second),
+	}
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(3)).Return(uint64(4))
+	mocks.MEvmEventRepository.On("Create", expectedEvent).Return(errors.New("some-error"))
+
+	w.handleUnlockLog(context.Background(), unlockLog)
+
+	mocks.MEvmEventRepository.AssertCalled(t, "Create", expectedEvent)
+}
+
+func Test_HandleLockERC721_HappyPath(t *testing.T) {
+	setupLockERC721()
+	mocks.MBridgeContractService.On("TokenURI", lockERC721Log.NativeToken.String(), lockERC721Log.TokenId).Return("ipfs://metadata", nil)
+
+	expected := transfer.NewNft(
+		fmt.Sprintf("%s-%d", lockERC721Log.Raw.TxHash, lockERC721Log.Raw.Index),
+		33,
+		constants.HederaNetworkId,
+		33,
+		hederaAcc.String(),
+		lockERC721Log.NativeToken.String(),
+		"0.0.5001",
+		lockERC721Log.NativeToken.String(),
+		7,
+		"ipfs://metadata")
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.HederaMintNftTransfer}).Return()
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.HederaMintNftTransfer})
+	mocks.MEvmEventRepository.AssertCalled(t, "Create", mock.MatchedBy(func(e *entity.EvmEvent) bool {
+		return e.Name == entity.EvmEventLockERC721 && e.TransferID == expected.TransactionId
+	}))
+}
+
+func Test_HandleLockERC721_ReadOnly(t *testing.T) {
+	setupLockERC721()
+	w.validator = false
+	mocks.MBridgeContractService.On("TokenURI", lockERC721Log.NativeToken.String(), lockERC721Log.TokenId).Return("ipfs://metadata", nil)
+	mocks.MPusher.On("Push", mock.Anything).Return()
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", mock.MatchedBy(func(m *queue.Message) bool {
+		return m.Topic == constants.ReadOnlyHederaMintNftTransfer && m.Payload.(*transfer.Transfer).Timestamp == "1"
+	}))
+}
+
+func Test_HandleLockERC721_UnsupportedTargetChain(t *testing.T) {
+	setupLockERC721()
+	log := *lockERC721Log
+	log.TargetChain = big.NewInt(1)
+
+	w.handleLockERC721(context.Background(), &log, mocks.MPusher)
+
+	mocks.MBridgeContractService.AssertNotCalled(t, "TokenURI", mock.Anything, mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockERC721_MetadataTooLong(t *testing.T) {
+	setupLockERC721()
+	mocks.MBridgeContractService.On("TokenURI", lockERC721Log.NativeToken.String(), lockERC721Log.TokenId).Return(strings.Repeat("a", maxNftMetadataLength+1), nil)
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockERC721_TokenURIFails(t *testing.T) {
+	setupLockERC721()
+	mocks.MBridgeContractService.On("TokenURI", lockERC721Log.NativeToken.String(), lockERC721Log.TokenId).Return("", errors.New("some-error"))
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockERC1155_HappyPath(t *testing.T) {
+	setupLockERC1155()
+
+	expected := transfer.NewErc1155(
+		fmt.Sprintf("%s-%d", lockERC1155Log.Raw.TxHash, lockERC1155Log.Raw.Index),
+		33,
+		constants.HederaNetworkId,
+		33,
+		hederaAcc.String(),
+		lockERC1155Log.NativeToken.String(),
+		[]transfer.Erc1155Item{
+			{TokenId: 1, Amount: "10", WrappedAsset: "0.0.6001"},
+			{TokenId: 2, Amount: "20", WrappedAsset: "0.0.6002"},
+		})
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.HederaMintErc1155Transfer}).Return()
+
+	w.handleLockERC1155(context.Background(), lockERC1155Log, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.HederaMintErc1155Transfer})
+	mocks.MEvmEventRepository.AssertCalled(t, "Create", mock.MatchedBy(func(e *entity.EvmEvent) bool {
+		return e.Name == entity.EvmEventLockERC1155 && e.TransferID == expected.TransactionId
+	}))
+}
+
+func Test_HandleLockERC1155_ReadOnly(t *testing.T) {
+	setupLockERC1155()
+	w.validator = false
+	mocks.MPusher.On("Push", mock.Anything).Return()
+
+	w.handleLockERC1155(context.Background(), lockERC1155Log, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", mock.MatchedBy(func(m *queue.Message) bool {
+		return m.Topic == constants.ReadOnlyHederaMintErc1155 && m.Payload.(*transfer.Transfer).Timestamp == "1"
+	}))
+}
+
+func Test_HandleLockERC1155_InvalidBatches(t *testing.T) {
+	unmapped := *lockERC1155Log
+	unmapped.TokenIds = []*big.Int{big.NewInt(1), big.NewInt(3)}
+	mismatched := *lockERC1155Log
+	mismatched.Amounts = []*big.Int{big.NewInt(10)}
+	duplicate := *lockERC1155Log
+	duplicate.TokenIds = []*big.Int{big.NewInt(1), big.NewInt(1)}
+	zeroAmount := *lockERC1155Log
+	zeroAmount.Amounts = []*big.Int{big.NewInt(10), big.NewInt(0)}
+	otherTarget := *lockERC1155Log
+	otherTarget.TargetChain = big.NewInt(1)
+
+	for name, log := range map[string]*router.RouterLockERC1155{
+		"unmapped token ID":  &unmapped,
+		"mismatched lengths": &mismatched,
+		"duplicate token ID": &duplicate,
+		"zero amount":        &zeroAmount,
+		"other target chain": &otherTarget,
+	} {
+		t.Run(name, func(t *testing.T) {
+			setupLockERC1155()
+
+			w.handleLockERC1155(context.Background(), log, mocks.MPusher)
+
+			mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+		})
+	}
+}
+
+func Test_HandleUnlockERC721_RecordsEvent(t *testing.T) {
+	setup()
+	unlockLog := &router.RouterUnlockERC721{
+		SourceChain:   big.NewInt(0),
+		Token:         common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		TokenId:       big.NewInt(7),
+		TransactionId: []byte("0.0.1-1-1"),
+		Raw: types.Log{
+			TxHash:      common.HexToHash("0x1"),
+			Index:       2,
+			BlockNumber: 3,
+		},
+	}
+	expectedEvent := &entity.EvmEvent{
+		TransactionHash: unlockLog.Raw.TxHash.String(),
+		LogIndex:        2,
+		TransferID:      "0.0.1-1-1",
+		Name:            entity.EvmEventUnlockERC721,
+		ChainID:         33,
+		BlockNumber:     3,
+		BlockHash:       common.Hash{}.String(),
+		Timestamp:       int64(4 * time.Second),
+	}
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(3)).Return(uint64(4))
+
+	w.handleUnlockERC721(context.Background(), unlockLog)
+
+	mocks.MEvmEventRepository.AssertCalled(t, "Create", expectedEvent)
+}
+
+func Test_HandleMintLog_Removed(t *testing.T) {
+	setup()
+
+	w.handleMintLog(context.Background(), &router.RouterMint{Raw: types.Log{Removed: true}})
+
+	mocks.MEvmEventRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 func TestNewWatcher(t *testing.T) {
 	mocks.Setup()
 
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
-	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
+	mocks.MEVMClient.On("RetryBlockNumber", context.Background()).Return(uint64(10), nil)
 	mocks.MEVMClient.On("BlockConfirmations", mock.Anything).Return(uint64(5))
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
@@ -459,6 +869,10 @@ func TestNewWatcher(t *testing.T) {
 	lockHashFromAbi := abi.Events["Lock"].ID
 	unlockHashFromAbi := abi.Events["Unlock"].ID
 	burnERC721HashAbi := abi.Events["BurnERC721"].ID
+	lockERC721HashAbi := abi.Events["LockERC721"].ID
+	unlockERC721HashAbi := abi.Events["UnlockERC721"].ID
+	lockERC1155HashAbi := abi.Events["LockERC1155"].ID
+	unlockERC1155HashAbi := abi.Events["UnlockERC1155"].ID
 	memberUpdatedHash := abi.Events["MemberUpdated"].ID
 
 	addresses := []common.Address{
@@ -474,26 +888,35 @@ func TestNewWatcher(t *testing.T) {
 		lockHash:          lockHashFromAbi,
 		unlockHash:        unlockHashFromAbi,
 		burnERC721Hash:    burnERC721HashAbi,
+		lockERC721Hash:    lockERC721HashAbi,
+		unlockERC721Hash:  unlockERC721HashAbi,
+		lockERC1155Hash:   lockERC1155HashAbi,
+		unlockERC1155Hash: unlockERC1155HashAbi,
 		memberUpdatedHash: memberUpdatedHash,
 		maxLogsBlocks:     220,
 	}
 
 	assets := config.LoadAssets(testConstants.Networks)
+	contractServices := map[uint64]service.Contracts{33: mocks.MBridgeContractService}
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		dbIdentifier:      dbIdentifier,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          assets,
-		validator:         true,
-		targetBlock:       5,
-		sleepDuration:     defaultSleepDuration,
-		filterConfig:      filterCfg,
-	}
-
-	actual := NewWatcher(mocks.MStatusRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220)
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		evmBlockRepository: mocks.MEvmBlockRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		contractServices:   contractServices,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		dbIdentifier:       dbIdentifier,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           assets,
+		validator:          true,
+		targetBlock:        5,
+		sleepDuration:      defaultSleepDuration,
+		filterConfig:       filterCfg,
+	}
+
+	actual := NewWatcher(mocks.MStatusRepository, mocks.MEvmEventRepository, mocks.MEvmBlockRepository, mocks.MTransferRepository, mocks.MBridgeContractService, contractServices, mocks.MPrometheusService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220)
 	assert.Equal(t, w, actual)
 }
 
@@ -511,7 +934,8 @@ func Test_ProcessLogs_ParseBurnLogFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), query.ToBlock).Return(header, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", context.Background(), *query).
 		Return([]types.Log{
 			{
 				Topics: []common.Hash{
@@ -525,8 +949,10 @@ func Test_ProcessLogs_ParseBurnLogFails(t *testing.T) {
 			burnHash,
 		},
 	}).Return(burnLog, errors.New("some-error"))
-	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
-	w.processLogs(0, 0, mocks.MQueue)
+	mocks.MQueue.On("Commit", dbIdentifier, int64(1), []*queue.Message(nil)).Return(nil)
+	mocks.MEvmBlockRepository.On("Create", &entity.EvmBlock{EntityID: dbIdentifier, Number: 0, Hash: header.Hash().String()}).Return(nil)
+	mocks.MEvmBlockRepository.On("DeleteBefore", dbIdentifier, -reorgHistoryBlocks).Return(nil)
+	w.processLogs(context.Background(), 0, 0, mocks.MQueue)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -542,7 +968,8 @@ func Test_ProcessLogs_ParseLockLogFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), query.ToBlock).Return(header, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", context.Background(), *query).
 		Return([]types.Log{
 			{
 				Topics: []common.Hash{
@@ -556,8 +983,10 @@ func Test_ProcessLogs_ParseLockLogFails(t *testing.T) {
 			lockHash,
 		},
 	}).Return(lockLog, errors.New("some-error"))
-	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
-	w.processLogs(0, 0, mocks.MQueue)
+	mocks.MQueue.On("Commit", dbIdentifier, int64(1), []*queue.Message(nil)).Return(nil)
+	mocks.MEvmBlockRepository.On("Create", &entity.EvmBlock{EntityID: dbIdentifier, Number: 0, Hash: header.Hash().String()}).Return(nil)
+	mocks.MEvmBlockRepository.On("DeleteBefore", dbIdentifier, -reorgHistoryBlocks).Return(nil)
+	w.processLogs(context.Background(), 0, 0, mocks.MQueue)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -573,10 +1002,11 @@ func Test_ProcessLogs_FilterLogsFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), query.ToBlock).Return(header, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", context.Background(), *query).
 		Return([]types.Log{}, errors.New("some-error"))
 
-	w.processLogs(0, 5, mocks.MQueue)
+	w.processLogs(context.Background(), 0, 5, mocks.MQueue)
 }
 
 func Test_ProcessLogs_RepoUpdateFails(t *testing.T) {
@@ -593,29 +1023,176 @@ func Test_ProcessLogs_RepoUpdateFails(t *testing.T) {
 	}
 	expectedErr := errors.New("some-error")
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), query.ToBlock).Return(header, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", context.Background(), *query).
 		Return([]types.Log{}, nil)
-	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(expectedErr)
-	res := w.processLogs(0, 0, mocks.MQueue)
+	mocks.MQueue.On("Commit", dbIdentifier, int64(1), []*queue.Message(nil)).Return(expectedErr)
+	res := w.processLogs(context.Background(), 0, 0, mocks.MQueue)
 	assert.Equal(t, expectedErr, res)
 }
 
+func Test_HandleReorganisation_NoCheckpoints(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("GetLatest", dbIdentifier, maxReorgCheckpoints).Return([]*entity.EvmBlock{}, nil)
+
+	reorganised, err := w.handleReorganisation(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, reorganised)
+	mocks.MEVMClient.AssertNotCalled(t, "HeaderByNumber", mock.Anything, mock.Anything)
+}
+
+func Test_HandleReorganisation_NoReorganisation(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("GetLatest", dbIdentifier, maxReorgCheckpoints).Return([]*entity.EvmBlock{{EntityID: dbIdentifier, Number: 10, Hash: header.Hash().String()}}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(11)).Return(&types.Header{ParentHash: header.Hash()}, nil)
+
+	reorganised, err := w.handleReorganisation(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, reorganised)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_HandleReorganisation_SuccessorNotMined(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("GetLatest", dbIdentifier, maxReorgCheckpoints).Return([]*entity.EvmBlock{{EntityID: dbIdentifier, Number: 10, Hash: header.Hash().String()}}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(11)).Return(nil, ethereum.NotFound)
+
+	reorganised, err := w.handleReorganisation(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, reorganised)
+}
+
+func Test_HandleReorganisation_RollsBack(t *testing.T) {
+	setup()
+	ancestor := &types.Header{Number: big.NewInt(5)}
+	orphanedLock := &entity.EvmEvent{
+		TransferID:      "0xaa-1",
+		Name:            entity.EvmEventLock,
+		ChainID:         1,
+		TransactionHash: "0xaa",
+		LogIndex:        1,
+		BlockNumber:     8,
+	}
+	canonicalMint := &entity.EvmEvent{
+		TransferID:      "0.0.1-1-1",
+		Name:            "mint",
+		ChainID:         1,
+		TransactionHash: "0xbb",
+		LogIndex:        0,
+		BlockNumber:     9,
+	}
+	mocks.MEvmBlockRepository.On("GetLatest", dbIdentifier, maxReorgCheckpoints).Return([]*entity.EvmBlock{
+		{EntityID: dbIdentifier, Number: 10, Hash: "0x10"},
+		{EntityID: dbIdentifier, Number: 7, Hash: "0x7"},
+		{EntityID: dbIdentifier, Number: 5, Hash: ancestor.Hash().String()},
+	}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(11)).Return(&types.Header{ParentHash: common.HexToHash("0x11")}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(7)).Return(&types.Header{Number: big.NewInt(7)}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(5)).Return(ancestor, nil)
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(1), nil)
+	mocks.MEvmEventRepository.On("GetFromBlock", uint64(1), uint64(6)).Return([]*entity.EvmEvent{orphanedLock, canonicalMint}, nil)
+	mocks.MEVMClient.On("TransactionReceipt", context.Background(), common.HexToHash("0xaa")).Return(nil, ethereum.NotFound)
+	mocks.MEVMClient.On("TransactionReceipt", context.Background(), common.HexToHash("0xbb")).Return(&types.Receipt{Logs: []*types.Log{{Index: 0, Address: mocks.MBridgeContractService.Address()}}}, nil)
+	mocks.MEvmEventRepository.On("MarkOrphaned", "0xaa", uint(1)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusRevoked", "0xaa-1").Return(nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(6)).Return(nil)
+	mocks.MEvmBlockRepository.On("DeleteAfter", dbIdentifier, int64(5)).Return(nil)
+
+	reorganised, err := w.handleReorganisation(context.Background())
+
+	assert.Nil(t, err)
+	assert.True(t, reorganised)
+	mocks.MEvmEventRepository.AssertCalled(t, "MarkOrphaned", "0xaa", uint(1))
+	mocks.MEvmEventRepository.AssertNotCalled(t, "MarkOrphaned", "0xbb", uint(0))
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusRevoked", "0xaa-1")
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(6))
+	mocks.MEvmBlockRepository.AssertCalled(t, "DeleteAfter", dbIdentifier, int64(5))
+}
+
+func Test_HandleReorganisation_AncestorNotFound(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("GetLatest", dbIdentifier, maxReorgCheckpoints).Return([]*entity.EvmBlock{
+		{EntityID: dbIdentifier, Number: 10, Hash: "0x10"},
+		{EntityID: dbIdentifier, Number: 7, Hash: "0x7"},
+	}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(11)).Return(&types.Header{ParentHash: common.HexToHash("0x11")}, nil)
+	mocks.MEVMClient.On("HeaderByNumber", context.Background(), big.NewInt(7)).Return(&types.Header{Number: big.NewInt(7)}, nil)
+
+	reorganised, err := w.handleReorganisation(context.Background())
+
+	assert.NotNil(t, err)
+	assert.False(t, reorganised)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mocks.MEvmBlockRepository.AssertNotCalled(t, "DeleteAfter", mock.Anything, mock.Anything)
+}
+
+func Test_RecordBlock_CreateFails(t *testing.T) {
+	setup()
+	mocks.MEvmBlockRepository.On("Create", mock.Anything).Return(errors.New("some-error"))
+
+	w.recordBlock(20, header.Hash())
+
+	mocks.MEvmBlockRepository.AssertNotCalled(t, "DeleteBefore", mock.Anything, mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MEvmEventRepository.On("Create", mock.Anything).Return(nil)
 
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		dbIdentifier:      dbIdentifier,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		mappings:          config.LoadAssets(testConstants.Networks),
-		validator:         true,
-		sleepDuration:     defaultSleepDuration,
-		filterConfig:      filterConfig,
+		repository:         mocks.MStatusRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		evmBlockRepository: mocks.MEvmBlockRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		contractServices:   map[uint64]service.Contracts{1: mocks.MBridgeContractService},
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		dbIdentifier:       dbIdentifier,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		mappings:           config.LoadAssets(testConstants.Networks),
+		validator:          true,
+		sleepDuration:      defaultSleepDuration,
+		filterConfig:       filterConfig,
 	}
 }
+
+func setupLockERC721() {
+	setup()
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	w.mappings = config.LoadAssets(map[uint64]*parser.Network{
+		0: {Tokens: parser.Tokens{Fungible: map[string]parser.Token{}, Nft: map[string]parser.Token{}}},
+		33: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{},
+				Nft: map[string]parser.Token{
+					lockERC721Log.NativeToken.String(): {Networks: map[uint64]string{constants.HederaNetworkId: "0.0.5001"}},
+				},
+			},
+		},
+	})
+}
+
+func setupLockERC1155() {
+	setup()
+	mocks.MEVMClient.On("ChainID", context.Background()).Return(big.NewInt(33), nil)
+	w.mappings = config.LoadAssets(map[uint64]*parser.Network{
+		0: {Tokens: parser.Tokens{Fungible: map[string]parser.Token{}, Nft: map[string]parser.Token{}}},
+		33: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{},
+				Nft:      map[string]parser.Token{},
+				Erc1155: map[string]parser.Erc1155Token{
+					lockERC1155Log.NativeToken.String(): {TokenIds: map[int64]string{1: "0.0.6001", 2: "0.0.6002"}},
+				},
+			},
+		},
+	})
+}
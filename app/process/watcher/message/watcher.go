@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -25,27 +26,33 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"time"
 )
 
 type Watcher struct {
-	client           client.MirrorNode
-	topicID          hedera.TopicID
-	statusRepository repository.Status
-	pollingInterval  time.Duration
-	logger           *log.Entry
+	client            client.MirrorNode
+	topicID           hedera.TopicID
+	statusRepository  repository.Status
+	messageRepository repository.Message
+	pollingInterval   time.Duration
+	logger            *log.Entry
 }
 
 func NewWatcher(
 	client client.MirrorNode,
 	topicID string,
 	repository repository.Status,
+	messageRepository repository.Message,
 	pollingInterval time.Duration,
 	startTimestamp int64) *Watcher {
 	id, err := hedera.TopicIDFromString(topicID)
@@ -78,44 +85,54 @@ func NewWatcher(
 	}
 
 	return &Watcher{
-		client:           client,
-		topicID:          id,
-		statusRepository: repository,
-		pollingInterval:  pollingInterval,
-		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
+		client:            client,
+		topicID:           id,
+		statusRepository:  repository,
+		messageRepository: messageRepository,
+		pollingInterval:   pollingInterval,
+		logger:            config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
 
-func (cmw Watcher) Watch(q qi.Queue) {
-	if !cmw.client.TopicExists(cmw.topicID) {
+// Watch processes the messages of the topic until the given context is done
+func (cmw Watcher) Watch(ctx context.Context, q qi.Queue) {
+	if !cmw.client.TopicExists(ctx, cmw.topicID) {
 		cmw.logger.Errorf("Could not start monitoring topic [%s] - Topic not found.", cmw.topicID.String())
 		return
 	}
 
-	cmw.beginWatching(q)
+	cmw.beginWatching(ctx, q)
+
+	cmw.logger.Infof("Stopped watching for Messages.")
 }
 
-func (cmw Watcher) updateStatusTimestamp(ts int64) {
-	err := cmw.statusRepository.Update(cmw.topicID.String(), ts)
+// commit pushes the produced messages together with the updated Status timestamp.
+// Nothing is committed if the given context is done before the messages are pushed.
+func (cmw Watcher) commit(ctx context.Context, ts int64, batch *queue.Batch, q qi.Queue) {
+	err := q.Commit(ctx, cmw.topicID.String(), ts, batch.Messages()...)
 	if err != nil {
+		if ctx.Err() != nil {
+			cmw.logger.Infof("Stopped before updating Topic Watcher Status timestamp to [%s].", timestamp.ToHumanReadable(ts))
+			return
+		}
 		cmw.logger.Fatalf("Failed to update Topic Watcher Status timestamp. Error [%s]", err)
 	}
 	cmw.logger.Tracef("Updated Topic Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (cmw Watcher) beginWatching(q qi.Queue) {
+func (cmw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := cmw.statusRepository.Get(cmw.topicID.String())
 	if err != nil {
 		cmw.logger.Fatalf("Failed to retrieve Topic Watcher Status timestamp. Error [%s]", err)
 	}
 	cmw.logger.Infof("Watching for Messages after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
-	for {
-		messages, err := cmw.client.GetMessagesAfterTimestamp(cmw.topicID, milestoneTimestamp)
+	for ctx.Err() == nil {
+		messages, err := cmw.client.GetMessagesAfterTimestamp(ctx, cmw.topicID, milestoneTimestamp)
 		if err != nil {
 			cmw.logger.Errorf("Error while retrieving messages from mirror node. Error [%s]", err)
-			go cmw.beginWatching(q)
-			return
+			wait.Sleep(ctx, cmw.pollingInterval*time.Second)
+			continue
 		}
 
 		cmw.logger.Tracef("Polling found [%d] Messages", len(messages))
@@ -126,14 +143,81 @@ func (cmw Watcher) beginWatching(q qi.Queue) {
 				cmw.logger.Errorf("Unable to parse latest message timestamp. Error - [%s].", err)
 				continue
 			}
-			cmw.processMessage(msg, q)
-			cmw.updateStatusTimestamp(milestoneTimestamp)
+			batch := &queue.Batch{}
+			cmw.processMessage(msg, batch)
+			cmw.commit(ctx, milestoneTimestamp, batch, q)
+		}
+		wait.Sleep(ctx, cmw.pollingInterval*time.Second)
+	}
+}
+
+// Name returns the watched topic
+func (cmw Watcher) Name() string {
+	return cmw.topicID.String()
+}
+
+// Backfill re-scans the messages of the topic between from included and to excluded. Every signature, which is not
+// persisted, is pushed to the given queue. The missing messages are identified by their consensus timestamps
+func (cmw Watcher) Backfill(ctx context.Context, from, to int64, q qi.Pusher) (int, []string, error) {
+	messages, err := cmw.client.GetMessagesForTopicBetween(ctx, cmw.topicID, from, to)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var missing []string
+	for _, topicMsg := range messages {
+		msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
+		if err != nil {
+			cmw.logger.Errorf("Could not decode message [%s]. Error: [%s]", topicMsg.ConsensusTimestamp, err)
+			continue
+		}
+
+		transferID, signature := signatureOf(msg)
+		_, signatureHex, err := evm.DecodeSignature(signature)
+		if err != nil {
+			cmw.logger.Errorf("[%s] - Could not decode signature [%s]. Error: [%s]", transferID, signature, err)
+			continue
+		}
+
+		persisted, err := cmw.messageRepository.Get(transferID)
+		if err != nil {
+			return len(messages), missing, err
+		}
+		if containsSignature(persisted, signatureHex) {
+			continue
+		}
+
+		cmw.logger.Warnf("[%s] - Signature in message [%s] was not processed by the watcher.", transferID, topicMsg.ConsensusTimestamp)
+		missing = append(missing, topicMsg.ConsensusTimestamp)
+		q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation})
+	}
+
+	return len(messages), missing, nil
+}
+
+func signatureOf(msg *message.Message) (transferID, signature string) {
+	switch m := msg.Message.(type) {
+	case *proto.TopicMessage_FungibleSignatureMessage:
+		return m.FungibleSignatureMessage.TransferID, m.FungibleSignatureMessage.Signature
+	case *proto.TopicMessage_NftSignatureMessage:
+		return m.NftSignatureMessage.TransferID, m.NftSignatureMessage.Signature
+	case *proto.TopicMessage_Erc1155SignatureMessage:
+		return m.Erc1155SignatureMessage.TransferID, m.Erc1155SignatureMessage.Signature
+	default:
+		return "", ""
+	}
+}
+
+func containsSignature(messages []entity.Message, signature string) bool {
+	for _, m := range messages {
+		if m.Signature == signature {
+			return true
 		}
-		time.Sleep(cmw.pollingInterval * time.Second)
 	}
+	return false
 }
 
-func (cmw Watcher) processMessage(topicMsg model.Message, q qi.Queue) {
+func (cmw Watcher) processMessage(topicMsg model.Message, q qi.Pusher) {
 	cmw.logger.Info("New Message Received")
 
 	msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
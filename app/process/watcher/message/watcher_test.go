@@ -17,16 +17,20 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 	"testing"
@@ -43,46 +47,52 @@ var (
 	milestoneTimestamp, _ = timestamp.FromString(consensusTimestamp)
 )
 
-func Test_UpdateStatusTimestamp(t *testing.T) {
+func Test_Commit(t *testing.T) {
 	setup()
-	mocks.MStatusRepository.On("Update", topicID.String(), int64(1)).Return(nil)
-	w.updateStatusTimestamp(1)
+	batch := &queue.Batch{}
+	batch.Push(&queue.Message{Topic: constants.TopicMessageValidation})
+	mocks.MQueue.On("Commit", topicID.String(), int64(1), batch.Messages()).Return(nil)
+	w.commit(context.Background(), 1, batch, mocks.MQueue)
+	mocks.MQueue.AssertCalled(t, "Commit", topicID.String(), int64(1), batch.Messages())
 }
 
 func Test_ProcessMessage_FromString_Fails(t *testing.T) {
 	setup()
-	w.processMessage(model.Message{Contents: "invalid-data"}, mocks.MQueue)
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	w.processMessage(model.Message{Contents: "invalid-data"}, mocks.MPusher)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_NewWatcher(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, mocks.MMessageRepository, 1, 0)
 }
 
 func Test_NewWatcher_Get_Error(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), gorm.ErrRecordNotFound)
 	mocks.MStatusRepository.On("Create", topicID.String(), mock.Anything).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, mocks.MMessageRepository, 1, 0)
 }
 
 func Test_NewWatcher_WithTS(t *testing.T) {
 	mocks.Setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(6), nil)
 	mocks.MStatusRepository.On("Update", topicID.String(), int64(6)).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, mocks.MMessageRepository, 1, 6)
 }
 
 func Test_BeginWatch_FailsMessagesRetrieval(t *testing.T) {
 	setup()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(5), nil)
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(5)).Return([]model.Message{}, errors.New("some-error"))
-	w.beginWatching(mocks.MQueue)
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(5)).Return([]model.Message{}, errors.New("some-error")).Run(func(args mock.Arguments) {
+		cancel()
+	})
+	w.beginWatching(ctx, mocks.MQueue)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
-	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
@@ -106,23 +116,73 @@ func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(2), nil).Once()
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(milestoneTimestamp, nil)
 	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(2)).Return([]model.Message{m}, nil).Once()
-	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, milestoneTimestamp).Return([]model.Message{}, errors.New("some-error"))
-	mocks.MQueue.On("Push", queueMessage)
-	mocks.MStatusRepository.On("Update", topicID.String(), milestoneTimestamp).Return(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, milestoneTimestamp).Return([]model.Message{}, errors.New("some-error")).Run(func(args mock.Arguments) {
+		cancel()
+	})
+	mocks.MQueue.On("Commit", topicID.String(), milestoneTimestamp, []*queue.Message{queueMessage}).Return(nil)
 
-	w.beginWatching(mocks.MQueue)
+	w.beginWatching(ctx, mocks.MQueue)
 
-	mocks.MQueue.AssertCalled(t, "Push", queueMessage)
-	mocks.MStatusRepository.AssertCalled(t, "Update", topicID.String(), milestoneTimestamp)
+	mocks.MQueue.AssertCalled(t, "Commit", topicID.String(), milestoneTimestamp, []*queue.Message{queueMessage})
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func setup() {
 	mocks.Setup()
 	w = &Watcher{
-		client:           mocks.MHederaMirrorClient,
-		topicID:          topicID,
-		statusRepository: mocks.MStatusRepository,
-		pollingInterval:  1,
-		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
+		client:            mocks.MHederaMirrorClient,
+		topicID:           topicID,
+		statusRepository:  mocks.MStatusRepository,
+		messageRepository: mocks.MMessageRepository,
+		pollingInterval:   1,
+		logger:            config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
 	}
 }
+
+func Test_Backfill(t *testing.T) {
+	m := model.Message{
+		ConsensusTimestamp: consensusTimestamp,
+		Contents: "EIHxBBodMC4wLjE4OTMtMTYzMTI2MDg5MC05NDgyMDg5NDkiKjB4MDg3MkI5RjY1OUYwYjQ" +
+			"xNGU1M2ZEYWIyQjY2OThDMzRCYWMxY0I5MCoqMHgwZjJGNjYyM2FDNGI5NGUxZDYxQjRDZD" +
+			"E5NUE2YzI4OTkyMzEwRjk2Mgk5MDAwMDAwMDE6ggE0YThiZmNhMmY2MGVkN2M5NDkwZDBhZ" +
+			"DNiZWNmODk2YmVjMGYxYmYxZmFiOTlhNWQwMmY4ZjZiYzU1NWZmNTA2NzdiOWRkMWJmOTg4" +
+			"OGIxMzZhYjhlMzMzMjE0NjJjMGRkZWNiNWQ5NzE3YTY1OGQxYjYyZTliYTkyY2Q4OTlmYjFj",
+	}
+	payload, _ := message.FromString(m.Contents, m.ConsensusTimestamp)
+	signatureMessage := payload.GetFungibleSignatureMessage()
+	_, signatureHex, _ := evm.DecodeSignature(signatureMessage.Signature)
+
+	setup()
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(1), int64(2)).Return([]model.Message{m}, nil)
+	mocks.MMessageRepository.On("Get", signatureMessage.TransferID).Return([]entity.Message{}, nil)
+	mocks.MPusher.On("Push", &queue.Message{Payload: payload, Topic: constants.TopicMessageValidation}).Return()
+
+	scanned, missing, err := w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, scanned)
+	assert.Equal(t, []string{consensusTimestamp}, missing)
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: payload, Topic: constants.TopicMessageValidation})
+
+	setup()
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(1), int64(2)).Return([]model.Message{m}, nil)
+	mocks.MMessageRepository.On("Get", signatureMessage.TransferID).Return([]entity.Message{{Signature: signatureHex}}, nil)
+
+	scanned, missing, err = w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, scanned)
+	assert.Empty(t, missing)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Backfill_MirrorNodeFails(t *testing.T) {
+	setup()
+	mocks.MHederaMirrorClient.On("GetMessagesForTopicBetween", topicID, int64(1), int64(2)).Return([]model.Message{}, errors.New("some-error"))
+
+	_, _, err := w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Error(t, err)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
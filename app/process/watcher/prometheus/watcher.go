@@ -17,6 +17,7 @@
 package prometheus
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -27,6 +28,7 @@ import (
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/prometheus/client_golang/prometheus"
@@ -102,28 +104,30 @@ func NewWatcher(
 	}
 }
 
-func (pw Watcher) Watch(q qi.Queue) {
+// Watch updates the metrics until the given context is done
+func (pw Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !pw.prometheusService.GetIsMonitoringEnabled() {
 		pw.logger.Warnf("Tried to executed Prometheus watcher, when monitoring is not enabled.")
 		return
 	}
 	// there will be no handler, so the q is to implement the interface
-	go pw.beginWatching()
+	pw.beginWatching(ctx)
 }
 
-func (pw Watcher) beginWatching() {
+func (pw Watcher) beginWatching(ctx context.Context) {
 	//The queue will be not used
-	pw.registerAssetsMetrics()
-	pw.setMetrics()
+	pw.registerAssetsMetrics(ctx)
+	pw.setMetrics(ctx)
 }
 
-func (pw Watcher) registerAssetsMetrics() {
+func (pw Watcher) registerAssetsMetrics(ctx context.Context) {
 	fungibleAssets := pw.configuration.Bridge.Assets.GetFungibleNetworkAssets()
 	for networkId, networkAssets := range fungibleAssets {
 		for _, assetAddress := range networkAssets { // native
 			if pw.configuration.Bridge.Assets.IsNative(networkId, assetAddress) {
 				// register native assets balance
 				pw.registerAssetMetric(
+					ctx,
 					networkId,
 					networkId,
 					assetAddress,
@@ -134,6 +138,7 @@ func (pw Watcher) registerAssetsMetrics() {
 				for wrappedNetworkId, wrappedAssetAddress := range wrappedFromNative {
 					//register wrapped assets total supply
 					pw.registerAssetMetric(
+						ctx,
 						networkId,
 						wrappedNetworkId,
 						wrappedAssetAddress,
@@ -147,6 +152,7 @@ func (pw Watcher) registerAssetsMetrics() {
 }
 
 func (pw Watcher) registerAssetMetric(
+	ctx context.Context,
 	nativeNetworkId,
 	wrappedNetworkId uint64,
 	assetAddress string,
@@ -154,7 +160,7 @@ func (pw Watcher) registerAssetMetric(
 	metricHelpCnt string,
 ) {
 	if assetAddress != constants.Hbar { // skip HBAR
-		assetName, assetSymbol, e := pw.getAssetData(wrappedNetworkId, assetAddress)
+		assetName, assetSymbol, e := pw.getAssetData(ctx, wrappedNetworkId, assetAddress)
 		if e != nil {
 			return
 		}
@@ -180,9 +186,9 @@ func (pw Watcher) registerAssetMetric(
 	}
 }
 
-func (pw Watcher) getAssetData(networkId uint64, assetAddress string) (name string, symbol string, err error) {
+func (pw Watcher) getAssetData(ctx context.Context, networkId uint64, assetAddress string) (name string, symbol string, err error) {
 	if networkId == constants.HederaNetworkId { // Hedera
-		asset, e := pw.mirrorNode.GetToken(assetAddress)
+		asset, e := pw.mirrorNode.GetToken(ctx, assetAddress)
 		if e != nil {
 			pw.logger.Errorf("Hedera Mirror Node method GetToken for Asset [%s] - Error: [%s]", assetAddress, e)
 			return "", "", e
@@ -240,11 +246,11 @@ func getMetricData(
 	return name, help
 }
 
-func (pw Watcher) setMetrics() {
-	for {
-		payerAccount, errPayerAcc := pw.getAccount(pw.configuration.Bridge.Hedera.PayerAccount)
-		bridgeAccount, errBridgeAcc := pw.getAccount(pw.configuration.Bridge.Hedera.BridgeAccount)
-		operatorAccount, errOperatorAcc := pw.getAccount(pw.configuration.Node.Clients.Hedera.Operator.AccountId)
+func (pw Watcher) setMetrics(ctx context.Context) {
+	for ctx.Err() == nil {
+		payerAccount, errPayerAcc := pw.getAccount(ctx, pw.configuration.Bridge.Hedera.PayerAccount)
+		bridgeAccount, errBridgeAcc := pw.getAccount(ctx, pw.configuration.Bridge.Hedera.BridgeAccount)
+		operatorAccount, errOperatorAcc := pw.getAccount(ctx, pw.configuration.Node.Clients.Hedera.Operator.AccountId)
 
 		if errPayerAcc == nil {
 			pw.payerAccountBalanceGauge.Set(pw.getAccountBalance(payerAccount))
@@ -256,15 +262,15 @@ func (pw Watcher) setMetrics() {
 			pw.operatorBalanceGauge.Set(pw.getAccountBalance(operatorAccount))
 		}
 
-		pw.setAssetsMetrics(bridgeAccount)
+		pw.setAssetsMetrics(ctx, bridgeAccount)
 
 		pw.logger.Infoln("Dashboard Polling interval: ", pw.dashboardPolling)
-		time.Sleep(pw.dashboardPolling)
+		wait.Sleep(ctx, pw.dashboardPolling)
 	}
 }
 
-func (pw Watcher) getAccount(accountId string) (*model.AccountsResponse, error) {
-	account, e := pw.mirrorNode.GetAccount(accountId)
+func (pw Watcher) getAccount(ctx context.Context, accountId string) (*model.AccountsResponse, error) {
+	account, e := pw.mirrorNode.GetAccount(ctx, accountId)
 	if e != nil {
 		pw.logger.Errorf("Hedera Mirror Node for Account ID [%s] method GetAccount - Error: [%s]", accountId, e)
 		return nil, e
@@ -278,31 +284,37 @@ func (pw Watcher) getAccountBalance(account *model.AccountsResponse) float64 {
 	return balance
 }
 
-func (pw Watcher) setAssetsMetrics(bridgeAccount *model.AccountsResponse) {
+func (pw Watcher) setAssetsMetrics(ctx context.Context, bridgeAccount *model.AccountsResponse) {
 	fungibleAssets := pw.configuration.Bridge.Assets.GetFungibleNetworkAssets()
 	for networkId, networkAssets := range fungibleAssets {
 		for _, assetAddress := range networkAssets { // native
 			// set native assets balance
-			pw.prepareAndSetAssetMetric(networkId, assetAddress, bridgeAccount, true)
+			pw.prepareAndSetAssetMetric(ctx, networkId, assetAddress, bridgeAccount, true)
 			if pw.configuration.Bridge.Assets.IsNative(networkId, assetAddress) {
 				wrappedFromNative := pw.configuration.Bridge.Assets.WrappedFromNative(networkId, assetAddress)
 				for wrappedNetworkId, wrappedAssetAddress := range wrappedFromNative {
 					//set wrapped assets total supply
-					pw.prepareAndSetAssetMetric(wrappedNetworkId, wrappedAssetAddress, bridgeAccount, false)
+					pw.prepareAndSetAssetMetric(ctx, wrappedNetworkId, wrappedAssetAddress, bridgeAccount, false)
 				}
 			}
 		}
 	}
 }
 
-func (pw Watcher) prepareAndSetAssetMetric(networkId uint64,
+func (pw Watcher) prepareAndSetAssetMetric(ctx context.Context,
+	networkId uint64,
 	assetAddress string,
 	bridgeAccount *model.AccountsResponse,
 	isNative bool,
 ) {
 	if assetAddress != constants.Hbar { // skip HBAR
-		assetMetric := pw.prometheusService.GetGauge(pw.assetsMetrics[networkId][assetAddress])
-		value, e := pw.getAssetMetricValue(networkId, assetAddress, bridgeAccount, isNative)
+		metricName, ok := pw.assetsMetrics[networkId][assetAddress]
+		if !ok {
+			// Assets, added to the bridge configuration at runtime, have no metrics until the next restart
+			return
+		}
+		assetMetric := pw.prometheusService.GetGauge(metricName)
+		value, e := pw.getAssetMetricValue(ctx, networkId, assetAddress, bridgeAccount, isNative)
 		if e != nil {
 			pw.logger.Errorf("Network ID [%d] and asset [%s] for getAssetMetricValue Error: [%s]", networkId, assetAddress, e)
 			return
@@ -318,6 +330,7 @@ func (pw Watcher) prepareAndSetAssetMetric(networkId uint64,
 }
 
 func (pw Watcher) getAssetMetricValue(
+	ctx context.Context,
 	networkId uint64,
 	assetAddress string,
 	bridgeAccount *model.AccountsResponse,
@@ -345,9 +358,9 @@ func (pw Watcher) getAssetMetricValue(
 
 	if networkId == constants.HederaNetworkId { //Hedera
 		if isNative { // Hedera native balance
-			value, err = pw.getHederaTokenBalance(assetAddress, bridgeAccount)
+			value, err = pw.getHederaTokenBalance(ctx, assetAddress, bridgeAccount)
 		} else { // Hedera wrapped total supply
-			value, err = pw.getHederaTokenSupply(assetAddress)
+			value, err = pw.getHederaTokenSupply(ctx, assetAddress)
 		}
 	} else { // EVM
 		if isNative { // EVM native balance
@@ -359,13 +372,13 @@ func (pw Watcher) getAssetMetricValue(
 	return value, err
 }
 
-func (pw Watcher) getHederaTokenBalance(assetAddress string, bridgeAccount *model.AccountsResponse) (value float64, err error) {
+func (pw Watcher) getHederaTokenBalance(ctx context.Context, assetAddress string, bridgeAccount *model.AccountsResponse) (value float64, err error) {
 	if bridgeAccount == nil {
 		return 0, errors.New(fmt.Sprintf("Bridge account cannot be nil"))
 	}
 	for _, token := range bridgeAccount.Balance.Tokens {
 		if assetAddress == token.TokenID {
-			asset, e := pw.mirrorNode.GetToken(assetAddress)
+			asset, e := pw.mirrorNode.GetToken(ctx, assetAddress)
 			if e != nil {
 				pw.logger.Errorf("Hedera Mirror Node for asset [%s] method GetToken - Error: [%s]", assetAddress, e)
 				return 0, e
@@ -389,8 +402,8 @@ func (pw Watcher) getHederaTokenBalance(assetAddress string, bridgeAccount *mode
 	return value, nil
 }
 
-func (pw Watcher) getHederaTokenSupply(assetAddress string) (float64, error) {
-	asset, e := pw.mirrorNode.GetToken(assetAddress)
+func (pw Watcher) getHederaTokenSupply(ctx context.Context, assetAddress string) (float64, error) {
+	asset, e := pw.mirrorNode.GetToken(ctx, assetAddress)
 	if e != nil {
 		pw.logger.Errorf("Hedera Mirror Node for asset [%s] method GetToken - Error: [%s]", assetAddress, e)
 		return 0, e
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening_list
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"time"
+)
+
+// Watcher polls the screening list file and loads its content whenever it changes
+type Watcher struct {
+	path     string
+	interval time.Duration
+	list     service.ScreeningList
+	checksum [sha256.Size]byte
+	logger   *log.Entry
+}
+
+func NewWatcher(path string, interval time.Duration, list service.ScreeningList) *Watcher {
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		list:     list,
+		logger:   config.GetLoggerFor(fmt.Sprintf("Screening List Watcher [%s]", path)),
+	}
+
+	// The content of the file at startup is already loaded
+	content, err := ioutil.ReadFile(path)
+	if err == nil {
+		w.checksum = sha256.Sum256(content)
+	}
+	return w
+}
+
+// Watch checks the file for changes on every interval, until the given context is done
+func (w *Watcher) Watch(ctx context.Context, q queue.Queue) {
+	w.logger.Infof("Watching for changes every [%s].", w.interval)
+	for wait.Sleep(ctx, w.interval) {
+		w.check()
+	}
+}
+
+// check loads the content of the file if it changed since the last check. A rejected content
+// is not retried until the file changes again, and the previously loaded entries stay active
+func (w *Watcher) check() {
+	content, err := ioutil.ReadFile(w.path)
+	if err != nil {
+		w.logger.Errorf("Failed to read the screening list. Error: [%s].", err)
+		return
+	}
+
+	checksum := sha256.Sum256(content)
+	if checksum == w.checksum {
+		return
+	}
+	w.checksum = checksum
+
+	w.logger.Infof("Screening list changed. Loading.")
+	if err := w.list.Load(content); err != nil {
+		w.logger.Errorf("Failed to load the screening list. Error: [%s].", err)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening_list
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/screening"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func setup(t *testing.T) (string, *screening.List, func()) {
+	dir, err := ioutil.TempDir("", "screening-list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, "screening.txt")
+	if err := ioutil.WriteFile(path, []byte("0.0.1001\n"), 0600); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	list := screening.NewList()
+	list.Load([]byte("0.0.1001\n"))
+	return path, list, cleanup
+}
+
+func Test_Check_Changed(t *testing.T) {
+	path, list, cleanup := setup(t)
+	defer cleanup()
+	w := NewWatcher(path, time.Second, list)
+	ioutil.WriteFile(path, []byte("0.0.1002\n"), 0600)
+
+	w.check()
+
+	denied, _ := list.IsDenied("0.0.1001")
+	assert.False(t, denied)
+	denied, _ = list.IsDenied("0.0.1002")
+	assert.True(t, denied)
+}
+
+func Test_Check_Invalid(t *testing.T) {
+	path, list, cleanup := setup(t)
+	defer cleanup()
+	w := NewWatcher(path, time.Second, list)
+	ioutil.WriteFile(path, []byte("0.0.1002\ninvalid\n"), 0600)
+
+	w.check()
+
+	denied, _ := list.IsDenied("0.0.1001")
+	assert.True(t, denied)
+	denied, _ = list.IsDenied("0.0.1002")
+	assert.False(t, denied)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supply
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+// Watcher periodically reconciles the locked balances of the native assets against the supplies of their wrapped assets
+type Watcher struct {
+	interval       time.Duration
+	reconciliation service.Reconciliation
+	logger         *log.Entry
+}
+
+func NewWatcher(interval time.Duration, reconciliation service.Reconciliation) *Watcher {
+	return &Watcher{
+		interval:       interval,
+		reconciliation: reconciliation,
+		logger:         config.GetLoggerFor("Supply Watcher"),
+	}
+}
+
+// Watch reconciles on start and on every interval after, until the given context is done
+func (w *Watcher) Watch(ctx context.Context, q queue.Queue) {
+	w.logger.Infof("Reconciling supplies every [%s].", w.interval)
+	w.reconcile(ctx)
+	for wait.Sleep(ctx, w.interval) {
+		w.reconcile(ctx)
+	}
+}
+
+func (w *Watcher) reconcile(ctx context.Context) {
+	snapshots, err := w.reconciliation.Reconcile(ctx)
+	if err != nil {
+		w.logger.Errorf("Failed to reconcile supplies. Error: [%s].", err)
+		return
+	}
+
+	diverged := 0
+	for _, snapshot := range snapshots {
+		if snapshot.Diverged {
+			diverged++
+		}
+	}
+	w.logger.Infof("Reconciled [%d] assets, [%d] diverged.", len(snapshots), diverged)
+}
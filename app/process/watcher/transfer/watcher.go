@@ -17,6 +17,7 @@
 package cryptotransfer
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -29,28 +30,32 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"math/big"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 )
 
 type Watcher struct {
-	transfers         service.Transfers
-	client            client.MirrorNode
-	accountID         hedera.AccountID
-	pollingInterval   time.Duration
-	statusRepository  repository.Status
-	targetTimestamp   int64
-	logger            *log.Entry
-	contractServices  map[uint64]service.Contracts
-	mappings          config.Assets
-	hederaNftFees     map[string]int64
-	validator         bool
-	prometheusService service.Prometheus
+	transfers          service.Transfers
+	client             client.MirrorNode
+	accountID          hedera.AccountID
+	pollingInterval    time.Duration
+	statusRepository   repository.Status
+	transferRepository repository.Transfer
+	targetTimestamp    int64
+	logger             *log.Entry
+	contractServices   map[uint64]service.Contracts
+	mappings           config.Assets
+	validator          bool
+	prometheusService  service.Prometheus
 }
 
 func NewWatcher(
@@ -59,10 +64,10 @@ func NewWatcher(
 	accountID string,
 	pollingInterval time.Duration,
 	repository repository.Status,
+	transferRepository repository.Transfer,
 	startTimestamp int64,
 	contractServices map[uint64]service.Contracts,
 	mappings config.Assets,
-	hederaNftFees map[string]int64,
 	validator bool,
 	prometheusService service.Prometheus,
 ) *Watcher {
@@ -96,75 +101,132 @@ func NewWatcher(
 	}
 
 	return &Watcher{
-		transfers:         transfers,
-		client:            client,
-		accountID:         id,
-		pollingInterval:   pollingInterval,
-		statusRepository:  repository,
-		targetTimestamp:   targetTimestamp,
-		logger:            config.GetLoggerFor(fmt.Sprintf("[%s] Transfer Watcher", accountID)),
-		contractServices:  contractServices,
-		mappings:          mappings,
-		hederaNftFees:     hederaNftFees,
-		validator:         validator,
-		prometheusService: prometheusService,
+		transfers:          transfers,
+		client:             client,
+		accountID:          id,
+		pollingInterval:    pollingInterval,
+		statusRepository:   repository,
+		transferRepository: transferRepository,
+		targetTimestamp:    targetTimestamp,
+		logger:             config.GetLoggerFor(fmt.Sprintf("[%s] Transfer Watcher", accountID)),
+		contractServices:   contractServices,
+		mappings:           mappings,
+		validator:          validator,
+		prometheusService:  prometheusService,
 	}
 }
 
-func (ctw Watcher) Watch(q qi.Queue) {
-	if !ctw.client.AccountExists(ctw.accountID) {
+// Watch processes the incoming transfers of the account until the given context is done
+func (ctw Watcher) Watch(ctx context.Context, q qi.Queue) {
+	if !ctw.client.AccountExists(ctx, ctw.accountID) {
 		ctw.logger.Errorf("Could not start monitoring account [%s] - Account not found.", ctw.accountID.String())
 		return
 	}
 
-	go ctw.beginWatching(q)
+	ctw.beginWatching(ctx, q)
+
+	ctw.logger.Infof("Stopped watching for Transfers.")
 }
 
-func (ctw Watcher) updateStatusTimestamp(ts int64) {
-	err := ctw.statusRepository.Update(ctw.accountID.String(), ts)
+// commit pushes the produced messages together with the updated Status timestamp.
+// Nothing is committed if the given context is done before the messages are pushed.
+func (ctw Watcher) commit(ctx context.Context, ts int64, batch *queue.Batch, q qi.Queue) {
+	err := q.Commit(ctx, ctw.accountID.String(), ts, batch.Messages()...)
 	if err != nil {
+		if ctx.Err() != nil {
+			ctw.logger.Infof("Stopped before updating Transfer Watcher Status timestamp to [%s].", timestamp.ToHumanReadable(ts))
+			return
+		}
 		ctw.logger.Fatalf("Failed to update Transfer Watcher Status timestamp. Error [%s]", err)
 	}
 	ctw.logger.Tracef("Updated Transfer Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (ctw Watcher) beginWatching(q qi.Queue) {
+func (ctw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := ctw.statusRepository.Get(ctw.accountID.String())
 	if err != nil {
 		ctw.logger.Fatalf("Failed to retrieve Transfer Watcher Status timestamp. Error [%s]", err)
 	}
 	ctw.logger.Infof("Watching for Transfers after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
-	for {
-		transactions, e := ctw.client.GetAccountCreditTransactionsAfterTimestamp(ctw.accountID, milestoneTimestamp)
+	for ctx.Err() == nil {
+		transactions, e := ctw.client.GetAccountCreditTransactionsAfterTimestamp(ctx, ctw.accountID, milestoneTimestamp)
 		if e != nil {
-			ctw.logger.Errorf("Suddenly stopped monitoring account. Error: [%s]", e)
-			go ctw.beginWatching(q)
-			return
+			ctw.logger.Errorf("Failed to retrieve account transactions. Error: [%s]", e)
+			wait.Sleep(ctx, ctw.pollingInterval*time.Second)
+			continue
 		}
 
 		ctw.logger.Tracef("Polling found [%d] Transactions", len(transactions.Transactions))
 		if len(transactions.Transactions) > 0 {
-			for _, tx := range transactions.Transactions {
-				go ctw.processTransaction(tx.TransactionID, q)
-			}
-			var err error
-			milestoneTimestamp, err = timestamp.FromString(transactions.Transactions[len(transactions.Transactions)-1].ConsensusTimestamp)
+			latestTimestamp, err := timestamp.FromString(transactions.Transactions[len(transactions.Transactions)-1].ConsensusTimestamp)
 			if err != nil {
 				ctw.logger.Errorf("Unable to parse latest transfer timestamp. Error - [%s].", err)
 				continue
 			}
 
-			ctw.updateStatusTimestamp(milestoneTimestamp)
+			batch := &queue.Batch{}
+			var wg sync.WaitGroup
+			for _, tx := range transactions.Transactions {
+				wg.Add(1)
+				go func(txID string) {
+					defer wg.Done()
+					ctw.processTransaction(ctx, txID, batch)
+				}(tx.TransactionID)
+			}
+			wg.Wait()
+
+			milestoneTimestamp = latestTimestamp
+			ctw.commit(ctx, milestoneTimestamp, batch, q)
+		}
+		wait.Sleep(ctx, ctw.pollingInterval*time.Second)
+	}
+}
+
+// Name returns the watched account
+func (ctw Watcher) Name() string {
+	return ctw.accountID.String()
+}
+
+// Backfill re-scans the incoming transfers of the account between from included and to excluded. Every transaction,
+// which is not persisted as a transfer and passes the checks of the watcher, is pushed to the given queue
+func (ctw Watcher) Backfill(ctx context.Context, from, to int64, q qi.Pusher) (int, []string, error) {
+	transactions, err := ctw.client.GetAccountCreditTransactionsBetween(ctx, ctw.accountID, from, to)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var missing []string
+	for _, tx := range transactions {
+		t, err := ctw.transferRepository.GetByTransactionId(tx.TransactionID)
+		if err != nil {
+			return len(transactions), missing, err
+		}
+		if t != nil {
+			continue
+		}
+
+		batch := &queue.Batch{}
+		ctw.processTransaction(ctx, tx.TransactionID, batch)
+		// Transactions rejected by the watcher are never persisted and have nothing to backfill
+		if len(batch.Messages()) == 0 {
+			continue
+		}
+
+		ctw.logger.Warnf("[%s] - Transfer was not processed by the watcher.", tx.TransactionID)
+		missing = append(missing, tx.TransactionID)
+		for _, m := range batch.Messages() {
+			q.Push(m)
 		}
-		time.Sleep(ctw.pollingInterval * time.Second)
 	}
+
+	return len(transactions), missing, nil
 }
 
-func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
+func (ctw Watcher) processTransaction(ctx context.Context, txID string, q qi.Pusher) {
 	ctw.logger.Infof("New Transaction with ID: [%s]", txID)
 
-	tx, err := ctw.client.GetSuccessfulTransaction(txID)
+	tx, err := ctw.client.GetSuccessfulTransaction(ctx, txID)
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to get Transaction. Error: [%s]", txID, err)
 		return
@@ -182,6 +244,11 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		return
 	}
 
+	if erc1155 := ctw.mappings.Erc1155Native(parsedTransfer.Asset); erc1155 != nil {
+		ctw.processErc1155Transaction(tx, *erc1155, targetChainId, receiverAddress, q)
+		return
+	}
+
 	if !parsedTransfer.IsNft {
 		ctw.initSuccessRatePrometheusMetrics(tx, constants.HederaNetworkId, targetChainId, parsedTransfer.Asset)
 	}
@@ -198,25 +265,35 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 			return
 		}
 		targetChainAsset = nativeAsset.Asset
+		// This is the case when you are bridging wrapped to wrapped
 		if nativeAsset.ChainId != targetChainId {
-			ctw.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", tx.TransactionID, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
-			return
+			if parsedTransfer.IsNft {
+				ctw.logger.Errorf("[%s] - Wrapped to Wrapped NFT transfers are not supported [%s] - [%d] for [%d]", tx.TransactionID, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+				return
+			}
+			targetChainAsset = ctw.mappings.NativeToWrapped(nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+			if targetChainAsset == "" {
+				ctw.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s] - [%d] for [%d].", tx.TransactionID, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+				return
+			}
 		}
 	}
 
 	var transferMessage *transfer.Transfer
-	if parsedTransfer.IsNft {
+	if parsedTransfer.IsNft && nativeAsset.ChainId != constants.HederaNetworkId {
+		transferMessage, err = ctw.createWrappedNonFungiblePayload(tx.TransactionID, receiverAddress, parsedTransfer.Asset, *nativeAsset, parsedTransfer.AmountOrSerialNum, targetChainId, targetChainAsset)
+	} else if parsedTransfer.IsNft {
 		// Validate that the HBAR fee is sent
 		amount, found := tx.GetHBARTransfer(ctw.accountID.String())
 		if !found {
 			ctw.logger.Errorf("[%s] - Transfer to [%s] not found.", tx.TransactionID, ctw.accountID.String())
 			return
 		}
-		if amount != ctw.hederaNftFees[parsedTransfer.Asset] {
-			ctw.logger.Errorf("[%s] - Invalid provided NFT Fee for [%s]. It should be [%d]", tx.TransactionID, parsedTransfer.Asset, ctw.hederaNftFees[parsedTransfer.Asset])
+		if nftFee := ctw.mappings.NftFee(parsedTransfer.Asset); amount != nftFee {
+			ctw.logger.Errorf("[%s] - Invalid provided NFT Fee for [%s]. It should be [%d]", tx.TransactionID, parsedTransfer.Asset, nftFee)
 			return
 		}
-		transferMessage, err = ctw.createNonFungiblePayload(tx.TransactionID, receiverAddress, parsedTransfer.Asset, *nativeAsset, parsedTransfer.AmountOrSerialNum, targetChainId, targetChainAsset)
+		transferMessage, err = ctw.createNonFungiblePayload(ctx, tx.TransactionID, receiverAddress, parsedTransfer.Asset, *nativeAsset, parsedTransfer.AmountOrSerialNum, targetChainId, targetChainAsset)
 	} else {
 		transferMessage, err = ctw.createFungiblePayload(tx.TransactionID, receiverAddress, parsedTransfer.Asset, *nativeAsset, parsedTransfer.AmountOrSerialNum, targetChainId, targetChainAsset)
 	}
@@ -242,10 +319,10 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 			}
 		} else {
 			if parsedTransfer.IsNft {
-				ctw.logger.Errorf("[%s] - NFT Transfer not supported", tx.TransactionID)
-				return
+				topic = constants.HederaBurnNftMessageSubmission
+			} else {
+				topic = constants.HederaBurnMessageSubmission
 			}
-			topic = constants.HederaBurnMessageSubmission
 		}
 	} else {
 		transferMessage.Timestamp = tx.ConsensusTimestamp
@@ -256,10 +333,6 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 				topic = constants.ReadOnlyHederaFeeTransfer
 			}
 		} else {
-			if parsedTransfer.IsNft {
-				ctw.logger.Errorf("[%s] - NFT Read-only Transfer not supported", tx.TransactionID)
-				return
-			}
 			topic = constants.ReadOnlyHederaBurn
 		}
 	}
@@ -267,6 +340,48 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 	q.Push(&queue.Message{Payload: transferMessage, Topic: topic})
 }
 
+// processErc1155Transaction handles the return of wrapped ERC-1155 tokens to their native EVM network.
+// All HTS tokens, which wrap token IDs of the same ERC-1155 contract and are sent to the bridge account, form the batch
+func (ctw Watcher) processErc1155Transaction(tx model.Transaction, erc1155 config.Erc1155Asset, targetChainId uint64, receiver string, q qi.Pusher) {
+	if targetChainId != erc1155.ChainId {
+		ctw.logger.Errorf("[%s] - Wrapped to Wrapped ERC-1155 transfers are not supported [%s] - [%d] for [%d]", tx.TransactionID, erc1155.Asset, erc1155.ChainId, targetChainId)
+		return
+	}
+
+	var items []transfer.Erc1155Item
+	for _, tr := range tx.GetIncomingTokenTransfers(ctw.accountID.String()) {
+		asset := ctw.mappings.Erc1155Native(tr.Token)
+		if asset == nil || asset.ChainId != erc1155.ChainId || asset.Asset != erc1155.Asset {
+			ctw.logger.Errorf("[%s] - Token [%s] is not part of the ERC-1155 batch of [%s] - [%d].", tx.TransactionID, tr.Token, erc1155.Asset, erc1155.ChainId)
+			return
+		}
+		items = append(items, transfer.Erc1155Item{
+			TokenId:      asset.TokenId,
+			Amount:       strconv.FormatInt(tr.Amount, 10),
+			WrappedAsset: tr.Token,
+		})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].TokenId < items[j].TokenId
+	})
+
+	transferMessage := transfer.NewErc1155(tx.TransactionID, constants.HederaNetworkId, targetChainId, erc1155.ChainId, receiver, erc1155.Asset, items)
+
+	transactionTimestamp, err := timestamp.FromString(tx.ConsensusTimestamp)
+	if err != nil {
+		ctw.logger.Errorf("[%s] - Failed to parse consensus timestamp [%s]. Error: [%s]", tx.TransactionID, tx.ConsensusTimestamp, err)
+		return
+	}
+
+	topic := constants.HederaBurnErc1155MessageSubmission
+	if !ctw.validator || transactionTimestamp <= ctw.targetTimestamp {
+		transferMessage.Timestamp = tx.ConsensusTimestamp
+		topic = constants.ReadOnlyHederaBurnErc1155
+	}
+
+	q.Push(&queue.Message{Payload: transferMessage, Topic: topic})
+}
+
 func (ctw Watcher) createFungiblePayload(transactionID string, receiver string, sourceAsset string, asset config.NativeAsset, amount int64, targetChainId uint64, targetChainAsset string) (*transfer.Transfer, error) {
 	nativeAsset := ctw.mappings.FungibleNativeAsset(asset.ChainId, asset.Asset)
 	properAmount, err := ctw.contractServices[targetChainId].AddDecimals(big.NewInt(amount), targetChainAsset)
@@ -296,6 +411,7 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 }
 
 func (ctw Watcher) createNonFungiblePayload(
+	ctx context.Context,
 	transactionID string,
 	receiver string,
 	sourceAsset string,
@@ -303,7 +419,7 @@ func (ctw Watcher) createNonFungiblePayload(
 	serialNum int64,
 	targetChainId uint64,
 	targetChainAsset string) (*transfer.Transfer, error) {
-	nftData, err := ctw.client.GetNft(sourceAsset, serialNum)
+	nftData, err := ctw.client.GetNft(ctx, sourceAsset, serialNum)
 	if err != nil {
 		return nil, err
 	}
@@ -325,6 +441,36 @@ func (ctw Watcher) createNonFungiblePayload(
 		string(decodedMetadata)), nil
 }
 
+// createWrappedNonFungiblePayload creates the transfer of a wrapped Hedera NFT back to its native EVM network.
+// The transfer unlocks the EVM native NFT, which was locked when the given serial number was minted
+func (ctw Watcher) createWrappedNonFungiblePayload(
+	transactionID string,
+	receiver string,
+	sourceAsset string,
+	nativeAsset config.NativeAsset,
+	serialNum int64,
+	targetChainId uint64,
+	targetChainAsset string) (*transfer.Transfer, error) {
+	tokenId, err := ctw.transfers.LockedNftTokenId(sourceAsset, serialNum)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("[%s] - Failed to retrieve the locked token ID of [%s] - [%d]. Error [%s]", transactionID, sourceAsset, serialNum, err))
+	}
+
+	wrappedTransfer := transfer.NewNft(
+		transactionID,
+		constants.HederaNetworkId,
+		targetChainId,
+		nativeAsset.ChainId,
+		receiver,
+		sourceAsset,
+		targetChainAsset,
+		nativeAsset.Asset,
+		tokenId,
+		"")
+	wrappedTransfer.WrappedSerialNum = serialNum
+	return wrappedTransfer, nil
+}
+
 func (ctw Watcher) initSuccessRatePrometheusMetrics(tx model.Transaction, sourceChainId, targetChainId uint64, asset string) {
 	if !ctw.prometheusService.GetIsMonitoringEnabled() {
 		return
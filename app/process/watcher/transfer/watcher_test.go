@@ -17,14 +17,20 @@
 package cryptotransfer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	iservice "github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 	"math/big"
@@ -55,6 +61,33 @@ var (
 				},
 			},
 		},
+		2: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					"0x0000000000000000000000000000000000000002": {
+						Networks: map[uint64]string{
+							0: "0.0.222222",
+							3: "0x0000000000000000000000000000000000000003",
+						},
+					},
+				},
+				Nft: map[string]parser.Token{
+					"0x0000000000000000000000000000000000000004": {
+						Networks: map[uint64]string{
+							0: "0.0.333333",
+						},
+					},
+				},
+				Erc1155: map[string]parser.Erc1155Token{
+					"0x0000000000000000000000000000000000000005": {
+						TokenIds: map[int64]string{
+							1: "0.0.666661",
+							2: "0.0.666662",
+						},
+					},
+				},
+			},
+		},
 	}
 	assets = config.LoadAssets(networks)
 )
@@ -64,9 +97,9 @@ func Test_NewMemo_MissingWrappedCorrelation(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", mock.Anything).Return(uint64(0), "0xevmaddress", nil)
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(context.Background(), tx.TransactionID, mocks.MPusher)
 	mocks.MTransferService.AssertCalled(t, "SanityCheckTransfer", tx)
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_NewWatcher_RecordNotFound_Creates(t *testing.T) {
@@ -80,10 +113,10 @@ func Test_NewWatcher_RecordNotFound_Creates(t *testing.T) {
 		"0.0.444444",
 		5,
 		mocks.MStatusRepository,
+		mocks.MTransferRepository,
 		0,
 		map[uint64]iservice.Contracts{3: mocks.MBridgeContractService, 0: mocks.MBridgeContractService},
 		assets,
-		map[string]int64{},
 		true,
 		mocks.MPrometheusService)
 
@@ -100,10 +133,10 @@ func Test_NewWatcher_NotNilTS_Works(t *testing.T) {
 		"0.0.444444",
 		5,
 		mocks.MStatusRepository,
+		mocks.MTransferRepository,
 		1,
 		map[uint64]iservice.Contracts{3: mocks.MBridgeContractService, 0: mocks.MBridgeContractService},
 		assets,
-		map[string]int64{},
 		true,
 		mocks.MPrometheusService)
 
@@ -118,16 +151,16 @@ func Test_Watch_AccountNotExist(t *testing.T) {
 		Account: 444444,
 	}
 	mocks.MHederaMirrorClient.On("AccountExists", hederaAcc).Return(false)
-	w.Watch(mocks.MQueue)
+	w.Watch(context.Background(), mocks.MQueue)
 }
 
 func Test_ProcessTransaction(t *testing.T) {
 	w := initializeWatcher()
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(uint64(3), "0xaiskdjakdjakl", nil)
-	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MPusher.On("Push", mock.Anything).Return()
 	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(10), "0x0000000000000000000000000000000000000001").Return(big.NewInt(10), nil)
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(context.Background(), tx.TransactionID, mocks.MPusher)
 }
 
 func Test_ProcessTransaction_WithTS(t *testing.T) {
@@ -137,14 +170,116 @@ func Test_ProcessTransaction_WithTS(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", anotherTx.TransactionID).Return(anotherTx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", anotherTx).Return(uint64(3), "0xaiskdjakdjakl", nil)
 	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(10), "0x0000000000000000000000000000000000000001").Return(big.NewInt(10), nil)
-	mocks.MQueue.On("Push", mock.Anything).Return()
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	mocks.MPusher.On("Push", mock.Anything).Return()
+	w.processTransaction(context.Background(), anotherTx.TransactionID, mocks.MPusher)
 }
 
-func Test_UpdateStatusTimestamp_Works(t *testing.T) {
+func Test_ProcessTransaction_WrappedToWrapped(t *testing.T) {
 	w := initializeWatcher()
-	mocks.MStatusRepository.On("Update", "0.0.444444", int64(100)).Return(nil)
-	w.updateStatusTimestamp(100)
+	wrappedTx := tx
+	wrappedTx.TokenTransfers = []model.Transfer{
+		{
+			Account: "0.0.444444",
+			Amount:  10,
+			Token:   "0.0.222222",
+		},
+	}
+	wrappedTx.ConsensusTimestamp = fmt.Sprintf("%d.0", time.Now().Add(time.Hour).Unix())
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", wrappedTx.TransactionID).Return(wrappedTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", wrappedTx).Return(uint64(3), "0xaiskdjakdjakl", nil)
+	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(10), "0x0000000000000000000000000000000000000003").Return(big.NewInt(1000), nil)
+	isWrappedToWrapped := mock.MatchedBy(func(m *queue.Message) bool {
+		payload := m.Payload.(*transfer.Transfer)
+		return m.Topic == constants.HederaBurnMessageSubmission &&
+			payload.SourceAsset == "0.0.222222" &&
+			payload.TargetAsset == "0x0000000000000000000000000000000000000003" &&
+			payload.NativeChainId == 2 &&
+			payload.Amount == "1000"
+	})
+	mocks.MPusher.On("Push", isWrappedToWrapped).Return()
+
+	w.processTransaction(context.Background(), wrappedTx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", isWrappedToWrapped)
+}
+
+func Test_ProcessTransaction_WrappedToWrapped_MissingTargetAsset(t *testing.T) {
+	w := initializeWatcher()
+	wrappedTx := tx
+	wrappedTx.TokenTransfers = []model.Transfer{
+		{
+			Account: "0.0.444444",
+			Amount:  10,
+			Token:   "0.0.222222",
+		},
+	}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", wrappedTx.TransactionID).Return(wrappedTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", wrappedTx).Return(uint64(5), "0xaiskdjakdjakl", nil)
+
+	w.processTransaction(context.Background(), wrappedTx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_ProcessTransaction_WrappedNft(t *testing.T) {
+	w := initializeWatcher()
+	nftTx := tx
+	nftTx.TokenTransfers = nil
+	nftTx.NftTransfers = []model.NftTransfer{
+		{
+			ReceiverAccountID: "0.0.444444",
+			SenderAccountID:   "0.0.555555",
+			SerialNumber:      3,
+			Token:             "0.0.333333",
+		},
+	}
+	nftTx.ConsensusTimestamp = fmt.Sprintf("%d.0", time.Now().Add(time.Hour).Unix())
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", nftTx.TransactionID).Return(nftTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", nftTx).Return(uint64(2), "0xaiskdjakdjakl", nil)
+	mocks.MTransferService.On("LockedNftTokenId", "0.0.333333", int64(3)).Return(int64(7), nil)
+	isWrappedNft := mock.MatchedBy(func(m *queue.Message) bool {
+		payload := m.Payload.(*transfer.Transfer)
+		return m.Topic == constants.HederaBurnNftMessageSubmission &&
+			payload.IsNft &&
+			payload.SourceAsset == "0.0.333333" &&
+			payload.TargetAsset == "0x0000000000000000000000000000000000000004" &&
+			payload.SerialNum == 7 &&
+			payload.WrappedSerialNum == 3
+	})
+	mocks.MPusher.On("Push", isWrappedNft).Return()
+
+	w.processTransaction(context.Background(), nftTx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", isWrappedNft)
+}
+
+func Test_ProcessTransaction_WrappedNft_NotLocked(t *testing.T) {
+	w := initializeWatcher()
+	nftTx := tx
+	nftTx.TokenTransfers = nil
+	nftTx.NftTransfers = []model.NftTransfer{
+		{
+			ReceiverAccountID: "0.0.444444",
+			SerialNumber:      3,
+			Token:             "0.0.333333",
+		},
+	}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", nftTx.TransactionID).Return(nftTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", nftTx).Return(uint64(2), "0xaiskdjakdjakl", nil)
+	mocks.MTransferService.On("LockedNftTokenId", "0.0.333333", int64(3)).Return(int64(0), iservice.ErrNotFound)
+
+	w.processTransaction(context.Background(), nftTx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Commit_Works(t *testing.T) {
+	w := initializeWatcher()
+	batch := &queue.Batch{}
+	batch.Push(&queue.Message{Topic: constants.HederaTransferMessageSubmission})
+	mocks.MQueue.On("Commit", "0.0.444444", int64(100), batch.Messages()).Return(nil)
+	w.commit(context.Background(), 100, batch, mocks.MQueue)
+	mocks.MQueue.AssertCalled(t, "Commit", "0.0.444444", int64(100), batch.Messages())
 }
 
 func Test_ProcessTransaction_SanityCheckTransfer_Fails(t *testing.T) {
@@ -152,9 +287,9 @@ func Test_ProcessTransaction_SanityCheckTransfer_Fails(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(uint64(0), "", errors.New("some-error"))
 
-	w.processTransaction(tx.TransactionID, mocks.MQueue)
+	w.processTransaction(context.Background(), tx.TransactionID, mocks.MPusher)
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_ProcessTransaction_GetIncomingTransfer_Fails(t *testing.T) {
@@ -163,9 +298,9 @@ func Test_ProcessTransaction_GetIncomingTransfer_Fails(t *testing.T) {
 	anotherTx.Transfers = []model.Transfer{}
 	anotherTx.TokenTransfers = []model.Transfer{}
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", anotherTx.TransactionID).Return(anotherTx, nil)
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	w.processTransaction(context.Background(), anotherTx.TransactionID, mocks.MPusher)
 
-	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 	mocks.MTransferService.AssertNotCalled(t, "SanityCheckTransfer", mock.Anything)
 }
 
@@ -176,8 +311,61 @@ func Test_ConsensusTimestamp_Fails(t *testing.T) {
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", anotherTx.TransactionID).Return(anotherTx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", anotherTx).Return(uint64(3), "0xaiskdjakdjakl", nil)
 	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(10), "0x0000000000000000000000000000000000000001").Return(big.NewInt(10), nil)
-	mocks.MQueue.On("Push", mock.Anything).Return()
-	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
+	mocks.MPusher.On("Push", mock.Anything).Return()
+	w.processTransaction(context.Background(), anotherTx.TransactionID, mocks.MPusher)
+}
+
+func Test_ProcessTransaction_Erc1155(t *testing.T) {
+	w := initializeWatcher()
+	erc1155Tx := tx
+	erc1155Tx.TokenTransfers = []model.Transfer{
+		{Account: "0.0.555555", Amount: -20, Token: "0.0.666662"},
+		{Account: "0.0.444444", Amount: 20, Token: "0.0.666662"},
+		{Account: "0.0.555555", Amount: -10, Token: "0.0.666661"},
+		{Account: "0.0.444444", Amount: 10, Token: "0.0.666661"},
+	}
+	erc1155Tx.ConsensusTimestamp = fmt.Sprintf("%d.0", time.Now().Add(time.Hour).Unix())
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", erc1155Tx.TransactionID).Return(erc1155Tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", erc1155Tx).Return(uint64(2), "0xaiskdjakdjakl", nil)
+	expected := transfer.NewErc1155(erc1155Tx.TransactionID, 0, 2, 2, "0xaiskdjakdjakl", "0x0000000000000000000000000000000000000005",
+		[]transfer.Erc1155Item{
+			{TokenId: 1, Amount: "10", WrappedAsset: "0.0.666661"},
+			{TokenId: 2, Amount: "20", WrappedAsset: "0.0.666662"},
+		})
+	mocks.MPusher.On("Push", &queue.Message{Payload: expected, Topic: constants.HederaBurnErc1155MessageSubmission}).Return()
+
+	w.processTransaction(context.Background(), erc1155Tx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.HederaBurnErc1155MessageSubmission})
+}
+
+func Test_ProcessTransaction_Erc1155_MixedBatch(t *testing.T) {
+	w := initializeWatcher()
+	erc1155Tx := tx
+	erc1155Tx.TokenTransfers = []model.Transfer{
+		{Account: "0.0.444444", Amount: 10, Token: "0.0.666661"},
+		{Account: "0.0.444444", Amount: 10, Token: "0.0.222222"},
+	}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", erc1155Tx.TransactionID).Return(erc1155Tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", erc1155Tx).Return(uint64(2), "0xaiskdjakdjakl", nil)
+
+	w.processTransaction(context.Background(), erc1155Tx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_ProcessTransaction_Erc1155_WrappedToWrapped(t *testing.T) {
+	w := initializeWatcher()
+	erc1155Tx := tx
+	erc1155Tx.TokenTransfers = []model.Transfer{
+		{Account: "0.0.444444", Amount: 10, Token: "0.0.666661"},
+	}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", erc1155Tx.TransactionID).Return(erc1155Tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", erc1155Tx).Return(uint64(3), "0xaiskdjakdjakl", nil)
+
+	w.processTransaction(context.Background(), erc1155Tx.TransactionID, mocks.MPusher)
+
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func setup() {
@@ -196,10 +384,60 @@ func initializeWatcher() *Watcher {
 		"0.0.444444",
 		5,
 		mocks.MStatusRepository,
+		mocks.MTransferRepository,
 		0,
 		map[uint64]iservice.Contracts{3: mocks.MBridgeContractService, 0: mocks.MBridgeContractService},
 		assets,
-		map[string]int64{},
 		true,
 		mocks.MPrometheusService)
 }
+
+func Test_Backfill_EnqueuesMissing(t *testing.T) {
+	w := initializeWatcher()
+	missingTx := tx
+	missingTx.TransactionID = "0.0.1-1631092491-483966000"
+	persistedTx := tx
+	persistedTx.TransactionID = "0.0.2-1631092491-483966000"
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(1), int64(2)).Return([]model.Transaction{missingTx, persistedTx}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", missingTx.TransactionID).Return(nil, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", persistedTx.TransactionID).Return(&entity.Transfer{TransactionID: persistedTx.TransactionID}, nil)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", missingTx.TransactionID).Return(missingTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", missingTx).Return(uint64(3), "0xaiskdjakdjakl", nil)
+	mocks.MBridgeContractService.On("AddDecimals", big.NewInt(10), "0x0000000000000000000000000000000000000001").Return(big.NewInt(10), nil)
+	mocks.MPusher.On("Push", mock.Anything).Return()
+
+	scanned, missing, err := w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, scanned)
+	assert.Equal(t, []string{missingTx.TransactionID}, missing)
+	mocks.MPusher.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "GetSuccessfulTransaction", persistedTx.TransactionID)
+}
+
+func Test_Backfill_SkipsRejected(t *testing.T) {
+	w := initializeWatcher()
+	invalidTx := tx
+	invalidTx.TransactionID = "0.0.1-1631092491-483966000"
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(1), int64(2)).Return([]model.Transaction{invalidTx}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", invalidTx.TransactionID).Return(nil, nil)
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", invalidTx.TransactionID).Return(invalidTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", invalidTx).Return(uint64(0), "", errors.New("invalid memo"))
+
+	scanned, missing, err := w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, scanned)
+	assert.Empty(t, missing)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Backfill_MirrorNodeFails(t *testing.T) {
+	w := initializeWatcher()
+	mocks.MHederaMirrorClient.On("GetAccountCreditTransactionsBetween", w.accountID, int64(1), int64(2)).Return([]model.Transaction{}, errors.New("some-error"))
+
+	_, _, err := w.Backfill(context.Background(), 1, 2, mocks.MPusher)
+
+	assert.Error(t, err)
+	mocks.MPusher.AssertNotCalled(t, "Push", mock.Anything)
+}
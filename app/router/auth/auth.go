@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto/subtle"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"net/http"
+	"strings"
+)
+
+const bearerPrefix = "Bearer "
+
+// Admin restricts access to requests carrying the given API key as a bearer token
+func Admin(apiKey string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			token := strings.TrimPrefix(header, bearerPrefix)
+			if apiKey == "" || token == header || subtle.ConstantTimeCompare([]byte(token), []byte(apiKey)) != 1 {
+				render.Status(r, http.StatusUnauthorized)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorUnauthorized))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const apiKey = "some-api-key"
+
+func Test_Admin(t *testing.T) {
+	for header, expectedStatus := range map[string]int{
+		"Bearer " + apiKey:       http.StatusOK,
+		"":                       http.StatusUnauthorized,
+		apiKey:                   http.StatusUnauthorized,
+		"Bearer other-key":       http.StatusUnauthorized,
+		"Bearer " + apiKey + "1": http.StatusUnauthorized,
+	} {
+		assert.Equal(t, expectedStatus, serve(apiKey, header), header)
+	}
+}
+
+func Test_Admin_EmptyApiKey(t *testing.T) {
+	assert.Equal(t, http.StatusUnauthorized, serve("", "Bearer "))
+}
+
+func serve(apiKey, header string) int {
+	handler := Admin(apiKey)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if header != "" {
+		r.Header.Set("Authorization", header)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	return w.Code
+}
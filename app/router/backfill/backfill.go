@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backfill
+
+import (
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+	"strconv"
+)
+
+var (
+	Route  = "/backfill"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+// GET: .../backfill
+func getLatest(backfillService service.Backfill) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := backfillService.GetLatest()
+		if report == nil {
+			render.Status(r, http.StatusNotFound)
+			render.JSON(w, r, response.ErrorResponse(service.ErrNotFound))
+			return
+		}
+
+		render.JSON(w, r, report)
+	}
+}
+
+// POST: .../backfill?from=&to=&dry_run=
+func backfill(backfillService service.Backfill) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		from, err := timestamp.FromString(query.Get("from"))
+		if err != nil {
+			renderBadRequest(w, r)
+			return
+		}
+		to, err := timestamp.FromString(query.Get("to"))
+		if err != nil {
+			renderBadRequest(w, r)
+			return
+		}
+		dryRun := false
+		if value := query.Get("dry_run"); value != "" {
+			dryRun, err = strconv.ParseBool(value)
+			if err != nil {
+				renderBadRequest(w, r)
+				return
+			}
+		}
+
+		report, err := backfillService.Backfill(r.Context(), from, to, dryRun)
+		if err != nil {
+			if err == service.ErrInvalidRange {
+				renderBadRequest(w, r)
+				return
+			}
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		render.JSON(w, r, report)
+	}
+}
+
+func renderBadRequest(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusBadRequest)
+	render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+}
+
+// NewRouter creates the operator router for backfilling the transfers and topic messages, which the watchers skipped,
+// restricted to requests bearing the admin API key
+func NewRouter(service service.Backfill, apiKey string) chi.Router {
+	r := chi.NewRouter()
+	r.Use(auth.Admin(apiKey))
+	r.Get("/", getLatest(service))
+	r.Post("/", backfill(service))
+	return r
+}
@@ -17,26 +17,72 @@
 package config_bridge
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"net/http"
 )
 
 var (
-	Route = "/config/bridge"
+	Route  = "/config/bridge"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
 )
 
-//Router for bridge config
-func NewRouter(bridgeConfig parser.Bridge) http.Handler {
+type bridgeConfigResponse struct {
+	Version string `json:"version"`
+	parser.Bridge
+}
+
+//Router for bridge config. Replacing the bridge config is enabled only when an admin API key is configured
+func NewRouter(bridgeConfig service.BridgeConfig, apiKey string) http.Handler {
 	r := chi.NewRouter()
 	r.Get("/", configBridgeResponse(bridgeConfig))
+	if apiKey != "" {
+		r.With(auth.Admin(apiKey)).Put("/", applyConfigBridge(bridgeConfig))
+	}
 	return r
 }
 
 // GET: .../config/bridge
-func configBridgeResponse(bridgeConfig parser.Bridge) func(w http.ResponseWriter, r *http.Request) {
+func configBridgeResponse(bridgeConfig service.BridgeConfig) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		render.JSON(w, r, bridgeConfig)
+		bridge, version := bridgeConfig.Active()
+		render.JSON(w, r, bridgeConfigResponse{Version: version, Bridge: bridge})
+	}
+}
+
+// PUT: .../config/bridge
+func applyConfigBridge(bridgeConfig service.BridgeConfig) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var bridge parser.Bridge
+		if err := json.NewDecoder(r.Body).Decode(&bridge); err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+			return
+		}
+
+		err := bridgeConfig.Apply(bridge)
+		if err != nil {
+			var invalid *service.InvalidBridgeConfigError
+			if errors.As(err, &invalid) {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(err))
+				return
+			}
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		bridge, version := bridgeConfig.Active()
+		render.JSON(w, r, bridgeConfigResponse{Version: version, Bridge: bridge})
 	}
 }
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config_bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const apiKey = "some-api-key"
+
+var bridge = parser.Bridge{TopicId: "0.0.2001"}
+
+func serve(method, body, authorization string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, "/", strings.NewReader(body))
+	if authorization != "" {
+		request.Header.Set("Authorization", "Bearer "+authorization)
+	}
+	recorder := httptest.NewRecorder()
+	NewRouter(mocks.MBridgeConfigService, apiKey).ServeHTTP(recorder, request)
+	return recorder
+}
+
+func Test_GetConfigBridge(t *testing.T) {
+	mocks.Setup()
+	mocks.MBridgeConfigService.On("Active").Return(bridge, "some-version")
+
+	recorder := serve(http.MethodGet, "", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var body map[string]interface{}
+	json.Unmarshal(recorder.Body.Bytes(), &body)
+	assert.Equal(t, "some-version", body["version"])
+	assert.Equal(t, "0.0.2001", body["topicId"])
+}
+
+func Test_PutConfigBridge(t *testing.T) {
+	mocks.Setup()
+	mocks.MBridgeConfigService.On("Apply", bridge).Return(nil)
+	mocks.MBridgeConfigService.On("Active").Return(bridge, "some-version")
+
+	recorder := serve(http.MethodPut, `{"topicId":"0.0.2001"}`, apiKey)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mocks.MBridgeConfigService.AssertCalled(t, "Apply", bridge)
+}
+
+func Test_PutConfigBridge_Unauthorized(t *testing.T) {
+	mocks.Setup()
+
+	recorder := serve(http.MethodPut, `{"topicId":"0.0.2001"}`, "")
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	mocks.MBridgeConfigService.AssertNotCalled(t, "Apply", mock.Anything)
+}
+
+func Test_PutConfigBridge_Invalid(t *testing.T) {
+	mocks.Setup()
+	mocks.MBridgeConfigService.On("Apply", bridge).Return(&service.InvalidBridgeConfigError{Problems: []error{errors.New("some problem")}})
+
+	recorder := serve(http.MethodPut, `{"topicId":"0.0.2001"}`, apiKey)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "some problem")
+}
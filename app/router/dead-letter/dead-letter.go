@@ -0,0 +1,151 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letter
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+	"strconv"
+)
+
+var (
+	Route  = "/dead-letters"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+type deadLetterResponse struct {
+	ID          uint64          `json:"id"`
+	Topic       string          `json:"topic"`
+	PayloadType string          `json:"payloadType"`
+	Payload     json.RawMessage `json:"payload"`
+	Error       string          `json:"error"`
+	Attempts    int             `json:"attempts"`
+	CreatedAt   int64           `json:"createdAt"`
+}
+
+func newDeadLetterResponse(deadLetter *entity.DeadLetter) *deadLetterResponse {
+	return &deadLetterResponse{
+		ID:          deadLetter.ID,
+		Topic:       deadLetter.Topic,
+		PayloadType: deadLetter.PayloadType,
+		Payload:     json.RawMessage(deadLetter.Payload),
+		Error:       deadLetter.Error,
+		Attempts:    deadLetter.Attempts,
+		CreatedAt:   deadLetter.CreatedAt,
+	}
+}
+
+// GET: .../dead-letters
+func getDeadLetters(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		deadLetters, err := deadLettersService.GetAll()
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		result := make([]*deadLetterResponse, 0, len(deadLetters))
+		for _, deadLetter := range deadLetters {
+			result = append(result, newDeadLetterResponse(deadLetter))
+		}
+
+		render.JSON(w, r, result)
+	}
+}
+
+// GET: .../dead-letters/:id
+func getDeadLetter(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return withID(func(w http.ResponseWriter, r *http.Request, id uint64) {
+		deadLetter, err := deadLettersService.Get(id)
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, newDeadLetterResponse(deadLetter))
+	})
+}
+
+// POST: .../dead-letters/:id/replay
+func replayDeadLetter(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return withID(func(w http.ResponseWriter, r *http.Request, id uint64) {
+		err := deadLettersService.Replay(id)
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	})
+}
+
+// DELETE: .../dead-letters/:id
+func discardDeadLetter(deadLettersService service.DeadLetters) func(w http.ResponseWriter, r *http.Request) {
+	return withID(func(w http.ResponseWriter, r *http.Request, id uint64) {
+		err := deadLettersService.Discard(id)
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	})
+}
+
+func withID(handler func(w http.ResponseWriter, r *http.Request, id uint64)) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+			return
+		}
+
+		handler(w, r, id)
+	}
+}
+
+func renderError(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Errorf("Router resolved with an error. Error [%s].", err)
+	switch err {
+	case service.ErrNotFound:
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, response.ErrorResponse(err))
+	default:
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+	}
+}
+
+// NewRouter creates the operator router for dead letters, restricted to requests bearing the admin API key
+func NewRouter(service service.DeadLetters, apiKey string) chi.Router {
+	r := chi.NewRouter()
+	r.Use(auth.Admin(apiKey))
+	r.Get("/", getDeadLetters(service))
+	r.Get("/{id}", getDeadLetter(service))
+	r.Post("/{id}/replay", replayDeadLetter(service))
+	r.Delete("/{id}", discardDeadLetter(service))
+	return r
+}
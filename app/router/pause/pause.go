@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pause
+
+import (
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+	"strconv"
+)
+
+var (
+	Route  = "/pauses"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+type pauseResponse struct {
+	Scope     string `json:"scope"`
+	Value     string `json:"value,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// GET: .../pauses
+func getPauses(pauseService service.Pause) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pauses, err := pauseService.GetAll()
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		result := make([]*pauseResponse, 0, len(pauses))
+		for _, pause := range pauses {
+			result = append(result, &pauseResponse{
+				Scope:     pause.Scope,
+				Value:     pause.Value,
+				CreatedAt: pause.CreatedAt,
+			})
+		}
+
+		render.JSON(w, r, result)
+	}
+}
+
+// POST: .../pauses/global, .../pauses/chains/:value and .../pauses/assets/:value
+func pause(pauseService service.Pause, scope string) func(w http.ResponseWriter, r *http.Request) {
+	return withValue(scope, func(w http.ResponseWriter, r *http.Request, value string) {
+		err := pauseService.Pause(scope, value)
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	})
+}
+
+// DELETE: .../pauses/global, .../pauses/chains/:value and .../pauses/assets/:value
+func resume(pauseService service.Pause, scope string) func(w http.ResponseWriter, r *http.Request) {
+	return withValue(scope, func(w http.ResponseWriter, r *http.Request, value string) {
+		err := pauseService.Resume(scope, value)
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	})
+}
+
+// withValue passes the paused chain id or asset of the request to the handler. Chain ids must be numeric
+func withValue(scope string, handler func(w http.ResponseWriter, r *http.Request, value string)) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value := chi.URLParam(r, "value")
+		if scope == entity.PauseChain {
+			if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+				return
+			}
+		}
+
+		handler(w, r, value)
+	}
+}
+
+func renderError(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Errorf("Router resolved with an error. Error [%s].", err)
+	switch err {
+	case service.ErrNotFound:
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, response.ErrorResponse(err))
+	default:
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+	}
+}
+
+// NewRouter creates the operator router for pausing and resuming transfers, restricted to requests bearing the admin API key
+func NewRouter(service service.Pause, apiKey string) chi.Router {
+	r := chi.NewRouter()
+	r.Use(auth.Admin(apiKey))
+	r.Get("/", getPauses(service))
+	r.Post("/global", pause(service, entity.PauseGlobal))
+	r.Delete("/global", resume(service, entity.PauseGlobal))
+	r.Post("/chains/{value}", pause(service, entity.PauseChain))
+	r.Delete("/chains/{value}", resume(service, entity.PauseChain))
+	r.Post("/assets/{value}", pause(service, entity.PauseAsset))
+	r.Delete("/assets/{value}", resume(service, entity.PauseAsset))
+	return r
+}
@@ -22,6 +22,8 @@ import (
 
 var (
 	ErrorInternalServerError = errors.New("SOMETHING_WENT_WRONG")
+	ErrorUnauthorized        = errors.New("UNAUTHORIZED")
+	ErrorBadRequest          = errors.New("BAD_REQUEST")
 )
 
 type ErrResponse struct {
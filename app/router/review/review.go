@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+)
+
+var (
+	Route  = "/reviews"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+type reviewResponse struct {
+	TransferID string          `json:"transferId"`
+	Topic      string          `json:"topic"`
+	Reason     string          `json:"reason"`
+	Payload    json.RawMessage `json:"payload"`
+	CreatedAt  int64           `json:"createdAt"`
+}
+
+func newReviewResponse(review *entity.Review) *reviewResponse {
+	return &reviewResponse{
+		TransferID: review.TransferID,
+		Topic:      review.Topic,
+		Reason:     review.Reason,
+		Payload:    json.RawMessage(review.Payload),
+		CreatedAt:  review.CreatedAt,
+	}
+}
+
+// GET: .../reviews
+func getReviews(reviewsService service.Reviews) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reviews, err := reviewsService.GetPending()
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		result := make([]*reviewResponse, 0, len(reviews))
+		for _, review := range reviews {
+			result = append(result, newReviewResponse(review))
+		}
+
+		render.JSON(w, r, result)
+	}
+}
+
+// GET: .../reviews/:transferId
+func getReview(reviewsService service.Reviews) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		review, err := reviewsService.Get(chi.URLParam(r, "transferId"))
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, newReviewResponse(review))
+	}
+}
+
+// POST: .../reviews/:transferId/approve
+func approveReview(reviewsService service.Reviews) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := reviewsService.Approve(chi.URLParam(r, "transferId"))
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+// POST: .../reviews/:transferId/reject
+func rejectReview(reviewsService service.Reviews) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := reviewsService.Reject(chi.URLParam(r, "transferId"))
+		if err != nil {
+			renderError(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+func renderError(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Errorf("Router resolved with an error. Error [%s].", err)
+	switch err {
+	case service.ErrNotFound:
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, response.ErrorResponse(err))
+	default:
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+	}
+}
+
+// NewRouter creates the operator router for transfers held by the amount limits, restricted to requests bearing the admin API key
+func NewRouter(service service.Reviews, apiKey string) chi.Router {
+	r := chi.NewRouter()
+	r.Use(auth.Admin(apiKey))
+	r.Get("/", getReviews(service))
+	r.Get("/{transferId}", getReview(service))
+	r.Post("/{transferId}/approve", approveReview(service))
+	r.Post("/{transferId}/reject", rejectReview(service))
+	return r
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening_hit
+
+import (
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/auth"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+)
+
+var (
+	Route  = "/screening-hits"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+type screeningHitResponse struct {
+	ID         uint64 `json:"id"`
+	TransferID string `json:"transferId"`
+	Party      string `json:"party"`
+	Role       string `json:"role"`
+	CreatedAt  int64  `json:"createdAt"`
+}
+
+// GET: .../screening-hits
+func getScreeningHits(screeningService service.Screening) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hits, err := screeningService.GetHits()
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		result := make([]*screeningHitResponse, 0, len(hits))
+		for _, hit := range hits {
+			result = append(result, &screeningHitResponse{
+				ID:         hit.ID,
+				TransferID: hit.TransferID,
+				Party:      hit.Party,
+				Role:       hit.Role,
+				CreatedAt:  hit.CreatedAt,
+			})
+		}
+
+		render.JSON(w, r, result)
+	}
+}
+
+// NewRouter creates the operator router for auditing the blocked transfers, restricted to requests bearing the admin API key
+func NewRouter(service service.Screening, apiKey string) chi.Router {
+	r := chi.NewRouter()
+	r.Use(auth.Admin(apiKey))
+	r.Get("/", getScreeningHits(service))
+	return r
+}
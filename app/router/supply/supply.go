@@ -0,0 +1,139 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package supply
+
+import (
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"net/http"
+	"strconv"
+)
+
+var (
+	Route  = "/supply"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+const (
+	StatusOK       = "OK"
+	StatusDiverged = "DIVERGED"
+
+	defaultHistoryLimit = 100
+)
+
+type snapshotResponse struct {
+	ChainID    uint64 `json:"chainId"`
+	Asset      string `json:"asset"`
+	Locked     string `json:"locked"`
+	Supply     string `json:"supply"`
+	InFlight   string `json:"inFlight"`
+	Difference string `json:"difference"`
+	Diverged   bool   `json:"diverged"`
+	CreatedAt  int64  `json:"createdAt"`
+}
+
+type supplyResponse struct {
+	Status string              `json:"status"`
+	Assets []*snapshotResponse `json:"assets"`
+}
+
+// GET: .../supply
+func getSupply(reconciliationService service.Reconciliation) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snapshots, err := reconciliationService.GetLatest()
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		result := &supplyResponse{
+			Status: StatusOK,
+			Assets: toResponse(snapshots),
+		}
+		for _, snapshot := range snapshots {
+			if snapshot.Diverged {
+				result.Status = StatusDiverged
+			}
+		}
+
+		render.JSON(w, r, result)
+	}
+}
+
+// GET: .../supply/{chainId}/{asset}?limit=
+func getHistory(reconciliationService service.Reconciliation) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		chainId, err := strconv.ParseUint(chi.URLParam(r, "chainId"), 10, 64)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+			return
+		}
+
+		limit := defaultHistoryLimit
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+				return
+			}
+		}
+
+		snapshots, err := reconciliationService.GetHistory(chainId, chi.URLParam(r, "asset"), limit)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		render.JSON(w, r, toResponse(snapshots))
+	}
+}
+
+func toResponse(snapshots []*entity.SupplySnapshot) []*snapshotResponse {
+	result := make([]*snapshotResponse, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		result = append(result, &snapshotResponse{
+			ChainID:    snapshot.ChainID,
+			Asset:      snapshot.Asset,
+			Locked:     snapshot.Locked,
+			Supply:     snapshot.Supply,
+			InFlight:   snapshot.InFlight,
+			Difference: snapshot.Difference,
+			Diverged:   snapshot.Diverged,
+			CreatedAt:  snapshot.CreatedAt,
+		})
+	}
+	return result
+}
+
+// NewRouter creates the router for the supply reconciliation status of the native assets
+func NewRouter(service service.Reconciliation) chi.Router {
+	r := chi.NewRouter()
+	r.Get("/", getSupply(service))
+	r.Get("/{chainId}/{asset}", getHistory(service))
+	return r
+}
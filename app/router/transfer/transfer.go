@@ -1,20 +1,69 @@
 package transfer
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 var (
 	Route  = "/transfers"
 	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+	// keepAliveInterval is the interval at which comments are sent to idle event streams
+	keepAliveInterval = 15 * time.Second
 )
 
+// GET: .../transfers
+func getTransfers(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		filter, err := parseFilter(query)
+		if err != nil {
+			logger.Debugf("Invalid transfers filter [%s]. Error [%s].", query.Encode(), err)
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+			return
+		}
+
+		limit := 0
+		if value := query.Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorBadRequest))
+				return
+			}
+		}
+
+		page, err := transfersService.TransfersPage(*filter, query.Get("cursor"), limit)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			switch err {
+			case service.ErrInvalidCursor:
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(err))
+			default:
+				render.Status(r, http.StatusInternalServerError)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			}
+
+			return
+		}
+
+		render.JSON(w, r, page)
+	}
+}
+
 // GET: .../transfers/:id
 func getTransfer(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -39,8 +88,128 @@ func getTransfer(transfersService service.Transfers) func(w http.ResponseWriter,
 	}
 }
 
-func NewRouter(service service.Transfers) chi.Router {
+// GET: .../transfers/:id/timeline
+func getTransferTimeline(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		transferID := chi.URLParam(r, "id")
+
+		timeline, err := transfersService.TransferTimeline(transferID)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			switch err {
+			case service.ErrNotFound:
+				render.Status(r, http.StatusNotFound)
+				render.JSON(w, r, response.ErrorResponse(err))
+			default:
+				render.Status(r, http.StatusInternalServerError)
+				render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			}
+
+			return
+		}
+
+		render.JSON(w, r, timeline)
+	}
+}
+
+// GET: .../transfers/:id/events
+func streamTransferEvents(transferEvents service.TransferEvents) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			logger.Errorf("Streaming is not supported by the response writer.")
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		transferID := chi.URLParam(r, "id")
+		events, unsubscribe := transferEvents.Subscribe(transferID)
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(keepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				data, err := json.Marshal(event)
+				if err != nil {
+					logger.Errorf("[%s] - Failed to marshal [%s] event. Error [%s].", transferID, event.Type, err)
+					continue
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// parseFilter reads the transfers filter from the query parameters
+func parseFilter(query url.Values) (*transfer.Filter, error) {
+	filter := &transfer.Filter{
+		Asset:    query.Get("asset"),
+		Receiver: query.Get("receiver"),
+		Status:   query.Get("status"),
+	}
+
+	if value := query.Get("sourceChainId"); value != "" {
+		sourceChainId, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		filter.SourceChainId = &sourceChainId
+	}
+	if value := query.Get("targetChainId"); value != "" {
+		targetChainId, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		filter.TargetChainId = &targetChainId
+	}
+	if value := query.Get("isNft"); value != "" {
+		isNft, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		filter.IsNft = &isNft
+	}
+	if value := query.Get("from"); value != "" {
+		from, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, err
+		}
+		filter.From = from.UnixNano()
+	}
+	if value := query.Get("to"); value != "" {
+		to, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, err
+		}
+		filter.To = to.UnixNano()
+	}
+
+	return filter, nil
+}
+
+func NewRouter(service service.Transfers, transferEvents service.TransferEvents) chi.Router {
 	r := chi.NewRouter()
+	r.Get("/", getTransfers(service))
 	r.Get("/{id}", getTransfer(service))
+	r.Get("/{id}/timeline", getTransferTimeline(service))
+	r.Get("/{id}/events", streamTransferEvents(transferEvents))
 	return r
 }
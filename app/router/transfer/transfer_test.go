@@ -0,0 +1,135 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func Test_GetTransfers(t *testing.T) {
+	mocks.Setup()
+	sourceChainId := uint64(0)
+	targetChainId := uint64(80001)
+	isNft := false
+	from, _ := time.Parse(time.RFC3339, "2022-01-01T00:00:00Z")
+	filter := model.Filter{
+		SourceChainId: &sourceChainId,
+		TargetChainId: &targetChainId,
+		Asset:         "0.0.2",
+		Receiver:      "0xreceiver",
+		Status:        "COMPLETED",
+		IsNft:         &isNft,
+		From:          from.UnixNano(),
+	}
+	mocks.MTransferService.On("TransfersPage", filter, "some-cursor", 10).Return(&service.TransfersPage{}, nil)
+
+	code := serve("/?sourceChainId=0&targetChainId=80001&asset=0.0.2&receiver=0xreceiver&status=COMPLETED&isNft=false&from=2022-01-01T00:00:00Z&cursor=some-cursor&limit=10")
+
+	assert.Equal(t, http.StatusOK, code)
+	mocks.MTransferService.AssertCalled(t, "TransfersPage", filter, "some-cursor", 10)
+}
+
+func Test_GetTransfers_InvalidQuery(t *testing.T) {
+	mocks.Setup()
+
+	for _, query := range []string{"sourceChainId=a", "targetChainId=-1", "isNft=maybe", "from=yesterday", "to=1", "limit=a"} {
+		assert.Equal(t, http.StatusBadRequest, serve("/?"+query), query)
+	}
+	mocks.MTransferService.AssertNotCalled(t, "TransfersPage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetTransfers_InvalidCursor(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferService.On("TransfersPage", model.Filter{}, "invalid", 0).Return(nil, service.ErrInvalidCursor)
+
+	assert.Equal(t, http.StatusBadRequest, serve("/?cursor="+url.QueryEscape("invalid")))
+}
+
+func Test_GetTransferTimeline(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferService.On("TransferTimeline", "0.0.1-1-1").Return(&service.TransferTimeline{}, nil)
+
+	assert.Equal(t, http.StatusOK, serve("/0.0.1-1-1/timeline"))
+	mocks.MTransferService.AssertCalled(t, "TransferTimeline", "0.0.1-1-1")
+}
+
+func Test_GetTransferTimeline_NotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferService.On("TransferTimeline", "0.0.1-1-1").Return(nil, service.ErrNotFound)
+
+	assert.Equal(t, http.StatusNotFound, serve("/0.0.1-1-1/timeline"))
+}
+
+func Test_GetTransferTimeline_Fails(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferService.On("TransferTimeline", "0.0.1-1-1").Return(nil, errors.New("some-error"))
+
+	assert.Equal(t, http.StatusInternalServerError, serve("/0.0.1-1-1/timeline"))
+}
+
+func Test_StreamTransferEvents(t *testing.T) {
+	mocks.Setup()
+	events := make(chan service.TransferEvent, 1)
+	unsubscribed := false
+	mocks.MTransferEventsService.On("Subscribe", "0.0.1-1-1").Return((<-chan service.TransferEvent)(events), func() { unsubscribed = true })
+	events <- service.TransferEvent{TransferID: "0.0.1-1-1", Type: service.TransferEventSignature, Timestamp: 1, Signer: "0xsigner"}
+	close(events)
+
+	r := httptest.NewRequest(http.MethodGet, "/0.0.1-1-1/events", nil)
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MTransferService, mocks.MTransferEventsService).ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "event: SIGNATURE\ndata: {\"transferId\":\"0.0.1-1-1\",\"type\":\"SIGNATURE\",\"timestamp\":1,\"signer\":\"0xsigner\"}\n\n", w.Body.String())
+	assert.True(t, unsubscribed)
+}
+
+func Test_StreamTransferEvents_ClientDisconnects(t *testing.T) {
+	mocks.Setup()
+	unsubscribed := false
+	mocks.MTransferEventsService.On("Subscribe", "0.0.1-1-1").Return((<-chan service.TransferEvent)(make(chan service.TransferEvent)), func() { unsubscribed = true })
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := httptest.NewRequest(http.MethodGet, "/0.0.1-1-1/events", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MTransferService, mocks.MTransferEventsService).ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.True(t, unsubscribed)
+}
+
+func serve(target string) int {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	w := httptest.NewRecorder()
+	NewRouter(mocks.MTransferService, mocks.MTransferEventsService).ServeHTTP(w, r)
+
+	return w.Code
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backfill
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"sync"
+)
+
+type Service struct {
+	sources        []service.BackfillSource
+	queue          qi.Queue
+	missingCounter prometheus.Counter
+	// Serializes the backfills, so that a periodic and a requested one never enqueue the same entries twice
+	mutex       sync.Mutex
+	latestMutex sync.RWMutex
+	latest      *service.BackfillReport
+	logger      *log.Entry
+}
+
+// NewService creates the backfill service, which enqueues the missing entries of the given sources to the given queue
+func NewService(sources []service.BackfillSource, q qi.Queue, prometheusService service.Prometheus) *Service {
+	s := &Service{
+		sources: sources,
+		queue:   q,
+		logger:  config.GetLoggerFor("Backfill Service"),
+	}
+
+	if prometheusService.GetIsMonitoringEnabled() {
+		s.missingCounter = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.BackfillMissingName,
+			Help: constants.BackfillMissingHelp,
+		})
+	}
+
+	return s
+}
+
+func (s *Service) Backfill(ctx context.Context, from, to int64, dryRun bool) (*service.BackfillReport, error) {
+	if from <= 0 || from >= to {
+		return nil, service.ErrInvalidRange
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.logger.Infof("Backfilling from [%s] to [%s].", timestamp.ToHumanReadable(from), timestamp.ToHumanReadable(to))
+
+	report := &service.BackfillReport{
+		From:    from,
+		To:      to,
+		DryRun:  dryRun,
+		Results: make([]service.BackfillResult, 0, len(s.sources)),
+	}
+	for _, source := range s.sources {
+		report.Results = append(report.Results, s.backfill(ctx, source, from, to, dryRun))
+	}
+
+	s.latestMutex.Lock()
+	s.latest = report
+	s.latestMutex.Unlock()
+
+	return report, nil
+}
+
+func (s *Service) backfill(ctx context.Context, source service.BackfillSource, from, to int64, dryRun bool) service.BackfillResult {
+	// The missing entries are collected in a batch, which is pushed to the queue unless in dry run
+	batch := &queue.Batch{}
+
+	result := service.BackfillResult{Source: source.Name(), Missing: []string{}}
+	scanned, missing, err := source.Backfill(ctx, from, to, batch)
+	result.Scanned = scanned
+	if missing != nil {
+		result.Missing = missing
+	}
+	if s.missingCounter != nil {
+		s.missingCounter.Add(float64(len(missing)))
+	}
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to backfill. Error: [%s]", source.Name(), err)
+		result.Error = err.Error()
+		return result
+	}
+
+	if len(missing) == 0 {
+		s.logger.Infof("[%s] - Scanned [%d] entries, none missing.", source.Name(), scanned)
+		return result
+	}
+
+	if dryRun {
+		s.logger.Warnf("[%s] - Scanned [%d] entries, [%d] missing: %v. Dry run, not enqueued.", source.Name(), scanned, len(missing), missing)
+		return result
+	}
+
+	for _, message := range batch.Messages() {
+		err = s.queue.Push(ctx, message)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to enqueue missing entries. Error: [%s]", source.Name(), err)
+			result.Error = err.Error()
+			return result
+		}
+	}
+	s.logger.Warnf("[%s] - Scanned [%d] entries, [%d] missing and enqueued: %v.", source.Name(), scanned, len(missing), missing)
+
+	return result
+}
+
+func (s *Service) GetLatest() *service.BackfillReport {
+	s.latestMutex.RLock()
+	defer s.latestMutex.RUnlock()
+	return s.latest
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backfill
+
+import (
+	"context"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+// source pushes a message for every missing entry
+type source struct {
+	name    string
+	scanned int
+	missing []string
+	err     error
+}
+
+func (s source) Name() string {
+	return s.name
+}
+
+func (s source) Backfill(ctx context.Context, from, to int64, q qi.Pusher) (int, []string, error) {
+	for _, id := range s.missing {
+		q.Push(&queue.Message{Payload: id, Topic: "topic"})
+	}
+	return s.scanned, s.missing, s.err
+}
+
+func setup(sources ...service.BackfillSource) *Service {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+	return NewService(sources, mocks.MQueue, mocks.MPrometheusService)
+}
+
+func Test_Backfill(t *testing.T) {
+	s := setup(
+		source{name: "0.0.1", scanned: 3, missing: []string{"0.0.5-1-2"}},
+		source{name: "0.0.2", scanned: 2})
+
+	report, err := s.Backfill(context.Background(), 1, 2, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &service.BackfillReport{
+		From: 1,
+		To:   2,
+		Results: []service.BackfillResult{
+			{Source: "0.0.1", Scanned: 3, Missing: []string{"0.0.5-1-2"}},
+			{Source: "0.0.2", Scanned: 2, Missing: []string{}},
+		},
+	}, report)
+	assert.Equal(t, report, s.GetLatest())
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: "0.0.5-1-2", Topic: "topic"})
+}
+
+func Test_Backfill_DryRun(t *testing.T) {
+	s := setup(source{name: "0.0.1", scanned: 3, missing: []string{"0.0.5-1-2"}})
+
+	report, err := s.Backfill(context.Background(), 1, 2, true)
+
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []string{"0.0.5-1-2"}, report.Results[0].Missing)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Backfill_SourceFails(t *testing.T) {
+	s := setup(
+		source{name: "0.0.1", err: errors.New("some-error")},
+		source{name: "0.0.2", scanned: 1, missing: []string{"1633633534.108746000"}})
+
+	report, err := s.Backfill(context.Background(), 1, 2, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "some-error", report.Results[0].Error)
+	assert.Equal(t, []string{"1633633534.108746000"}, report.Results[1].Missing)
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+}
+
+func Test_Backfill_PushFails(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MQueue.On("Push", mock.Anything).Return(context.Canceled)
+	s := NewService([]service.BackfillSource{source{name: "0.0.1", scanned: 1, missing: []string{"0.0.5-1-2"}}}, mocks.MQueue, mocks.MPrometheusService)
+
+	report, err := s.Backfill(context.Background(), 1, 2, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, context.Canceled.Error(), report.Results[0].Error)
+}
+
+func Test_Backfill_InvalidRange(t *testing.T) {
+	s := setup(source{name: "0.0.1"})
+
+	report, err := s.Backfill(context.Background(), 2, 2, false)
+
+	assert.Equal(t, service.ErrInvalidRange, err)
+	assert.Nil(t, report)
+	assert.Nil(t, s.GetLatest())
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bridge_config
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	log "github.com/sirupsen/logrus"
+	"sync"
+)
+
+type Service struct {
+	mutex  sync.Mutex
+	active parser.Bridge
+	assets config.Assets
+	logger *log.Entry
+}
+
+// NewService creates the service holding the given active bridge configuration. The assets are expected
+// to be loaded from the same configuration and are reloaded whenever a new configuration is applied
+func NewService(active parser.Bridge, assets config.Assets) *Service {
+	return &Service{
+		active: active,
+		assets: assets,
+		logger: config.GetLoggerFor("Bridge Config Service"),
+	}
+}
+
+// Active returns the active bridge configuration and its version
+func (s *Service) Active() (parser.Bridge, string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.active, s.assets.Version()
+}
+
+// Apply validates the given bridge configuration and atomically replaces the
+// asset mapping, fees and min amounts with the ones of the configuration
+func (s *Service) Apply(bridge parser.Bridge) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if problems := config.ValidateReload(s.active, bridge); len(problems) > 0 {
+		err := &service.InvalidBridgeConfigError{Problems: problems}
+		s.logger.Errorf("Rejected bridge configuration. Error: [%s].", err)
+		return err
+	}
+
+	previous := s.assets.Version()
+	s.assets.Reload(bridge.Networks)
+	s.active = bridge
+
+	if version := s.assets.Version(); version != previous {
+		s.logger.Infof("Applied bridge configuration version [%s], replacing version [%s].", version, previous)
+	}
+	return nil
+}
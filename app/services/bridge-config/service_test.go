@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bridge_config
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const wrappedToken = "0x0000000000000000000000000000000000000001"
+
+func bridge(feePercentage int64) parser.Bridge {
+	return parser.Bridge{
+		TopicId: "0.0.2001",
+		Networks: map[uint64]*parser.Network{
+			0: {
+				Name:          "Hedera",
+				BridgeAccount: "0.0.3001",
+				Tokens: parser.Tokens{
+					Fungible: map[string]parser.Token{
+						"HBAR": {FeePercentage: feePercentage, Networks: map[uint64]string{80001: wrappedToken}},
+					},
+				},
+			},
+			80001: {
+				Name:                  "Mumbai",
+				RouterContractAddress: "0x0000000000000000000000000000000000000002",
+			},
+		},
+	}
+}
+
+func Test_Apply(t *testing.T) {
+	active := bridge(10000)
+	assets := config.LoadAssets(active.Networks)
+	s := NewService(active, assets)
+	_, previousVersion := s.Active()
+
+	err := s.Apply(bridge(20000))
+
+	assert.Nil(t, err)
+	applied, version := s.Active()
+	assert.Equal(t, bridge(20000), applied)
+	assert.NotEqual(t, previousVersion, version)
+	assert.Equal(t, version, assets.Version())
+	assert.Equal(t, int64(20000), assets.FeePercentage("HBAR"))
+}
+
+func Test_Apply_Rejected(t *testing.T) {
+	active := bridge(10000)
+	assets := config.LoadAssets(active.Networks)
+	s := NewService(active, assets)
+	_, previousVersion := s.Active()
+	updated := bridge(20000)
+	updated.TopicId = "0.0.2002"
+
+	err := s.Apply(updated)
+
+	var invalid *service.InvalidBridgeConfigError
+	assert.True(t, errors.As(err, &invalid))
+	assert.Len(t, invalid.Problems, 1)
+	applied, version := s.Active()
+	assert.Equal(t, active, applied)
+	assert.Equal(t, previousVersion, version)
+	assert.Equal(t, int64(10000), assets.FeePercentage("HBAR"))
+}
@@ -17,6 +17,7 @@
 package burn_event
 
 import (
+	"context"
 	"database/sql"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -44,6 +45,7 @@ type Service struct {
 	feeService         service.Fee
 	scheduledService   service.Scheduled
 	transferService    service.Transfers
+	limitsService      service.Limits
 	logger             *log.Entry
 	prometheusService  service.Prometheus
 }
@@ -57,6 +59,7 @@ func NewService(
 	scheduled service.Scheduled,
 	feeService service.Fee,
 	transferService service.Transfers,
+	limitsService service.Limits,
 	prometheusService service.Prometheus) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
@@ -73,47 +76,53 @@ func NewService(
 		feeService:         feeService,
 		scheduledService:   scheduled,
 		transferService:    transferService,
+		limitsService:      limitsService,
 		prometheusService:  prometheusService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
 }
 
-func (s Service) ProcessEvent(event transfer.Transfer) {
+func (s Service) ProcessEvent(ctx context.Context, event transfer.Transfer) error {
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.TargetAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
-		return
+		return err
 	}
 
 	receiver, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
 	}
 
 	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	held, err := s.limitsService.Hold(ctx, event, constants.HederaFeeTransfer)
+	if err != nil || held {
+		return err
 	}
 
 	fee, splitTransfers, err := s.prepareTransfers(event.NativeAsset, amount, receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to prepare transfers. Error [%s].", event.TransactionId, err)
-		return
+		return err
 	}
 
 	err = s.repository.UpdateFee(event.TransactionId, strconv.FormatInt(fee, 10))
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to update fee [%d]. Error [%s].", event.TransactionId, fee, err)
-		return
+		return err
 	}
 
 	var (
@@ -138,10 +147,12 @@ func (s Service) ProcessEvent(event transfer.Transfer) {
 			userOutParams,
 		)
 
-		s.scheduledService.ExecuteScheduledTransferTransaction(event.TransactionId, event.NativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+		s.scheduledService.ExecuteScheduledTransferTransaction(ctx, event.TransactionId, event.NativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	}
 
 	s.startAwaitingFunctionsForMetrics(event, feeOutParams, userOutParams)
+
+	return nil
 }
 
 func (s Service) startAwaitingFunctionsForMetrics(event transfer.Transfer, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) {
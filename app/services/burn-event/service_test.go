@@ -17,6 +17,7 @@
 package burn_event
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -26,8 +27,10 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"strconv"
 	"testing"
 )
@@ -97,13 +100,15 @@ func Test_ProcessEvent(t *testing.T) {
 	}
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.HederaFeeTransfer).Return(false, nil)
 	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
 	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return([]transfer.Hedera{}, nil)
 	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, strconv.FormatInt(mockValidFee, 10)).Return(nil)
 	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation).Return()
 
-	s.ProcessEvent(tr)
+	err := s.ProcessEvent(context.Background(), tr)
+	assert.Nil(t, err)
 }
 
 func Test_ProcessEventCreateFail(t *testing.T) {
@@ -129,7 +134,21 @@ func Test_ProcessEventCreateFail(t *testing.T) {
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", mockValidFee)
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
 
-	s.ProcessEvent(tr)
+	err := s.ProcessEvent(context.Background(), tr)
+	assert.Equal(t, errors.New("invalid-result"), err)
+}
+
+func Test_ProcessEventHeldForReview(t *testing.T) {
+	setup()
+
+	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.HederaFeeTransfer).Return(true, nil)
+
+	err := s.ProcessEvent(context.Background(), tr)
+
+	assert.Nil(t, err)
+	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", tr.NativeAsset, burnEventAmount)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
@@ -150,12 +169,14 @@ func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
 	}
 
 	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
+	mocks.MLimitsService.On("Hold", tr, constants.HederaFeeTransfer).Return(false, nil)
 	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
 	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return(nil, errors.New("invalid-result"))
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
 
-	s.ProcessEvent(tr)
+	err := s.ProcessEvent(context.Background(), tr)
+	assert.Equal(t, errors.New("invalid-result"), err)
 }
 
 func Test_New(t *testing.T) {
@@ -168,6 +189,7 @@ func Test_New(t *testing.T) {
 		mocks.MScheduledService,
 		mocks.MFeeService,
 		mocks.MTransferService,
+		mocks.MLimitsService,
 		mocks.MPrometheusService)
 	assert.Equal(t, s, actualService)
 }
@@ -487,6 +509,7 @@ func setup() {
 		feeService:         mocks.MFeeService,
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
+		limitsService:      mocks.MLimitsService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/werc721"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/wtoken"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -78,6 +79,11 @@ func (bsc *Service) HasValidSignaturesLength(signaturesLength *big.Int) (bool, e
 	return bsc.contract.HasValidSignaturesLength(nil, signaturesLength)
 }
 
+// IsHashUsed returns whether a mint or unlock authorised by the given hash is executed
+func (bsc *Service) IsHashUsed(hash [32]byte) (bool, error) {
+	return bsc.contract.HashesUsed(nil, hash)
+}
+
 // ParseMintLog parses a general typed log to a RouterMint event
 func (bsc *Service) ParseMintLog(log types.Log) (*router.RouterMint, error) {
 	return bsc.contract.ParseMint(log)
@@ -103,6 +109,26 @@ func (bsc *Service) ParseBurnERC721Log(log types.Log) (*router.RouterBurnERC721,
 	return bsc.contract.ParseBurnERC721(log)
 }
 
+// ParseLockERC721Log parses a general typed log to a RouterLockERC721 event
+func (bsc *Service) ParseLockERC721Log(log types.Log) (*router.RouterLockERC721, error) {
+	return bsc.contract.ParseLockERC721(log)
+}
+
+// ParseUnlockERC721Log parses a general typed log to a RouterUnlockERC721 event
+func (bsc *Service) ParseUnlockERC721Log(log types.Log) (*router.RouterUnlockERC721, error) {
+	return bsc.contract.ParseUnlockERC721(log)
+}
+
+// ParseLockERC1155Log parses a general typed log to a RouterLockERC1155 event
+func (bsc *Service) ParseLockERC1155Log(log types.Log) (*router.RouterLockERC1155, error) {
+	return bsc.contract.ParseLockERC1155(log)
+}
+
+// ParseUnlockERC1155Log parses a general typed log to a RouterUnlockERC1155 event
+func (bsc *Service) ParseUnlockERC1155Log(log types.Log) (*router.RouterUnlockERC1155, error) {
+	return bsc.contract.ParseUnlockERC1155(log)
+}
+
 // WatchBurnEventLogs creates a subscription for Burn Events emitted in the Bridge contract
 func (bsc *Service) WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *router.RouterBurn) (event.Subscription, error) {
 	return bsc.contract.WatchBurn(opts, sink)
@@ -154,14 +180,9 @@ func NewService(client client.EVM, address string, assets []string) *Service {
 
 	assetsDecimals := make(map[string]uint8)
 	for _, asset := range assets {
-		evmAsset, err := wtoken.NewWtoken(common.HexToAddress(asset), client.GetClient())
+		decimals, err := fetchDecimals(client, asset)
 		if err != nil {
-			log.Fatalf("Could not instantiate wtoken for [%s]. Error [%s].", asset, err)
-		}
-
-		decimals, err := evmAsset.Decimals(nil)
-		if err != nil {
-			log.Fatalf("Could not get asset decimals for [%s]. Error [%s].", asset, err)
+			log.Fatal(err)
 		}
 
 		assetsDecimals[asset] = decimals
@@ -180,8 +201,43 @@ func NewService(client client.EVM, address string, assets []string) *Service {
 	return contractService
 }
 
+// decimals returns the decimals of the given asset. Decimals of assets, which are added
+// to the bridge configuration at runtime, are fetched on first use
+func (bsc *Service) decimals(asset string) (uint8, error) {
+	bsc.mutex.Lock()
+	defer bsc.mutex.Unlock()
+
+	if decimals, ok := bsc.assetsDecimals[asset]; ok {
+		return decimals, nil
+	}
+
+	decimals, err := fetchDecimals(bsc.Client, asset)
+	if err != nil {
+		bsc.logger.Error(err)
+		return 0, err
+	}
+	bsc.assetsDecimals[asset] = decimals
+	return decimals, nil
+}
+
+func fetchDecimals(client client.EVM, asset string) (uint8, error) {
+	evmAsset, err := wtoken.NewWtoken(common.HexToAddress(asset), client.GetClient())
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Could not instantiate wtoken for [%s]. Error [%s].", asset, err))
+	}
+
+	decimals, err := evmAsset.Decimals(nil)
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Could not get asset decimals for [%s]. Error [%s].", asset, err))
+	}
+	return decimals, nil
+}
+
 func (bsc *Service) AddDecimals(amount *big.Int, asset string) (*big.Int, error) {
-	decimals := bsc.assetsDecimals[asset]
+	decimals, err := bsc.decimals(asset)
+	if err != nil {
+		return nil, err
+	}
 
 	adaptation := int(decimals) - 8
 	if decimals > 0 {
@@ -191,7 +247,10 @@ func (bsc *Service) AddDecimals(amount *big.Int, asset string) (*big.Int, error)
 }
 
 func (bsc *Service) RemoveDecimals(amount *big.Int, asset string) (*big.Int, error) {
-	decimals := bsc.assetsDecimals[asset]
+	decimals, err := bsc.decimals(asset)
+	if err != nil {
+		return nil, err
+	}
 
 	adaptation := int(decimals) - 8
 	if decimals > 0 {
@@ -203,3 +262,17 @@ func (bsc *Service) RemoveDecimals(amount *big.Int, asset string) (*big.Int, err
 	}
 	return amount, nil
 }
+
+// TokenURI returns the metadata URI of the given ERC-721 token
+func (bsc *Service) TokenURI(asset string, tokenId *big.Int) (string, error) {
+	erc721, err := werc721.NewWerc721(common.HexToAddress(asset), bsc.Client.GetClient())
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Could not instantiate werc721 for [%s]. Error [%s].", asset, err))
+	}
+
+	tokenURI, err := erc721.TokenURI(nil, tokenId)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Could not get token URI of [%s] for [%s]. Error [%s].", tokenId, asset, err))
+	}
+	return tokenURI, nil
+}
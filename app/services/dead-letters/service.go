@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letters
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repository repository.DeadLetter
+	queue      qi.Queue
+	logger     *log.Entry
+}
+
+func NewService(repository repository.DeadLetter, queue qi.Queue) *Service {
+	return &Service{
+		repository: repository,
+		queue:      queue,
+		logger:     config.GetLoggerFor("Dead Letters Service"),
+	}
+}
+
+func (s *Service) GetAll() ([]*entity.DeadLetter, error) {
+	deadLetters, err := s.repository.GetAll()
+	if err != nil {
+		s.logger.Errorf("Failed to query dead letters. Error: [%s].", err)
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
+
+func (s *Service) Get(id uint64) (*entity.DeadLetter, error) {
+	deadLetter, err := s.repository.Get(id)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to query dead letter. Error: [%s].", id, err)
+		return nil, err
+	}
+
+	if deadLetter == nil {
+		return nil, service.ErrNotFound
+	}
+
+	return deadLetter, nil
+}
+
+func (s *Service) Replay(id uint64) error {
+	deadLetter, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = queue.DecodePayload(deadLetter.PayloadType, deadLetter.Payload)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to decode dead letter payload. Error: [%s].", id, err)
+		return err
+	}
+
+	err = s.queue.Replay(deadLetter)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to replay dead letter. Error: [%s].", id, err)
+		return err
+	}
+
+	s.logger.Infof("[%d] - Replayed dead letter for [%s].", id, deadLetter.Topic)
+	return nil
+}
+
+func (s *Service) Discard(id uint64) error {
+	_, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete(id)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to delete dead letter. Error: [%s].", id, err)
+		return err
+	}
+
+	s.logger.Infof("[%d] - Discarded dead letter.", id)
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dead_letters
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	id          = uint64(7)
+	transferMsg = transfer.New(
+		"0.0.123123-123321-420",
+		0,
+		1,
+		0,
+		"0xreceiver",
+		"0.0.123",
+		"0xwrapped00123",
+		"0.0.123",
+		"100")
+	deadLetter *entity.DeadLetter
+)
+
+func Test_GetAll(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("GetAll").Return([]*entity.DeadLetter{deadLetter}, nil)
+
+	actual, err := s.GetAll()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.DeadLetter{deadLetter}, actual)
+}
+
+func Test_Get(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(deadLetter, nil)
+
+	actual, err := s.Get(id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, deadLetter, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(nil, nil)
+
+	actual, err := s.Get(id)
+
+	assert.Nil(t, actual)
+	assert.Equal(t, service.ErrNotFound, err)
+}
+
+func Test_Replay(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(deadLetter, nil)
+	mocks.MQueue.On("Replay", deadLetter).Return(nil)
+
+	err := s.Replay(id)
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertCalled(t, "Replay", deadLetter)
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Delete", id)
+}
+
+func Test_Replay_Fails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MDeadLetterRepository.On("Get", id).Return(deadLetter, nil)
+	mocks.MQueue.On("Replay", deadLetter).Return(expectedErr)
+
+	err := s.Replay(id)
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Delete", id)
+}
+
+func Test_Replay_NotFound(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(nil, nil)
+
+	err := s.Replay(id)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MQueue.AssertNotCalled(t, "Replay", mock.Anything)
+}
+
+func Test_Replay_UndecodablePayload(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(&entity.DeadLetter{ID: id, PayloadType: "unsupported"}, nil)
+
+	err := s.Replay(id)
+
+	assert.NotNil(t, err)
+	mocks.MQueue.AssertNotCalled(t, "Replay", mock.Anything)
+	mocks.MDeadLetterRepository.AssertNotCalled(t, "Delete", id)
+}
+
+func Test_Discard(t *testing.T) {
+	s := setup()
+	mocks.MDeadLetterRepository.On("Get", id).Return(deadLetter, nil)
+	mocks.MDeadLetterRepository.On("Delete", id).Return(nil)
+
+	err := s.Discard(id)
+
+	assert.Nil(t, err)
+	mocks.MDeadLetterRepository.AssertCalled(t, "Delete", id)
+}
+
+func Test_Discard_DeleteFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MDeadLetterRepository.On("Get", id).Return(deadLetter, nil)
+	mocks.MDeadLetterRepository.On("Delete", id).Return(expectedErr)
+
+	err := s.Discard(id)
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func setup() *Service {
+	mocks.Setup()
+	payloadType, payload, _ := queue.EncodePayload(transferMsg)
+	deadLetter = &entity.DeadLetter{
+		ID:          id,
+		Topic:       constants.HederaTransferMessageSubmission,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Error:       "some-error",
+		Attempts:    5,
+	}
+	return NewService(mocks.MDeadLetterRepository, mocks.MQueue)
+}
@@ -18,32 +18,28 @@ package calculator
 
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
-const MaxPercentage = 100000
-const MinPercentage = 0
+const MaxPercentage = constants.FeeMaxPercentage
+const MinPercentage = constants.FeeMinPercentage
 
 type Service struct {
-	feePercentages map[string]int64
-	logger         *log.Entry
+	assets config.Assets
+	logger *log.Entry
 }
 
-func New(feePercentages map[string]int64) *Service {
-	for token, fee := range feePercentages {
-		if fee < MinPercentage || fee > MaxPercentage {
-			log.Fatalf("[%s] Invalid fee percentage: [%d]", token, fee)
-		}
-	}
-
+// New creates a fee calculator, which uses the fee percentages of the active bridge configuration
+func New(assets config.Assets) *Service {
 	return &Service{
-		feePercentages: feePercentages,
-		logger:         config.GetLoggerFor("Fee Service")}
+		assets: assets,
+		logger: config.GetLoggerFor("Fee Service")}
 }
 
 // CalculateFee calculates the fee and remainder of a given token and amount
 func (s Service) CalculateFee(token string, amount int64) (fee, remainder int64) {
-	fee = amount * s.feePercentages[token] / MaxPercentage
+	fee = amount * s.assets.FeePercentage(token) / MaxPercentage
 	remainder = amount - fee
 
 	totalAmount := remainder + fee
@@ -2,28 +2,35 @@ package calculator
 
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
-var feePercentages = map[string]int64{
-	"hbar":       10000,
-	"0.0.123321": 1213,
-}
+var assets = config.LoadAssets(map[uint64]*parser.Network{
+	0: {
+		Tokens: parser.Tokens{
+			Fungible: map[string]parser.Token{
+				"hbar":       {FeePercentage: 10000},
+				"0.0.123321": {FeePercentage: 1213},
+			},
+		},
+	},
+})
 
 func Test_New(t *testing.T) {
-	newService := New(feePercentages)
+	newService := New(assets)
 
 	expectedService := &Service{
-		feePercentages: feePercentages,
-		logger:         config.GetLoggerFor("Fee Service"),
+		assets: assets,
+		logger: config.GetLoggerFor("Fee Service"),
 	}
 
 	assert.Equal(t, expectedService, newService)
 }
 
 func Test_CalculateFee(t *testing.T) {
-	service := New(feePercentages)
+	service := New(assets)
 
 	fee, remainder := service.CalculateFee("hbar", 20)
 
@@ -33,3 +40,16 @@ func Test_CalculateFee(t *testing.T) {
 	assert.Equal(t, expectedFee, fee)
 	assert.Equal(t, expectedRemainder, remainder)
 }
+
+func Test_CalculateFee_Reloaded(t *testing.T) {
+	reloadable := config.LoadAssets(map[uint64]*parser.Network{})
+	service := New(reloadable)
+
+	reloadable.Reload(map[uint64]*parser.Network{
+		0: {Tokens: parser.Tokens{Fungible: map[string]parser.Token{"hbar": {FeePercentage: 50000}}}},
+	})
+	fee, remainder := service.CalculateFee("hbar", 20)
+
+	assert.Equal(t, int64(10), fee)
+	assert.Equal(t, int64(10), remainder)
+}
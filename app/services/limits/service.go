@@ -0,0 +1,185 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimals"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"time"
+)
+
+type Service struct {
+	transferRepository repository.Transfer
+	reviewRepository   repository.Review
+	assets             config.Assets
+	decimals           *decimals.Cache
+	logger             *log.Entry
+}
+
+func NewService(
+	transferRepository repository.Transfer,
+	reviewRepository repository.Review,
+	assets config.Assets,
+	mirrorNode client.MirrorNode,
+	evmClients map[uint64]client.EVM) *Service {
+	return &Service{
+		transferRepository: transferRepository,
+		reviewRepository:   reviewRepository,
+		assets:             assets,
+		decimals:           decimals.NewCache(mirrorNode, evmClients),
+		logger:             config.GetLoggerFor("Limits Service"),
+	}
+}
+
+func (s *Service) Hold(ctx context.Context, tm transfer.Transfer, topic string) (bool, error) {
+	review, err := s.reviewRepository.Get(tm.TransactionId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to query review. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+	if review != nil {
+		return !review.Approved, nil
+	}
+
+	reason, err := s.exceededLimit(ctx, tm)
+	if err != nil || reason == "" {
+		return false, err
+	}
+
+	payloadType, payload, err := queue.EncodePayload(&tm)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to encode transfer payload. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	err = s.reviewRepository.Create(&entity.Review{
+		TransferID:  tm.TransactionId,
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Reason:      reason,
+		CreatedAt:   time.Now().UnixNano(),
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create review. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	err = s.transferRepository.UpdateStatusPendingReview(tm.TransactionId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update status to pending review. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	s.logger.Warnf("[%s] - Held transfer for review: %s.", tm.TransactionId, reason)
+	return true, nil
+}
+
+// exceededLimit returns the reason, for which the transfer exceeds the limits of its native asset or an empty string if it does not.
+// The limits are in the lowest denomination of the native asset
+func (s *Service) exceededLimit(ctx context.Context, tm transfer.Transfer) (string, error) {
+	nativeAsset := s.assets.FungibleNativeAsset(tm.NativeChainId, tm.NativeAsset)
+	if nativeAsset == nil || (nativeAsset.MaxAmount == nil && nativeAsset.HourlyLimit == nil && nativeAsset.DailyLimit == nil) {
+		return "", nil
+	}
+
+	nativeDecimals, err := s.decimals.Get(ctx, tm.NativeChainId, tm.NativeAsset)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get the decimals of [%s]. Error: [%s].", tm.TransactionId, tm.NativeAsset, err)
+		return "", err
+	}
+
+	amount, err := s.nativeAmount(ctx, tm.TargetChainId, tm.TargetAsset, tm.Amount, nativeDecimals)
+	if err != nil {
+		return "", err
+	}
+	if nativeAsset.MaxAmount != nil && amount.Cmp(nativeAsset.MaxAmount) > 0 {
+		return fmt.Sprintf("amount [%s] exceeds the maximum amount [%s]", amount, nativeAsset.MaxAmount), nil
+	}
+
+	now := time.Now()
+	windows := []struct {
+		period time.Duration
+		limit  *big.Int
+	}{
+		{time.Hour, nativeAsset.HourlyLimit},
+		{24 * time.Hour, nativeAsset.DailyLimit},
+	}
+	for _, window := range windows {
+		if window.limit == nil {
+			continue
+		}
+
+		outflow, err := s.outflowSince(ctx, tm, nativeDecimals, now.Add(-window.period))
+		if err != nil {
+			return "", err
+		}
+		if outflow.Cmp(window.limit) > 0 {
+			return fmt.Sprintf("outflow [%s] within the last [%s] exceeds the limit [%s]", outflow, window.period, window.limit), nil
+		}
+	}
+
+	return "", nil
+}
+
+// outflowSince returns the total amount of the native asset of the transfer, transferred to any network since the given time,
+// in the given decimals of the native asset. The transfer is recorded beforehand, so its amount is included
+func (s *Service) outflowSince(ctx context.Context, tm transfer.Transfer, nativeDecimals uint8, since time.Time) (*big.Int, error) {
+	transfers, err := s.transferRepository.GetOutflowSince(tm.NativeChainId, tm.NativeAsset, since.UnixNano())
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to query the outflow of [%s]. Error: [%s].", tm.TransactionId, tm.NativeAsset, err)
+		return nil, err
+	}
+
+	outflow := big.NewInt(0)
+	for _, t := range transfers {
+		amount, err := s.nativeAmount(ctx, t.TargetChainID, t.TargetAsset, t.Amount, nativeDecimals)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to convert the amount of [%s]. Error: [%s].", tm.TransactionId, t.TransactionID, err)
+			return nil, err
+		}
+		outflow.Add(outflow, amount)
+	}
+
+	return outflow, nil
+}
+
+// nativeAmount converts the amount of a transfer from the decimals of its target asset to the given decimals of the native asset
+func (s *Service) nativeAmount(ctx context.Context, targetChainId uint64, targetAsset, amount string, nativeDecimals uint8) (*big.Int, error) {
+	value, err := big_numbers.ToBigInt(amount)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid amount [%s] of [%s]", amount, targetAsset))
+	}
+
+	targetDecimals, err := s.decimals.Get(ctx, targetChainId, targetAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	return decimals.Scale(value, targetDecimals, nativeDecimals), nil
+}
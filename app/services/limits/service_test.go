@@ -0,0 +1,267 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimals"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+)
+
+var (
+	limitedToken      = "0.0.1001"
+	unlimitedToken    = "0.0.1002"
+	wrappedToken      = "0x0000000000000000000000000000000000000001"
+	otherWrappedToken = "0x0000000000000000000000000000000000000003"
+	decimalsData      = hexutil.MustDecode("0x313ce567")
+	assets            = config.LoadAssets(map[uint64]*parser.Network{
+		0: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					limitedToken: {
+						MaxAmount:   "1000",
+						HourlyLimit: "1500",
+						DailyLimit:  "3000",
+						Networks:    map[uint64]string{80001: wrappedToken, 80002: otherWrappedToken},
+					},
+					unlimitedToken: {Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000002"}},
+				},
+			},
+		},
+	})
+	tm = transfer.Transfer{
+		TransactionId: "0.0.123123-123321-420",
+		SourceChainId: 0,
+		TargetChainId: 80001,
+		NativeChainId: 0,
+		SourceAsset:   limitedToken,
+		TargetAsset:   wrappedToken,
+		NativeAsset:   limitedToken,
+		Receiver:      "0xreceiver",
+		Amount:        "500",
+	}
+)
+
+func Test_New(t *testing.T) {
+	mocks.Setup()
+
+	expected := &Service{
+		transferRepository: mocks.MTransferRepository,
+		reviewRepository:   mocks.MReviewRepository,
+		assets:             assets,
+		decimals:           decimals.NewCache(mocks.MHederaMirrorClient, evmClients()),
+		logger:             config.GetLoggerFor("Limits Service"),
+	}
+
+	assert.Equal(t, expected, NewService(mocks.MTransferRepository, mocks.MReviewRepository, assets, mocks.MHederaMirrorClient, evmClients()))
+}
+
+func Test_Hold_WithinLimits(t *testing.T) {
+	s := setup()
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return(outflow("500", "1000"), nil)
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.False(t, held)
+	mocks.MReviewRepository.AssertNotCalled(t, "Create", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusPendingReview", tm.TransactionId)
+}
+
+func Test_Hold_ExceedsMaxAmount(t *testing.T) {
+	s := setup()
+	overMax := tm
+	overMax.Amount = "1001"
+	payloadType, payload, _ := queue.EncodePayload(&overMax)
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", tm.TransactionId).Return(nil)
+
+	held, err := s.Hold(context.Background(), overMax, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.True(t, held)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetOutflowSince", mock.Anything, mock.Anything, mock.Anything)
+	review := mocks.MReviewRepository.Calls[1].Arguments.Get(0).(*entity.Review)
+	assert.Equal(t, tm.TransactionId, review.TransferID)
+	assert.Equal(t, constants.HederaTransferMessageSubmission, review.Topic)
+	assert.Equal(t, payloadType, review.PayloadType)
+	assert.Equal(t, payload, review.Payload)
+	assert.Equal(t, "amount [1001] exceeds the maximum amount [1000]", review.Reason)
+}
+
+func Test_Hold_ExceedsHourlyLimit(t *testing.T) {
+	s := setup()
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return(outflow("500", "1001"), nil)
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", tm.TransactionId).Return(nil)
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.True(t, held)
+	review := mocks.MReviewRepository.Calls[1].Arguments.Get(0).(*entity.Review)
+	assert.Equal(t, "outflow [1501] within the last [1h0m0s] exceeds the limit [1500]", review.Reason)
+}
+
+func Test_Hold_ExceedsDailyLimit(t *testing.T) {
+	s := setup()
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return(outflow("500"), nil).Once()
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return(outflow("500", "1000", "1000", "1000"), nil).Once()
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", tm.TransactionId).Return(nil)
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.True(t, held)
+	review := mocks.MReviewRepository.Calls[1].Arguments.Get(0).(*entity.Review)
+	assert.Equal(t, "outflow [3500] within the last [24h0m0s] exceeds the limit [3000]", review.Reason)
+}
+
+func Test_Hold_Unlimited(t *testing.T) {
+	s := setup()
+	unlimited := tm
+	unlimited.NativeAsset = unlimitedToken
+	unlimited.Amount = "1000000"
+
+	held, err := s.Hold(context.Background(), unlimited, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.False(t, held)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetOutflowSince", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Hold_Approved(t *testing.T) {
+	mocks.Setup()
+	s := NewService(mocks.MTransferRepository, mocks.MReviewRepository, assets, mocks.MHederaMirrorClient, evmClients())
+	mocks.MReviewRepository.On("Get", tm.TransactionId).Return(&entity.Review{TransferID: tm.TransactionId, Approved: true}, nil)
+	overMax := tm
+	overMax.Amount = "1001"
+
+	held, err := s.Hold(context.Background(), overMax, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.False(t, held)
+	mocks.MReviewRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Hold_QueryFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return(nil, expectedErr)
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, held)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusPendingReview", tm.TransactionId)
+}
+
+func Test_Hold_AggregatesTargetChains(t *testing.T) {
+	s := setup()
+	// 500 and 1001 in the decimals of the native asset, transferred to networks with 8 and 18 decimals
+	mocks.MTransferRepository.On("GetOutflowSince", tm.NativeChainId, tm.NativeAsset, mock.Anything).Return([]*entity.Transfer{
+		{TransactionID: tm.TransactionId, TargetChainID: 80001, TargetAsset: wrappedToken, Amount: "500"},
+		{TransactionID: "0.0.123123-123321-421", TargetChainID: 80002, TargetAsset: otherWrappedToken, Amount: "10010000000000"},
+	}, nil)
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", tm.TransactionId).Return(nil)
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.True(t, held)
+	review := mocks.MReviewRepository.Calls[1].Arguments.Get(0).(*entity.Review)
+	assert.Equal(t, "outflow [1501] within the last [1h0m0s] exceeds the limit [1500]", review.Reason)
+}
+
+func Test_Hold_ConvertsAmountToNativeDecimals(t *testing.T) {
+	s := setup()
+	overMax := tm
+	overMax.TargetChainId = 80002
+	overMax.TargetAsset = otherWrappedToken
+	overMax.Amount = "10010000000000"
+	mocks.MReviewRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusPendingReview", tm.TransactionId).Return(nil)
+
+	held, err := s.Hold(context.Background(), overMax, constants.HederaTransferMessageSubmission)
+
+	assert.Nil(t, err)
+	assert.True(t, held)
+	review := mocks.MReviewRepository.Calls[1].Arguments.Get(0).(*entity.Review)
+	assert.Equal(t, "amount [1001] exceeds the maximum amount [1000]", review.Reason)
+}
+
+func Test_Hold_DecimalsFail(t *testing.T) {
+	mocks.Setup()
+	mocks.MReviewRepository.On("Get", tm.TransactionId).Return(nil, nil)
+	expectedErr := errors.New("some-error")
+	mocks.MHederaMirrorClient.On("GetToken", limitedToken).Return((*model.TokenResponse)(nil), expectedErr)
+	s := NewService(mocks.MTransferRepository, mocks.MReviewRepository, assets, mocks.MHederaMirrorClient, evmClients())
+
+	held, err := s.Hold(context.Background(), tm, constants.HederaTransferMessageSubmission)
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, held)
+	mocks.MReviewRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func setup() *Service {
+	mocks.Setup()
+	mocks.MReviewRepository.On("Get", tm.TransactionId).Return(nil, nil)
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MHederaMirrorClient.On("GetToken", limitedToken).Return(&model.TokenResponse{Decimals: "8"}, nil)
+	mockDecimals(wrappedToken, 8)
+	mockDecimals(otherWrappedToken, 18)
+	return NewService(mocks.MTransferRepository, mocks.MReviewRepository, assets, mocks.MHederaMirrorClient, evmClients())
+}
+
+func evmClients() map[uint64]client.EVM {
+	return map[uint64]client.EVM{80001: mocks.MEVMClient, 80002: mocks.MEVMClient}
+}
+
+// outflow returns transfers of the given amounts to the wrapped token, which has the decimals of the native asset
+func outflow(amounts ...string) []*entity.Transfer {
+	var transfers []*entity.Transfer
+	for _, amount := range amounts {
+		transfers = append(transfers, &entity.Transfer{TargetChainID: 80001, TargetAsset: wrappedToken, Amount: amount})
+	}
+	return transfers
+}
+
+func mockDecimals(contract string, decimals int64) {
+	mocks.MEVMCoreClient.On("CallContract", mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+		return *call.To == common.HexToAddress(contract) && bytes.HasPrefix(call.Data, decimalsData)
+	}), mock.Anything).Return(common.LeftPadBytes(big.NewInt(decimals).Bytes(), 32), nil)
+}
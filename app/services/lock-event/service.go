@@ -17,8 +17,12 @@
 package lock_event
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
@@ -35,20 +39,24 @@ import (
 
 type Service struct {
 	bridgeAccount      hedera.AccountID
+	mirrorNode         client.MirrorNode
 	repository         repository.Transfer
 	scheduleRepository repository.Schedule
 	transferService    service.Transfers
 	scheduledService   service.Scheduled
+	limitsService      service.Limits
 	prometheusService  service.Prometheus
 	logger             *log.Entry
 }
 
 func NewService(
 	bridgeAccount string,
+	mirrorNode client.MirrorNode,
 	repository repository.Transfer,
 	scheduleRepository repository.Schedule,
 	scheduled service.Scheduled,
 	transferService service.Transfers,
+	limitsService service.Limits,
 	prometheusService service.Prometheus) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
@@ -58,32 +66,40 @@ func NewService(
 
 	return &Service{
 		bridgeAccount:      bridgeAcc,
+		mirrorNode:         mirrorNode,
 		repository:         repository,
 		scheduleRepository: scheduleRepository,
 		scheduledService:   scheduled,
 		transferService:    transferService,
+		limitsService:      limitsService,
 		prometheusService:  prometheusService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
 }
 
-func (s *Service) ProcessEvent(event transfer.Transfer) {
+func (s *Service) ProcessEvent(ctx context.Context, event transfer.Transfer) error {
 	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.SourceAsset)
 
 	amount, err := strconv.ParseInt(event.Amount, 10, 64)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
+		return err
 	}
 
 	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
+	}
+
+	held, err := s.limitsService.Hold(ctx, event, constants.HederaMintHtsTransfer)
+	if err != nil || held {
+		return err
 	}
 
 	status := make(chan string)
@@ -92,6 +108,7 @@ func (s *Service) ProcessEvent(event transfer.Transfer) {
 	onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.MINT, &status, false)
 
 	s.scheduledService.ExecuteScheduledMintTransaction(
+		ctx,
 		event.TransactionId,
 		event.TargetAsset,
 		amount,
@@ -103,22 +120,14 @@ func (s *Service) ProcessEvent(event transfer.Transfer) {
 	)
 
 	// TODO: Figure out Unit Testing on this one
-	s.logger.Debugf("[%s] - Waiting for Mint Transaction Execution.", event.TransactionId)
-statusBlocker:
-	for {
-		switch <-status {
-		case syncHelper.DONE:
-			s.logger.Debugf("[%s] - Proceeding to submit the Scheduled Transfer Transaction.", event.TransactionId)
-			break statusBlocker
-		case syncHelper.FAIL:
-			s.logger.Errorf("[%s] - Failed to await the execution of Scheduled Mint Transaction.", event.TransactionId)
-			return
-		}
+	err = s.awaitScheduled(ctx, event.TransactionId, schedule.MINT, &status)
+	if err != nil {
+		return err
 	}
 	accountID, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
 	}
 
 	transfers := []transfer.Hedera{
@@ -136,6 +145,7 @@ statusBlocker:
 	onTransferSuccess, onTransferFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.TRANSFER)
 
 	s.scheduledService.ExecuteScheduledTransferTransaction(
+		ctx,
 		event.TransactionId,
 		event.TargetAsset,
 		transfers,
@@ -144,6 +154,205 @@ statusBlocker:
 		onTransferSuccess,
 		onTransferFail,
 	)
+
+	return nil
+}
+
+// ProcessNftEvent processes the ERC-721 lock event by submitting a Scheduled NFT Mint transaction
+// with the metadata of the locked token and a Scheduled NFT Transfer of the minted serial number to the receiver.
+// Awaiting the mint stops once the given context is done.
+func (s *Service) ProcessNftEvent(ctx context.Context, event transfer.Transfer) error {
+	receiver, err := hedera.AccountIDFromString(event.Receiver)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
+		return err
+	}
+
+	tokenID, err := hedera.TokenIDFromString(event.TargetAsset)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to parse token [%s]. Error: [%s].", event.TransactionId, event.TargetAsset, err)
+		return err
+	}
+
+	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
+	if err != nil {
+		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	status := make(chan string)
+
+	mintTransactionID := ""
+	onTokenMintSuccess, onTokenMintFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.MINT)
+	onNftMintSuccess := func(transactionID string) {
+		mintTransactionID = transactionID
+		onTokenMintSuccess(transactionID)
+	}
+	onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.MINT, &status, false)
+
+	s.scheduledService.ExecuteScheduledNftMintTransaction(
+		ctx,
+		event.TransactionId,
+		event.TargetAsset,
+		[]byte(event.Metadata),
+		&status,
+		onExecutionMintSuccess,
+		onExecutionMintFail,
+		onNftMintSuccess,
+		onTokenMintFail,
+	)
+
+	err = s.awaitScheduled(ctx, event.TransactionId, schedule.MINT, &status)
+	if err != nil {
+		return err
+	}
+
+	mintTransaction, err := s.mirrorNode.GetSuccessfulTransaction(ctx, mintTransactionID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get NFT mint transaction [%s]. Error: [%s].", event.TransactionId, mintTransactionID, err)
+		return err
+	}
+	serialNum, err := mintTransaction.GetMintedNftSerialNumber(event.TargetAsset)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get the serial number minted in [%s]. Error: [%s].", event.TransactionId, mintTransactionID, err)
+		return err
+	}
+
+	err = s.repository.UpdateWrappedSerialNumber(event.TransactionId, serialNum)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update wrapped serial number [%d]. Error: [%s].", event.TransactionId, serialNum, err)
+		return err
+	}
+
+	onExecutionTransferSuccess, onExecutionTransferFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.TRANSFER, &status, true)
+	onTransferSuccess, onTransferFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.TRANSFER)
+
+	s.scheduledService.ExecuteScheduledNftTransferTransaction(
+		ctx,
+		event.TransactionId,
+		hedera.NftID{TokenID: tokenID, SerialNumber: serialNum},
+		s.bridgeAccount,
+		receiver,
+		onExecutionTransferSuccess,
+		onExecutionTransferFail,
+		onTransferSuccess,
+		onTransferFail,
+	)
+
+	return nil
+}
+
+// ProcessErc1155Event processes the ERC-1155 lock event. For every token ID of the batch it submits
+// a Scheduled Token Mint of the wrapping HTS token and a Scheduled Transfer of the minted amount to the receiver.
+// The items are processed one at a time and awaiting stops once the given context is done.
+func (s *Service) ProcessErc1155Event(ctx context.Context, event transfer.Transfer) error {
+	receiver, err := hedera.AccountIDFromString(event.Receiver)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
+		return err
+	}
+
+	amounts := make([]int64, len(event.Erc1155Items))
+	for i, item := range event.Erc1155Items {
+		amounts[i], err = strconv.ParseInt(item.Amount, 10, 64)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to parse amount [%s] of token ID [%d]. Error [%s].", event.TransactionId, item.Amount, item.TokenId, err)
+			return err
+		}
+	}
+
+	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
+	if err != nil {
+		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	status := make(chan string)
+
+	for i, item := range event.Erc1155Items {
+		onTokenMintSuccess, onTokenMintFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.MINT)
+		onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.MINT, &status, false)
+
+		s.scheduledService.ExecuteScheduledMintTransaction(
+			ctx,
+			event.TransactionId,
+			item.WrappedAsset,
+			amounts[i],
+			&status,
+			onExecutionMintSuccess,
+			onExecutionMintFail,
+			onTokenMintSuccess,
+			onTokenMintFail,
+		)
+
+		err = s.awaitScheduled(ctx, event.TransactionId, schedule.MINT, &status)
+		if err != nil {
+			return err
+		}
+
+		transfers := []transfer.Hedera{
+			{
+				AccountID: receiver,
+				Amount:    amounts[i],
+			},
+			{
+				AccountID: s.bridgeAccount,
+				Amount:    -amounts[i],
+			},
+		}
+
+		onExecutionTransferSuccess, onExecutionTransferFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.TRANSFER, &status, true)
+		onTransferSuccess, onTransferFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.TRANSFER)
+
+		s.scheduledService.ExecuteScheduledTransferTransaction(
+			ctx,
+			event.TransactionId,
+			item.WrappedAsset,
+			transfers,
+			onExecutionTransferSuccess,
+			onExecutionTransferFail,
+			onTransferSuccess,
+			onTransferFail,
+		)
+
+		err = s.awaitScheduled(ctx, event.TransactionId, schedule.TRANSFER, &status)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// awaitScheduled blocks until the Scheduled Transaction of the given operation and transfer is executed
+func (s *Service) awaitScheduled(ctx context.Context, transferID, operation string, status *chan string) error {
+	s.logger.Debugf("[%s] - Waiting for Scheduled [%s] Transaction Execution.", transferID, operation)
+	for {
+		select {
+		case result := <-*status:
+			switch result {
+			case syncHelper.DONE:
+				s.logger.Debugf("[%s] - Scheduled [%s] Transaction executed.", transferID, operation)
+				return nil
+			case syncHelper.FAIL:
+				s.logger.Errorf("[%s] - Failed to await the execution of Scheduled [%s] Transaction.", transferID, operation)
+				return errors.New(fmt.Sprintf("scheduled %s transaction failed", operation))
+			}
+		case <-ctx.Done():
+			s.logger.Errorf("[%s] - Stopped awaiting the execution of Scheduled [%s] Transaction. Error: [%s]", transferID, operation, ctx.Err())
+			return ctx.Err()
+		}
+	}
 }
 
 func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceChainId, targetChainId uint64, asset string) {
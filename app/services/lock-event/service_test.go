@@ -17,12 +17,17 @@
 package lock_event
 
 import (
+	"context"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 )
 
@@ -55,10 +60,12 @@ func Test_New(t *testing.T) {
 	setup()
 	actualService := NewService(
 		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
 		mocks.MTransferRepository,
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
+		mocks.MLimitsService,
 		mocks.MPrometheusService)
 	assert.Equal(t, s, actualService)
 }
@@ -67,17 +74,65 @@ func Test_ProcessEventFailsOnCreate(t *testing.T) {
 	setup()
 	actualService := NewService(
 		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
 		mocks.MTransferRepository,
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
+		mocks.MLimitsService,
 		mocks.MPrometheusService)
 
 	mocks.MTransferService.On("InitiateNewTransfer", lockEvent).Return(nil, errors.New("new-error"))
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction")
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction")
 
-	actualService.ProcessEvent(lockEvent)
+	err := actualService.ProcessEvent(context.Background(), lockEvent)
+	assert.Equal(t, errors.New("new-error"), err)
+}
+
+func Test_ProcessEventHeldForReview(t *testing.T) {
+	setup()
+	actualService := NewService(
+		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MScheduledService,
+		mocks.MTransferService,
+		mocks.MLimitsService,
+		mocks.MPrometheusService)
+
+	mocks.MTransferService.On("InitiateNewTransfer", lockEvent).Return(&entity.Transfer{TransactionID: lockEvent.TransactionId, Status: status.Initial}, nil)
+	mocks.MLimitsService.On("Hold", lockEvent, constants.HederaMintHtsTransfer).Return(true, nil)
+
+	err := actualService.ProcessEvent(context.Background(), lockEvent)
+
+	assert.Nil(t, err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ProcessNftEventFailsOnCreate(t *testing.T) {
+	setup()
+	actualService := NewService(
+		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MScheduledService,
+		mocks.MTransferService,
+		mocks.MLimitsService,
+		mocks.MPrometheusService)
+
+	nftLockEvent := lockEvent
+	nftLockEvent.IsNft = true
+	nftLockEvent.SerialNum = 7
+	nftLockEvent.Metadata = "ipfs://metadata"
+	mocks.MTransferService.On("InitiateNewTransfer", nftLockEvent).Return(nil, errors.New("new-error"))
+
+	err := actualService.ProcessNftEvent(context.Background(), nftLockEvent)
+
+	assert.Equal(t, errors.New("new-error"), err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything)
 }
 
 // TODO: Uncomment when synchronization of scheduled token mint and transfer is ready
@@ -217,11 +272,57 @@ func setup() {
 
 	s = &Service{
 		bridgeAccount:      hederaAccount,
+		mirrorNode:         mocks.MHederaMirrorClient,
 		repository:         mocks.MTransferRepository,
 		scheduleRepository: mocks.MScheduleRepository,
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
+		limitsService:      mocks.MLimitsService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
 }
+
+func Test_ProcessErc1155EventFailsOnInvalidAmount(t *testing.T) {
+	setup()
+	actualService := NewService(
+		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MScheduledService,
+		mocks.MTransferService,
+		mocks.MLimitsService,
+		mocks.MPrometheusService)
+
+	erc1155LockEvent := *transfer.NewErc1155(lockEvent.TransactionId, lockEvent.SourceChainId, 0, lockEvent.SourceChainId, lockEvent.Receiver, lockEvent.SourceAsset,
+		[]transfer.Erc1155Item{{TokenId: 1, Amount: "not-a-number", WrappedAsset: "0.0.6001"}})
+
+	err := actualService.ProcessErc1155Event(context.Background(), erc1155LockEvent)
+
+	assert.NotNil(t, err)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ProcessErc1155EventFailsOnCreate(t *testing.T) {
+	setup()
+	actualService := NewService(
+		hederaAccount.String(),
+		mocks.MHederaMirrorClient,
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MScheduledService,
+		mocks.MTransferService,
+		mocks.MLimitsService,
+		mocks.MPrometheusService)
+
+	erc1155LockEvent := *transfer.NewErc1155(lockEvent.TransactionId, lockEvent.SourceChainId, 0, lockEvent.SourceChainId, lockEvent.Receiver, lockEvent.SourceAsset,
+		[]transfer.Erc1155Item{{TokenId: 1, Amount: "10", WrappedAsset: "0.0.6001"}})
+	mocks.MTransferService.On("InitiateNewTransfer", erc1155LockEvent).Return(nil, errors.New("new-error"))
+
+	err := actualService.ProcessErc1155Event(context.Background(), erc1155LockEvent)
+
+	assert.Equal(t, errors.New("new-error"), err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
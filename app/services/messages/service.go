@@ -50,6 +50,7 @@ type Service struct {
 	ethClients         map[uint64]client.EVM
 	logger             *log.Entry
 	mappings           config.Assets
+	transferEvents     service.TransferEvents
 }
 
 func NewService(
@@ -61,6 +62,7 @@ func NewService(
 	ethClients map[uint64]client.EVM,
 	topicID string,
 	mappings config.Assets,
+	transferEvents service.TransferEvents,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
@@ -77,6 +79,7 @@ func NewService(
 		mirrorClient:       mirrorClient,
 		ethClients:         ethClients,
 		mappings:           mappings,
+		transferEvents:     transferEvents,
 	}
 }
 
@@ -137,6 +140,42 @@ func (ss *Service) SanityCheckNftSignature(topicMessage *proto_models.TopicEthNf
 	return match, nil
 }
 
+// SanityCheckErc1155Signature performs validation on the topic message metadata.
+// Validates it and its batch of token IDs and amounts against the Transaction Record metadata from DB
+func (ss *Service) SanityCheckErc1155Signature(topicMessage *proto_models.TopicEthErc1155SignatureMessage) (bool, error) {
+	// In case a topic message for given transfer is being processed before the actual transfer
+	_, err := ss.awaitTransfer(topicMessage.TransferID)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to await incoming transfer. Error: [%s]", topicMessage.TransferID, err)
+		return false, err
+	}
+
+	t, err := ss.transferRepository.GetWithPreloads(topicMessage.TransferID)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to retrieve Transaction Record with its ERC-1155 batch. Error: [%s]", topicMessage.TransferID, err)
+		return false, err
+	}
+
+	if !t.IsErc1155 ||
+		len(topicMessage.TokenIds) != len(t.Erc1155Items) ||
+		len(topicMessage.Amounts) != len(t.Erc1155Items) {
+		return false, nil
+	}
+	for i, item := range t.Erc1155Items {
+		if int64(topicMessage.TokenIds[i]) != item.TokenID || topicMessage.Amounts[i] != item.Amount {
+			return false, nil
+		}
+	}
+
+	match :=
+		topicMessage.Recipient == t.Receiver &&
+			topicMessage.Asset == t.TargetAsset &&
+			topicMessage.TargetChainId == t.TargetChainID &&
+			topicMessage.SourceChainId == t.SourceChainID &&
+			topicMessage.TransferID == t.TransactionID
+	return match, nil
+}
+
 func (ss Service) SignFungibleMessage(tm model.Transfer) ([]byte, error) {
 	authMsgHash, err := auth_message.EncodeFungibleBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount)
 	if err != nil {
@@ -205,6 +244,44 @@ func (ss Service) SignNftMessage(tm model.Transfer) ([]byte, error) {
 	return bytes, nil
 }
 
+func (ss Service) SignErc1155Message(tm model.Transfer) ([]byte, error) {
+	tokenIds, amounts := tm.Erc1155Batch()
+	authMsgHash, err := auth_message.EncodeErc1155BytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tokenIds, amounts, tm.Receiver)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tm.TransactionId, err)
+		return nil, err
+	}
+
+	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
+		return nil, err
+	}
+	signature := hex.EncodeToString(signatureBytes)
+
+	topicMessage := &proto_models.TopicEthErc1155SignatureMessage{
+		SourceChainId: tm.SourceChainId,
+		TargetChainId: tm.TargetChainId,
+		TransferID:    tm.TransactionId,
+		Asset:         tm.TargetAsset,
+		Amounts:       amounts,
+		Recipient:     tm.Receiver,
+		Signature:     signature,
+	}
+	for _, tokenId := range tokenIds {
+		topicMessage.TokenIds = append(topicMessage.TokenIds, uint64(tokenId))
+	}
+	msg := message.NewErc1155Signature(topicMessage)
+
+	bytes, err := msg.ToBytes()
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to marshal ERC-1155 Signature Message to bytes. Error [%s]", tm.TransactionId, err)
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
 func (ss *Service) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
 	// Prepare Signature
@@ -247,6 +324,13 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 		return err
 	}
 
+	ss.transferEvents.Publish(service.TransferEvent{
+		TransferID: transferID,
+		Type:       service.TransferEventSignature,
+		Timestamp:  timestamp,
+		Signer:     address.String(),
+	})
+
 	ss.logger.Infof("[%s] - Successfully processed Signature Message from [%s]", transferID, address.String())
 	return nil
 }
@@ -0,0 +1,218 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pause
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type Service struct {
+	repository        repository.Pause
+	messageRepository repository.PausedMessage
+	queue             qi.Queue
+	// Serialises deferring and lifting pauses, so that no message is deferred while its pause is being lifted
+	mutex *sync.Mutex
+	// Serialises resuming, so that a paused message is not pushed twice by concurrent resumes. Deferring does not wait
+	// on it, as pushing to the in-memory queue blocks until the workers, which may be deferring, take the message
+	resumeMutex *sync.Mutex
+	logger      *log.Entry
+}
+
+func NewService(repository repository.Pause, messageRepository repository.PausedMessage, queue qi.Queue) *Service {
+	return &Service{
+		repository:        repository,
+		messageRepository: messageRepository,
+		queue:             queue,
+		mutex:             &sync.Mutex{},
+		resumeMutex:       &sync.Mutex{},
+		logger:            config.GetLoggerFor("Pause Service"),
+	}
+}
+
+func (s *Service) GetAll() ([]*entity.Pause, error) {
+	pauses, err := s.repository.GetAll()
+	if err != nil {
+		s.logger.Errorf("Failed to query pauses. Error: [%s].", err)
+		return nil, err
+	}
+
+	return pauses, nil
+}
+
+func (s *Service) Pause(scope, value string) error {
+	value = normalize(scope, value)
+	err := s.repository.Create(&entity.Pause{
+		Scope:     scope,
+		Value:     value,
+		CreatedAt: time.Now().UnixNano(),
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to pause [%s] [%s]. Error: [%s].", scope, value, err)
+		return err
+	}
+
+	s.logger.Warnf("Paused [%s] [%s].", scope, value)
+	return nil
+}
+
+func (s *Service) Resume(scope, value string) error {
+	s.resumeMutex.Lock()
+	defer s.resumeMutex.Unlock()
+
+	messages, err := s.lift(scope, value)
+	if err != nil {
+		return err
+	}
+
+	return s.pushResumed(messages)
+}
+
+func (s *Service) Defer(topic string, tm transfer.Transfer) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pauses, err := s.GetAll()
+	if err != nil || !isPaused(pauses, tm) {
+		return false, err
+	}
+
+	payloadType, payload, err := queue.EncodePayload(&tm)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to encode transfer payload. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	err = s.messageRepository.Create(&entity.PausedMessage{
+		TransferID:  tm.TransactionId,
+		Topic:       topic,
+		PayloadType: payloadType,
+		Payload:     payload,
+		CreatedAt:   time.Now().UnixNano(),
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to persist paused message. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	s.logger.Infof("[%s] - Deferred [%s] until the transfer is resumed.", tm.TransactionId, topic)
+	return true, nil
+}
+
+// lift deletes the given pause and returns the paused messages, to which no pause applies anymore
+func (s *Service) lift(scope, value string) ([]*entity.PausedMessage, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	value = normalize(scope, value)
+	pause, err := s.repository.Get(scope, value)
+	if err != nil {
+		s.logger.Errorf("Failed to query pause [%s] [%s]. Error: [%s].", scope, value, err)
+		return nil, err
+	}
+	if pause == nil {
+		return nil, service.ErrNotFound
+	}
+
+	err = s.repository.Delete(scope, value)
+	if err != nil {
+		s.logger.Errorf("Failed to delete pause [%s] [%s]. Error: [%s].", scope, value, err)
+		return nil, err
+	}
+	s.logger.Infof("Resumed [%s] [%s].", scope, value)
+
+	pauses, err := s.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.messageRepository.GetAll()
+	if err != nil {
+		s.logger.Errorf("Failed to query paused messages. Error: [%s].", err)
+		return nil, err
+	}
+
+	var resumed []*entity.PausedMessage
+	for _, message := range messages {
+		payload, err := queue.DecodePayload(message.PayloadType, message.Payload)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to decode paused message payload. Error: [%s].", message.TransferID, err)
+			return nil, err
+		}
+
+		tm, ok := payload.(*transfer.Transfer)
+		if ok && isPaused(pauses, *tm) {
+			continue
+		}
+		resumed = append(resumed, message)
+	}
+
+	return resumed, nil
+}
+
+// pushResumed pushes the given paused messages back to the queue. It runs without holding the mutex of deferring,
+// as the workers, which take the pushed messages, defer messages themselves
+func (s *Service) pushResumed(messages []*entity.PausedMessage) error {
+	for _, message := range messages {
+		err := s.queue.Resume(message)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to resume message. Error: [%s].", message.TransferID, err)
+			return err
+		}
+		s.logger.Infof("[%s] - Resumed [%s].", message.TransferID, message.Topic)
+	}
+
+	return nil
+}
+
+// isPaused returns whether any of the given pauses applies to the transfer
+func isPaused(pauses []*entity.Pause, tm transfer.Transfer) bool {
+	for _, p := range pauses {
+		switch p.Scope {
+		case entity.PauseGlobal:
+			return true
+		case entity.PauseChain:
+			if p.Value == strconv.FormatUint(tm.SourceChainId, 10) || p.Value == strconv.FormatUint(tm.TargetChainId, 10) {
+				return true
+			}
+		case entity.PauseAsset:
+			if p.Value == tm.SourceAsset || p.Value == tm.TargetAsset || p.Value == tm.NativeAsset {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// normalize checksums EVM addresses, so that paused assets match the assets of the transfers
+func normalize(scope, value string) string {
+	if scope == entity.PauseAsset && common.IsHexAddress(value) {
+		return common.HexToAddress(value).String()
+	}
+	return value
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pause
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	transferID  = "0.0.123123-123321-420"
+	evmAsset    = "0x0000000000000000000000000000000000000123"
+	transferMsg = transfer.New(
+		transferID,
+		0,
+		80001,
+		0,
+		"0xreceiver",
+		"0.0.123",
+		evmAsset,
+		"0.0.123",
+		"100")
+	pausedMessage *entity.PausedMessage
+)
+
+func Test_Pause(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("Create", mock.MatchedBy(func(p *entity.Pause) bool {
+		return p.Scope == entity.PauseAsset && p.Value == evmAsset
+	})).Return(nil)
+
+	err := s.Pause(entity.PauseAsset, "0x0000000000000000000000000000000000000123")
+
+	assert.Nil(t, err)
+}
+
+func Test_Defer_NotPaused(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{
+		{Scope: entity.PauseChain, Value: "3"},
+		{Scope: entity.PauseAsset, Value: "0.0.456"},
+	}, nil)
+
+	deferred, err := s.Defer(constants.HederaTransferMessageSubmission, *transferMsg)
+
+	assert.Nil(t, err)
+	assert.False(t, deferred)
+	mocks.MPausedMessageRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Defer(t *testing.T) {
+	for _, pause := range []*entity.Pause{
+		{Scope: entity.PauseGlobal},
+		{Scope: entity.PauseChain, Value: "80001"},
+		{Scope: entity.PauseAsset, Value: evmAsset},
+		{Scope: entity.PauseAsset, Value: "0.0.123"},
+	} {
+		s := setup()
+		mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{pause}, nil)
+		mocks.MPausedMessageRepository.On("Create", mock.MatchedBy(func(m *entity.PausedMessage) bool {
+			return m.TransferID == transferID && m.Topic == constants.HederaTransferMessageSubmission && m.Payload == pausedMessage.Payload
+		})).Return(nil)
+
+		deferred, err := s.Defer(constants.HederaTransferMessageSubmission, *transferMsg)
+
+		assert.Nil(t, err)
+		assert.True(t, deferred)
+	}
+}
+
+func Test_Defer_CreateFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{{Scope: entity.PauseGlobal}}, nil)
+	mocks.MPausedMessageRepository.On("Create", mock.Anything).Return(expectedErr)
+
+	deferred, err := s.Defer(constants.HederaTransferMessageSubmission, *transferMsg)
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, deferred)
+}
+
+func Test_Resume(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("Get", entity.PauseGlobal, "").Return(&entity.Pause{Scope: entity.PauseGlobal}, nil)
+	mocks.MPauseRepository.On("Delete", entity.PauseGlobal, "").Return(nil)
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{pausedMessage}, nil)
+	mocks.MQueue.On("Resume", pausedMessage).Return(nil)
+
+	err := s.Resume(entity.PauseGlobal, "")
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertCalled(t, "Resume", pausedMessage)
+	mocks.MPausedMessageRepository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func Test_Resume_DoesNotBlockDefer(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("Get", entity.PauseGlobal, "").Return(&entity.Pause{Scope: entity.PauseGlobal}, nil)
+	mocks.MPauseRepository.On("Delete", entity.PauseGlobal, "").Return(nil)
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{pausedMessage}, nil)
+	// The in-memory queue blocks on resume until a worker takes the message, which may be deferring another message
+	mocks.MQueue.On("Resume", pausedMessage).Run(func(mock.Arguments) {
+		deferred := make(chan struct{})
+		go func() {
+			s.Defer(constants.HederaTransferMessageSubmission, *transferMsg)
+			close(deferred)
+		}()
+		select {
+		case <-deferred:
+		case <-time.After(time.Second):
+			t.Error("Defer blocked while resuming")
+		}
+	}).Return(nil)
+
+	err := s.Resume(entity.PauseGlobal, "")
+
+	assert.Nil(t, err)
+}
+
+func Test_Resume_MoveFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MPauseRepository.On("Get", entity.PauseGlobal, "").Return(&entity.Pause{Scope: entity.PauseGlobal}, nil)
+	mocks.MPauseRepository.On("Delete", entity.PauseGlobal, "").Return(nil)
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{pausedMessage}, nil)
+	mocks.MQueue.On("Resume", pausedMessage).Return(expectedErr)
+
+	err := s.Resume(entity.PauseGlobal, "")
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_Resume_StillPaused(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("Get", entity.PauseGlobal, "").Return(&entity.Pause{Scope: entity.PauseGlobal}, nil)
+	mocks.MPauseRepository.On("Delete", entity.PauseGlobal, "").Return(nil)
+	mocks.MPauseRepository.On("GetAll").Return([]*entity.Pause{{Scope: entity.PauseChain, Value: "80001"}}, nil)
+	mocks.MPausedMessageRepository.On("GetAll").Return([]*entity.PausedMessage{pausedMessage}, nil)
+
+	err := s.Resume(entity.PauseGlobal, "")
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertNotCalled(t, "Resume", mock.Anything)
+	mocks.MPausedMessageRepository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func Test_Resume_NotFound(t *testing.T) {
+	s := setup()
+	mocks.MPauseRepository.On("Get", entity.PauseChain, "80001").Return(nil, nil)
+
+	err := s.Resume(entity.PauseChain, "80001")
+
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MPauseRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func setup() *Service {
+	mocks.Setup()
+	payloadType, payload, _ := queue.EncodePayload(transferMsg)
+	pausedMessage = &entity.PausedMessage{
+		TransferID:  transferID,
+		Topic:       constants.HederaTransferMessageSubmission,
+		PayloadType: payloadType,
+		Payload:     payload,
+	}
+	return NewService(mocks.MPauseRepository, mocks.MPausedMessageRepository, mocks.MQueue)
+}
@@ -32,6 +32,7 @@ type Service struct {
 	logger              *log.Entry
 	gauges              map[string]prometheus.Gauge
 	counters            map[string]prometheus.Counter
+	histograms          map[string]prometheus.Histogram
 	isMonitoringEnabled bool
 	assetsConfig        config.Assets
 }
@@ -42,6 +43,7 @@ func NewService(assetsConfig config.Assets, isMonitoringEnabled bool) *Service {
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
 		counters:            map[string]prometheus.Counter{},
+		histograms:          map[string]prometheus.Histogram{},
 		isMonitoringEnabled: isMonitoringEnabled,
 		assetsConfig:        assetsConfig,
 	}
@@ -188,6 +190,31 @@ func (s *Service) DeleteCounter(name string) {
 	s.logger.Infof("Counter Metric '%v' successfully unregisted!", name)
 }
 
+func (s *Service) CreateHistogramIfNotExists(opts prometheus.HistogramOpts) prometheus.Histogram {
+	if !s.isMonitoringEnabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if histogram, exist := s.histograms[opts.Name]; exist {
+		return histogram
+	}
+
+	s.logger.Infof("Creating Histogram Metric '%v' ...", opts.Name)
+	histogram := prometheus.NewHistogram(opts)
+	s.logger.Infof("Histogram Metric '%v' successfully created!", opts.Name)
+
+	s.logger.Infof("Registering Histogram Metric '%v' ...", opts.Name)
+	prometheus.MustRegister(histogram)
+	s.logger.Infof("Histogram Metric '%v' successfully registed!", opts.Name)
+
+	s.histograms[opts.Name] = histogram
+
+	return histogram
+}
+
 func (s *Service) GetIsMonitoringEnabled() bool {
 	return s.isMonitoringEnabled
 }
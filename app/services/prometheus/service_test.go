@@ -36,6 +36,7 @@ var (
 	gaugeSuffix         = "gauge_suffix"
 	counterOpts         = prometheus.CounterOpts{Name: "CounterName", Help: "CounterHelp"}
 	counterSuffix       = "counter_suffix"
+	histogramOpts       = prometheus.HistogramOpts{Name: "HistogramName", Help: "HistogramHelp"}
 	assets              = config.LoadAssets(testConstants.Networks)
 )
 
@@ -160,6 +161,16 @@ func Test_DeleteCounter(t *testing.T) {
 	assert.Nil(t, counterInMapping)
 }
 
+func Test_CreateHistogramIfNotExists(t *testing.T) {
+	setup()
+
+	histogram := service.CreateHistogramIfNotExists(histogramOpts)
+	defer prometheus.Unregister(histogram)
+
+	assert.NotNil(t, histogram)
+	assert.Equal(t, histogram, service.CreateHistogramIfNotExists(histogramOpts))
+}
+
 func setup() {
 	mocks.Setup()
 
@@ -172,6 +183,7 @@ func setup() {
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
 		counters:            map[string]prometheus.Counter{},
+		histograms:          map[string]prometheus.Histogram{},
 		assetsConfig:        assets,
 		isMonitoringEnabled: isMonitoringEnabled,
 	}
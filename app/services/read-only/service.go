@@ -17,10 +17,12 @@
 package read_only
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/wait"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -51,12 +53,13 @@ func New(
 }
 
 func (s Service) FindAssetTransfer(
+	ctx context.Context,
 	transferID string,
 	asset string,
 	expectedTransfers []model.Hedera,
 	fetch func() (*mirror_node.Response, error),
-	save func(transactionID, scheduleID, status string) error) {
-	for {
+	save func(transactionID, scheduleID, status string) error) error {
+	for ctx.Err() == nil {
 		response, err := fetch()
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
@@ -66,14 +69,14 @@ func (s Service) FindAssetTransfer(
 		finished := false
 		for _, transaction := range response.Transactions {
 			isFound := false
-			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(ctx, transaction.TransactionID)
 			if err != nil {
 				s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
 				continue
 			}
 			for _, tx := range scheduledTx.Transactions {
 				if tx.Result == hedera.StatusSuccess.String() {
-					scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
+					scheduleID, err := s.mirrorNode.GetSchedule(ctx, tx.EntityId)
 					if err != nil {
 						s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, tx.EntityId, err)
 						break
@@ -111,19 +114,22 @@ func (s Service) FindAssetTransfer(
 			}
 		}
 		if finished {
-			break
+			return nil
 		}
 		s.logger.Tracef("[%s] - No asset transfers found.", transferID)
 
-		time.Sleep(s.pollingInterval * time.Second)
+		wait.Sleep(ctx, s.pollingInterval*time.Second)
 	}
+
+	return ctx.Err()
 }
 
 func (s Service) FindNftTransfer(
+	ctx context.Context,
 	transferID string, tokenID string, serialNum int64, sender string, receiver string,
-	save func(transactionID, scheduleID, status string) error) {
-	for {
-		response, err := s.mirrorNode.GetNftTransactions(tokenID, serialNum)
+	save func(transactionID, scheduleID, status string) error) error {
+	for ctx.Err() == nil {
+		response, err := s.mirrorNode.GetNftTransactions(ctx, tokenID, serialNum)
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to get nft transactions after timestamp. Error: [%s]", transferID, err)
 			continue
@@ -135,14 +141,14 @@ func (s Service) FindNftTransfer(
 				transaction.ReceiverAccountID == receiver &&
 				transaction.SenderAccountID == sender {
 
-				scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+				scheduledTx, err := s.mirrorNode.GetScheduledTransaction(ctx, transaction.TransactionID)
 				if err != nil {
 					s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
 					continue
 				}
 				for _, tx := range scheduledTx.Transactions {
 					if tx.Result == hedera.StatusSuccess.String() {
-						scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
+						scheduleID, err := s.mirrorNode.GetSchedule(ctx, tx.EntityId)
 						if err != nil {
 							s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, scheduleID, err)
 							break
@@ -165,18 +171,21 @@ func (s Service) FindNftTransfer(
 			}
 		}
 		if finished {
-			break
+			return nil
 		}
 
-		time.Sleep(s.pollingInterval * time.Second)
+		wait.Sleep(ctx, s.pollingInterval*time.Second)
 	}
+
+	return ctx.Err()
 }
 
 func (s Service) FindTransfer(
+	ctx context.Context,
 	transferID string,
 	fetch func() (*mirror_node.Response, error),
-	save func(transactionID, scheduleID, status string) error) {
-	for {
+	save func(transactionID, scheduleID, status string) error) error {
+	for ctx.Err() == nil {
 		response, err := fetch()
 		if err != nil {
 			s.logger.Errorf("[%s] - Failed to get transactions after timestamp. Error: [%s]", transferID, err)
@@ -186,14 +195,14 @@ func (s Service) FindTransfer(
 		finished := false
 		for _, transaction := range response.Transactions {
 			isFound := false
-			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(transaction.TransactionID)
+			scheduledTx, err := s.mirrorNode.GetScheduledTransaction(ctx, transaction.TransactionID)
 			if err != nil {
 				s.logger.Errorf("[%s] - Failed to retrieve scheduled transaction [%s]. Error: [%s]", transferID, transaction.TransactionID, err)
 				continue
 			}
 			for _, tx := range scheduledTx.Transactions {
 				if tx.Result == hedera.StatusSuccess.String() {
-					scheduleID, err := s.mirrorNode.GetSchedule(tx.EntityId)
+					scheduleID, err := s.mirrorNode.GetSchedule(ctx, tx.EntityId)
 					if err != nil {
 						s.logger.Errorf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", transferID, scheduleID, err)
 						break
@@ -231,12 +240,14 @@ func (s Service) FindTransfer(
 			}
 		}
 		if finished {
-			break
+			return nil
 		}
 
 		s.logger.Tracef("[%s] - No transfers found.", transferID)
-		time.Sleep(s.pollingInterval * time.Second)
+		wait.Sleep(ctx, s.pollingInterval*time.Second)
 	}
+
+	return ctx.Err()
 }
 
 func transfersAreFound(expectedTransfers []model.Hedera, asset string, transaction mirror_node.Transaction) bool {
@@ -0,0 +1,276 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/wtoken"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimals"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Service struct {
+	mirrorNode         client.MirrorNode
+	evmClients         map[uint64]client.EVM
+	bridge             config.Bridge
+	transferRepository repository.Transfer
+	snapshotRepository repository.SupplySnapshot
+	prometheusService  service.Prometheus
+	// The allowed difference in percent of the locked balance
+	tolerance float64
+	decimals  *decimals.Cache
+	// Serialises the reconciliations
+	mutex  *sync.Mutex
+	logger *log.Entry
+}
+
+func NewService(
+	mirrorNode client.MirrorNode,
+	evmClients map[uint64]client.EVM,
+	bridge config.Bridge,
+	transferRepository repository.Transfer,
+	snapshotRepository repository.SupplySnapshot,
+	prometheusService service.Prometheus,
+	tolerance float64) *Service {
+	return &Service{
+		mirrorNode:         mirrorNode,
+		evmClients:         evmClients,
+		bridge:             bridge,
+		transferRepository: transferRepository,
+		snapshotRepository: snapshotRepository,
+		prometheusService:  prometheusService,
+		tolerance:          tolerance,
+		decimals:           decimals.NewCache(mirrorNode, evmClients),
+		mutex:              &sync.Mutex{},
+		logger:             config.GetLoggerFor("Reconciliation Service"),
+	}
+}
+
+func (s *Service) Reconcile(ctx context.Context) ([]*entity.SupplySnapshot, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	networkAssets := s.bridge.Assets.GetFungibleNetworkAssets()
+	chainIds := make([]uint64, 0, len(networkAssets))
+	for chainId := range networkAssets {
+		chainIds = append(chainIds, chainId)
+	}
+	sort.Slice(chainIds, func(i, j int) bool { return chainIds[i] < chainIds[j] })
+
+	var snapshots []*entity.SupplySnapshot
+	for _, chainId := range chainIds {
+		for _, asset := range networkAssets[chainId] {
+			if !s.bridge.Assets.IsNative(chainId, asset) {
+				continue
+			}
+
+			snapshot, err := s.snapshot(ctx, chainId, asset)
+			if err != nil {
+				s.logger.Errorf("Failed to reconcile [%s] of chain [%d]. Error: [%s].", asset, chainId, err)
+				continue
+			}
+
+			err = s.snapshotRepository.Create(snapshot)
+			if err != nil {
+				s.logger.Errorf("Failed to persist the supply snapshot of [%s] of chain [%d]. Error: [%s].", asset, chainId, err)
+				return snapshots, err
+			}
+			s.setDivergedMetric(snapshot)
+
+			if snapshot.Diverged {
+				s.logger.Warnf("The locked balance [%s] of [%s] of chain [%d] diverges from the wrapped supply [%s] and the in-flight amount [%s] by [%s].",
+					snapshot.Locked, asset, chainId, snapshot.Supply, snapshot.InFlight, snapshot.Difference)
+			} else {
+				s.logger.Debugf("The locked balance [%s] of [%s] of chain [%d] matches the wrapped supply [%s] and the in-flight amount [%s].",
+					snapshot.Locked, asset, chainId, snapshot.Supply, snapshot.InFlight)
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (s *Service) GetLatest() ([]*entity.SupplySnapshot, error) {
+	snapshots, err := s.snapshotRepository.GetLatest()
+	if err != nil {
+		s.logger.Errorf("Failed to query the latest supply snapshots. Error: [%s].", err)
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+func (s *Service) GetHistory(chainId uint64, asset string, limit int) ([]*entity.SupplySnapshot, error) {
+	snapshots, err := s.snapshotRepository.GetHistory(chainId, asset, limit)
+	if err != nil {
+		s.logger.Errorf("Failed to query the supply snapshots of [%s] of chain [%d]. Error: [%s].", asset, chainId, err)
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// snapshot reads the locked balance of the native asset, the total supplies of its wrapped assets and the amounts of its
+// unpaid transfers, converted to the lowest denomination of the native asset
+func (s *Service) snapshot(ctx context.Context, chainId uint64, asset string) (*entity.SupplySnapshot, error) {
+	nativeDecimals, err := s.decimals.Get(ctx, chainId, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := s.locked(ctx, chainId, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := big.NewInt(0)
+	for wrappedChainId, wrappedAsset := range s.bridge.Assets.WrappedFromNative(chainId, asset) {
+		wrappedDecimals, err := s.decimals.Get(ctx, wrappedChainId, wrappedAsset)
+		if err != nil {
+			return nil, err
+		}
+		totalSupply, err := s.totalSupply(ctx, wrappedChainId, wrappedAsset)
+		if err != nil {
+			return nil, err
+		}
+		supply.Add(supply, decimals.Scale(totalSupply, wrappedDecimals, nativeDecimals))
+	}
+
+	unpaid, err := s.transferRepository.GetUnpaid(chainId, asset)
+	if err != nil {
+		return nil, err
+	}
+	inFlight := big.NewInt(0)
+	for _, transfer := range unpaid {
+		amount, ok := new(big.Int).SetString(transfer.Amount, 10)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("invalid amount [%s] of transfer [%s]", transfer.Amount, transfer.TransactionID))
+		}
+		// Amounts of transfers are in the denomination of their target asset
+		targetDecimals, err := s.decimals.Get(ctx, transfer.TargetChainID, transfer.TargetAsset)
+		if err != nil {
+			return nil, err
+		}
+		inFlight.Add(inFlight, decimals.Scale(amount, targetDecimals, nativeDecimals))
+	}
+
+	difference := new(big.Int).Sub(locked, supply)
+	difference.Sub(difference, inFlight)
+
+	return &entity.SupplySnapshot{
+		ChainID:    chainId,
+		Asset:      asset,
+		Locked:     locked.String(),
+		Supply:     supply.String(),
+		InFlight:   inFlight.String(),
+		Difference: difference.String(),
+		Diverged:   isDiverged(difference, locked, s.tolerance),
+		CreatedAt:  time.Now().UnixNano(),
+	}, nil
+}
+
+// locked returns the balance of the native asset held by the bridge account on Hedera or by the router contract on EVM networks
+func (s *Service) locked(ctx context.Context, chainId uint64, asset string) (*big.Int, error) {
+	if chainId == constants.HederaNetworkId {
+		account, err := s.mirrorNode.GetAccount(ctx, s.bridge.Hedera.BridgeAccount)
+		if err != nil {
+			return nil, err
+		}
+		if asset == constants.Hbar {
+			return big.NewInt(int64(account.Balance.Balance)), nil
+		}
+		for _, token := range account.Balance.Tokens {
+			if token.TokenID == asset {
+				return big.NewInt(int64(token.Balance)), nil
+			}
+		}
+		return big.NewInt(0), nil
+	}
+
+	token, err := s.wtoken(chainId, asset)
+	if err != nil {
+		return nil, err
+	}
+	return token.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(s.bridge.EVMs[chainId].RouterContractAddress))
+}
+
+func (s *Service) totalSupply(ctx context.Context, chainId uint64, asset string) (*big.Int, error) {
+	if chainId == constants.HederaNetworkId {
+		token, err := s.mirrorNode.GetToken(ctx, asset)
+		if err != nil {
+			return nil, err
+		}
+		totalSupply, ok := new(big.Int).SetString(token.TotalSupply, 10)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("invalid total supply [%s] of [%s]", token.TotalSupply, asset))
+		}
+		return totalSupply, nil
+	}
+
+	token, err := s.wtoken(chainId, asset)
+	if err != nil {
+		return nil, err
+	}
+	return token.TotalSupply(&bind.CallOpts{Context: ctx})
+}
+
+func (s *Service) wtoken(chainId uint64, asset string) (*wtoken.Wtoken, error) {
+	evmClient, ok := s.evmClients[chainId]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("no EVM client for chain [%d]", chainId))
+	}
+	return wtoken.NewWtoken(common.HexToAddress(asset), evmClient.GetClient())
+}
+
+func (s *Service) setDivergedMetric(snapshot *entity.SupplySnapshot) {
+	if !s.prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	gauge := s.prometheusService.CreateGaugeIfNotExists(prometheus.GaugeOpts{
+		Name: fmt.Sprintf("%s%d_%s", constants.SupplyDivergedNamePrefix, snapshot.ChainID, metrics.AssetAddressToMetricName(snapshot.Asset)),
+		Help: constants.SupplyDivergedHelp,
+	})
+	if snapshot.Diverged {
+		gauge.Set(1)
+	} else {
+		gauge.Set(0)
+	}
+}
+
+// isDiverged returns whether the absolute difference exceeds the given tolerance in percent of the locked balance
+func isDiverged(difference, locked *big.Int, tolerance float64) bool {
+	allowed := new(big.Float).Mul(new(big.Float).SetInt(locked), big.NewFloat(tolerance/100))
+	return new(big.Float).SetInt(new(big.Int).Abs(difference)).Cmp(allowed) > 0
+}
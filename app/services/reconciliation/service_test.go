@@ -0,0 +1,184 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reconciliation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+)
+
+var (
+	evmChainId    = uint64(80001)
+	bridgeAccount = "0.0.1001"
+	routerAddress = "0x0000000000000000000000000000000000000aaa"
+	wrappedHbar   = common.HexToAddress("0x0000000000000000000000000000000000000bbb").String()
+	nativeToken   = common.HexToAddress("0x0000000000000000000000000000000000000ccc").String()
+	wrappedToken  = "0.0.2001"
+	decimalsData  = hexutil.MustDecode("0x313ce567")
+	supplyData    = hexutil.MustDecode("0x18160ddd")
+	balanceOfData = hexutil.MustDecode("0x70a08231")
+	networks      = map[uint64]*parser.Network{
+		constants.HederaNetworkId: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					constants.Hbar: {Networks: map[uint64]string{evmChainId: wrappedHbar}},
+				},
+			},
+		},
+		evmChainId: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{
+					nativeToken: {Networks: map[uint64]string{constants.HederaNetworkId: wrappedToken}},
+				},
+			},
+		},
+	}
+	s *Service
+)
+
+func setup() {
+	mocks.Setup()
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	bridge := config.Bridge{
+		Hedera: &config.BridgeHedera{BridgeAccount: bridgeAccount},
+		EVMs:   map[uint64]config.BridgeEvm{evmChainId: {RouterContractAddress: routerAddress}},
+		Assets: config.LoadAssets(networks),
+	}
+	s = NewService(
+		mocks.MHederaMirrorClient,
+		map[uint64]client.EVM{evmChainId: mocks.MEVMClient},
+		bridge,
+		mocks.MTransferRepository,
+		mocks.MSupplySnapshotRepository,
+		mocks.MPrometheusService,
+		0.1)
+}
+
+// mockCall mocks a call without arguments or with a single address argument of the given contract
+func mockCall(contract string, selector []byte, result *big.Int) {
+	mocks.MEVMCoreClient.On("CallContract", mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+		return *call.To == common.HexToAddress(contract) && bytes.HasPrefix(call.Data, selector)
+	}), mock.Anything).Return(common.LeftPadBytes(result.Bytes(), 32), nil)
+}
+
+func mockBalances(lockedHbar, wrappedHbarSupply, lockedToken, wrappedTokenSupply int64) {
+	mocks.MHederaMirrorClient.On("GetAccount", bridgeAccount).Return(&model.AccountsResponse{
+		Balance: model.Balance{Balance: int(lockedHbar)},
+	}, nil)
+	mocks.MHederaMirrorClient.On("GetToken", wrappedToken).Return(&model.TokenResponse{
+		TotalSupply: big.NewInt(wrappedTokenSupply).String(),
+		Decimals:    "6",
+	}, nil)
+	mockCall(wrappedHbar, decimalsData, big.NewInt(18))
+	mockCall(wrappedHbar, supplyData, new(big.Int).Mul(big.NewInt(wrappedHbarSupply), big.NewInt(1e10)))
+	mockCall(nativeToken, decimalsData, big.NewInt(18))
+	mockCall(nativeToken, balanceOfData, new(big.Int).Mul(big.NewInt(lockedToken), big.NewInt(1e12)))
+}
+
+func Test_Reconcile_Matching(t *testing.T) {
+	setup()
+	mockBalances(1000, 900, 500, 500)
+	mocks.MTransferRepository.On("GetUnpaid", constants.HederaNetworkId, constants.Hbar).Return([]*entity.Transfer{
+		{TransactionID: "1", TargetChainID: evmChainId, TargetAsset: wrappedHbar, Amount: "1000000000000"},
+	}, nil)
+	mocks.MTransferRepository.On("GetUnpaid", evmChainId, nativeToken).Return([]*entity.Transfer{}, nil)
+	mocks.MSupplySnapshotRepository.On("Create", mock.Anything).Return(nil)
+
+	snapshots, err := s.Reconcile(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, constants.Hbar, snapshots[0].Asset)
+	assert.Equal(t, "1000", snapshots[0].Locked)
+	assert.Equal(t, "900", snapshots[0].Supply)
+	assert.Equal(t, "100", snapshots[0].InFlight)
+	assert.Equal(t, "0", snapshots[0].Difference)
+	assert.False(t, snapshots[0].Diverged)
+	assert.Equal(t, nativeToken, snapshots[1].Asset)
+	assert.Equal(t, "500000000000000", snapshots[1].Locked)
+	assert.Equal(t, "500000000000000", snapshots[1].Supply)
+	assert.False(t, snapshots[1].Diverged)
+	mocks.MSupplySnapshotRepository.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func Test_Reconcile_Diverged(t *testing.T) {
+	setup()
+	mockBalances(1000, 1100, 500, 500)
+	mocks.MTransferRepository.On("GetUnpaid", mock.Anything, mock.Anything).Return([]*entity.Transfer{}, nil)
+	mocks.MSupplySnapshotRepository.On("Create", mock.Anything).Return(nil)
+
+	snapshots, err := s.Reconcile(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, "-100", snapshots[0].Difference)
+	assert.True(t, snapshots[0].Diverged)
+	assert.False(t, snapshots[1].Diverged)
+}
+
+func Test_Reconcile_SkipsUnreadableAsset(t *testing.T) {
+	setup()
+	mocks.MHederaMirrorClient.On("GetAccount", bridgeAccount).Return((*model.AccountsResponse)(nil), errors.New("some-error"))
+	mocks.MHederaMirrorClient.On("GetToken", wrappedToken).Return(&model.TokenResponse{TotalSupply: "500", Decimals: "18"}, nil)
+	mockCall(nativeToken, decimalsData, big.NewInt(18))
+	mockCall(nativeToken, balanceOfData, big.NewInt(500))
+	mocks.MTransferRepository.On("GetUnpaid", evmChainId, nativeToken).Return([]*entity.Transfer{}, nil)
+	mocks.MSupplySnapshotRepository.On("Create", mock.Anything).Return(nil)
+
+	snapshots, err := s.Reconcile(context.Background())
+
+	assert.Nil(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, nativeToken, snapshots[0].Asset)
+	mocks.MSupplySnapshotRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_Reconcile_PersistenceError(t *testing.T) {
+	setup()
+	mockBalances(1000, 1000, 500, 500)
+	mocks.MTransferRepository.On("GetUnpaid", mock.Anything, mock.Anything).Return([]*entity.Transfer{}, nil)
+	mocks.MSupplySnapshotRepository.On("Create", mock.Anything).Return(errors.New("some-error"))
+
+	_, err := s.Reconcile(context.Background())
+
+	assert.Error(t, err)
+	mocks.MSupplySnapshotRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_IsDiverged(t *testing.T) {
+	assert.False(t, isDiverged(big.NewInt(1), big.NewInt(1000), 0.1))
+	assert.True(t, isDiverged(big.NewInt(-2), big.NewInt(1000), 0.1))
+	assert.False(t, isDiverged(big.NewInt(0), big.NewInt(0), 0.1))
+	assert.True(t, isDiverged(big.NewInt(1), big.NewInt(0), 0.1))
+}
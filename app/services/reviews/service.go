@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reviews
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	repository         repository.Review
+	transferRepository repository.Transfer
+	queue              qi.Queue
+	logger             *log.Entry
+}
+
+func NewService(repository repository.Review, transferRepository repository.Transfer, queue qi.Queue) *Service {
+	return &Service{
+		repository:         repository,
+		transferRepository: transferRepository,
+		queue:              queue,
+		logger:             config.GetLoggerFor("Reviews Service"),
+	}
+}
+
+func (s *Service) GetPending() ([]*entity.Review, error) {
+	reviews, err := s.repository.GetPending()
+	if err != nil {
+		s.logger.Errorf("Failed to query pending reviews. Error: [%s].", err)
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (s *Service) Get(transferID string) (*entity.Review, error) {
+	review, err := s.repository.Get(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to query review. Error: [%s].", transferID, err)
+		return nil, err
+	}
+
+	if review == nil || review.Approved {
+		return nil, service.ErrNotFound
+	}
+
+	return review, nil
+}
+
+func (s *Service) Approve(transferID string) error {
+	review, err := s.Get(transferID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := queue.DecodePayload(review.PayloadType, review.Payload)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to decode review payload. Error: [%s].", transferID, err)
+		return err
+	}
+
+	err = s.repository.UpdateApproved(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to approve review. Error: [%s].", transferID, err)
+		return err
+	}
+
+	err = s.transferRepository.ApprovePendingReview(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to move approved transfer back to initial. Error: [%s].", transferID, err)
+		return err
+	}
+
+	err = s.queue.Push(context.Background(), &queue.Message{
+		Payload: payload,
+		Topic:   review.Topic,
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to enqueue approved transfer. Error: [%s].", transferID, err)
+		return err
+	}
+
+	s.logger.Infof("[%s] - Approved transfer for [%s].", transferID, review.Topic)
+	return nil
+}
+
+func (s *Service) Reject(transferID string) error {
+	_, err := s.Get(transferID)
+	if err != nil {
+		return err
+	}
+
+	err = s.transferRepository.RejectPendingReview(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to fail rejected transfer. Error: [%s].", transferID, err)
+		return err
+	}
+
+	err = s.repository.Delete(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to delete rejected review. Error: [%s].", transferID, err)
+		return err
+	}
+
+	s.logger.Infof("[%s] - Rejected transfer.", transferID)
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package reviews
+
+import (
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	transferID  = "0.0.123123-123321-420"
+	transferMsg = transfer.New(
+		transferID,
+		0,
+		1,
+		0,
+		"0xreceiver",
+		"0.0.123",
+		"0xwrapped00123",
+		"0.0.123",
+		"100")
+	review *entity.Review
+)
+
+func Test_GetPending(t *testing.T) {
+	s := setup()
+	mocks.MReviewRepository.On("GetPending").Return([]*entity.Review{review}, nil)
+
+	actual, err := s.GetPending()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Review{review}, actual)
+}
+
+func Test_Get(t *testing.T) {
+	s := setup()
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+
+	actual, err := s.Get(transferID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, review, actual)
+}
+
+func Test_Get_Approved(t *testing.T) {
+	s := setup()
+	review.Approved = true
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+
+	actual, err := s.Get(transferID)
+
+	assert.Nil(t, actual)
+	assert.Equal(t, service.ErrNotFound, err)
+}
+
+func Test_Approve(t *testing.T) {
+	s := setup()
+	expectedMessage := &queue.Message{Payload: transferMsg, Topic: constants.HederaTransferMessageSubmission}
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+	mocks.MReviewRepository.On("UpdateApproved", transferID).Return(nil)
+	mocks.MTransferRepository.On("ApprovePendingReview", transferID).Return(nil)
+	mocks.MQueue.On("Push", expectedMessage).Return(nil)
+
+	err := s.Approve(transferID)
+
+	assert.Nil(t, err)
+	mocks.MReviewRepository.AssertCalled(t, "UpdateApproved", transferID)
+	mocks.MTransferRepository.AssertCalled(t, "ApprovePendingReview", transferID)
+	mocks.MQueue.AssertCalled(t, "Push", expectedMessage)
+}
+
+func Test_Approve_NotFound(t *testing.T) {
+	s := setup()
+	mocks.MReviewRepository.On("Get", transferID).Return(nil, nil)
+
+	err := s.Approve(transferID)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Approve_UpdateFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+	mocks.MReviewRepository.On("UpdateApproved", transferID).Return(expectedErr)
+
+	err := s.Approve(transferID)
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "ApprovePendingReview", transferID)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Reject(t *testing.T) {
+	s := setup()
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+	mocks.MTransferRepository.On("RejectPendingReview", transferID).Return(nil)
+	mocks.MReviewRepository.On("Delete", transferID).Return(nil)
+
+	err := s.Reject(transferID)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "RejectPendingReview", transferID)
+	mocks.MReviewRepository.AssertCalled(t, "Delete", transferID)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Reject_StatusUpdateFails(t *testing.T) {
+	s := setup()
+	expectedErr := errors.New("some-error")
+	mocks.MReviewRepository.On("Get", transferID).Return(review, nil)
+	mocks.MTransferRepository.On("RejectPendingReview", transferID).Return(expectedErr)
+
+	err := s.Reject(transferID)
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MReviewRepository.AssertNotCalled(t, "Delete", transferID)
+}
+
+func setup() *Service {
+	mocks.Setup()
+	payloadType, payload, _ := queue.EncodePayload(transferMsg)
+	review = &entity.Review{
+		TransferID:  transferID,
+		Topic:       constants.HederaTransferMessageSubmission,
+		PayloadType: payloadType,
+		Payload:     payload,
+		Reason:      "amount [100] exceeds the maximum amount [10]",
+	}
+	return NewService(mocks.MReviewRepository, mocks.MTransferRepository, mocks.MQueue)
+}
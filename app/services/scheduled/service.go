@@ -1,10 +1,12 @@
 package scheduled
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	hederahelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -17,13 +19,15 @@ type Service struct {
 	payerAccount     hedera.AccountID
 	hederaNodeClient client.HederaNode
 	mirrorNodeClient client.MirrorNode
+	transferEvents   service.TransferEvents
 	logger           *log.Entry
 }
 
 func New(
 	payerAccount string,
 	hederaNodeClient client.HederaNode,
-	mirrorNodeClient client.MirrorNode) *Service {
+	mirrorNodeClient client.MirrorNode,
+	transferEvents service.TransferEvents) *Service {
 	payer, err := hedera.AccountIDFromString(payerAccount)
 	if err != nil {
 		log.Fatalf("Invalid payer account: [%s].", payerAccount)
@@ -33,12 +37,14 @@ func New(
 		payerAccount:     payer,
 		hederaNodeClient: hederaNodeClient,
 		mirrorNodeClient: mirrorNodeClient,
+		transferEvents:   transferEvents,
 		logger:           config.GetLoggerFor("Scheduled Service"),
 	}
 }
 
 // ExecuteScheduledTransferTransaction submits a scheduled transaction and executes provided functions when necessary
 func (s *Service) ExecuteScheduledTransferTransaction(
+	ctx context.Context,
 	id, nativeAsset string,
 	transfers []transfer.Hedera,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
@@ -50,7 +56,7 @@ func (s *Service) ExecuteScheduledTransferTransaction(
 		}
 		return
 	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
 		return
@@ -59,6 +65,7 @@ func (s *Service) ExecuteScheduledTransferTransaction(
 
 // ExecuteScheduledNftTransferTransaction submits a scheduled nft transaction and executes provided functions when necessary
 func (s *Service) ExecuteScheduledNftTransferTransaction(
+	ctx context.Context,
 	id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftTransferTransaction(nftID, s.payerAccount, sender, receiving, id)
@@ -69,7 +76,7 @@ func (s *Service) ExecuteScheduledNftTransferTransaction(
 		}
 		return
 	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
 		return
@@ -96,7 +103,7 @@ func (s *Service) executeScheduledTransfersTransaction(id, nativeAsset string, t
 	return transactionResponse, err
 }
 
-func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (s *Service) ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	transactionResponse, err := s.executeScheduledTokenMintTransaction(id, asset, amount)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to submit scheduled mint transaction. Error [%s].", id, err)
@@ -107,7 +114,7 @@ func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64
 		return
 	}
 
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled mint transaction. Error [%s].", id, err)
 		*status <- sync.FAIL
@@ -115,7 +122,7 @@ func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64
 	}
 }
 
-func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (s *Service) ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	transactionResponse, err := s.executeScheduledTokenBurnTransaction(id, asset, amount)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to submit scheduled burn transaction. Error [%s].", id, err)
@@ -126,7 +133,7 @@ func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64
 		return
 	}
 
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled burn transaction. Error [%s].", id, err)
 		*status <- sync.FAIL
@@ -134,6 +141,60 @@ func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64
 	}
 }
 
+// ExecuteScheduledNftMintTransaction submits a scheduled NFT mint transaction with the given metadata and executes provided functions when necessary
+func (s *Service) ExecuteScheduledNftMintTransaction(ctx context.Context, id, asset string, metadata []byte, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	tokenID, err := hedera.TokenIDFromString(asset)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to parse token [%s] to TokenID. Error [%s].", id, asset, err)
+		*status <- sync.FAIL
+		return
+	}
+
+	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftMintTransaction(tokenID, metadata, s.payerAccount, id)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to submit scheduled NFT mint transaction. Error [%s].", id, err)
+		if transactionResponse != nil {
+			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
+		}
+		*status <- sync.FAIL
+		return
+	}
+
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create/sign scheduled NFT mint transaction. Error [%s].", id, err)
+		*status <- sync.FAIL
+		return
+	}
+}
+
+// ExecuteScheduledNftBurnTransaction submits a scheduled burn transaction of the given NFT serial number and executes provided functions when necessary
+func (s *Service) ExecuteScheduledNftBurnTransaction(ctx context.Context, id, asset string, serialNum int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	tokenID, err := hedera.TokenIDFromString(asset)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to parse token [%s] to TokenID. Error [%s].", id, asset, err)
+		*status <- sync.FAIL
+		return
+	}
+
+	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftBurnTransaction(tokenID, serialNum, s.payerAccount, id)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to submit scheduled NFT burn transaction. Error [%s].", id, err)
+		if transactionResponse != nil {
+			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
+		}
+		*status <- sync.FAIL
+		return
+	}
+
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create/sign scheduled NFT burn transaction. Error [%s].", id, err)
+		*status <- sync.FAIL
+		return
+	}
+}
+
 func (s *Service) executeScheduledTokenMintTransaction(id, asset string, amount int64) (*hedera.TransactionResponse, error) {
 	var tokenID hedera.TokenID
 	var transactionResponse *hedera.TransactionResponse
@@ -168,7 +229,7 @@ func (s *Service) executeScheduledTokenBurnTransaction(id string, asset string,
 	return transactionResponse, err
 }
 
-func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.TransactionResponse, id string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) error {
+func (s *Service) createOrSignScheduledTransaction(ctx context.Context, transactionResponse *hedera.TransactionResponse, id string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) error {
 	scheduledTxID := hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String())
 	s.logger.Infof("[%s] - Successfully submitted scheduled transaction [%s].",
 		id,
@@ -201,12 +262,22 @@ func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.T
 
 	onMinedSuccess := func() {
 		onSuccess(transactionID)
+		s.transferEvents.Publish(service.TransferEvent{
+			TransferID:    id,
+			Type:          service.TransferEventScheduleCompleted,
+			TransactionId: transactionID,
+		})
 	}
 
 	onMinedFail := func() {
 		onFail(transactionID)
+		s.transferEvents.Publish(service.TransferEvent{
+			TransferID:    id,
+			Type:          service.TransferEventScheduleFailed,
+			TransactionId: transactionID,
+		})
 	}
-	go s.mirrorNodeClient.WaitForScheduledTransaction(transactionID, onMinedSuccess, onMinedFail)
+	go s.mirrorNodeClient.WaitForScheduledTransaction(ctx, transactionID, onMinedSuccess, onMinedFail)
 	return nil
 }
 
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"strings"
+	"sync"
+)
+
+// List is the file-backed screening provider. The file lists a Hedera account ID or an EVM address per line.
+// Empty lines and lines starting with # are ignored
+type List struct {
+	mutex   *sync.RWMutex
+	entries map[string]bool
+}
+
+func NewList() *List {
+	return &List{
+		mutex:   &sync.RWMutex{},
+		entries: make(map[string]bool),
+	}
+}
+
+func (l *List) IsDenied(party string) (bool, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return l.entries[party], nil
+}
+
+// Load replaces the entries of the list. The entries are kept unchanged if any line of the content is invalid
+func (l *List) Load(content []byte) error {
+	entries := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		party, err := normalizeEntry(entry)
+		if err != nil {
+			return errors.New(fmt.Sprintf("line %d: [%s] is neither a Hedera account ID nor an EVM address", line, entry))
+		}
+		entries[party] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.entries = entries
+	return nil
+}
+
+func normalizeEntry(entry string) (string, error) {
+	if common.IsHexAddress(entry) {
+		return common.HexToAddress(entry).String(), nil
+	}
+
+	account, err := hedera.AccountIDFromString(entry)
+	if err != nil {
+		return "", err
+	}
+	return account.String(), nil
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const listContent = `# Denied parties
+0.0.1001
+
+0xabcdef0000000000000000000000000000000001
+`
+
+func Test_Load(t *testing.T) {
+	list := NewList()
+
+	err := list.Load([]byte(listContent))
+
+	assert.Nil(t, err)
+	for party, expected := range map[string]bool{
+		"0.0.1001": true,
+		"0xABCdef0000000000000000000000000000000001": true,
+		"0.0.1002": false,
+		"0xabcdef0000000000000000000000000000000002": false,
+	} {
+		denied, err := list.IsDenied(normalizeParty(party))
+		assert.Nil(t, err)
+		assert.Equal(t, expected, denied, party)
+	}
+}
+
+func Test_Load_Invalid(t *testing.T) {
+	list := NewList()
+	list.Load([]byte(listContent))
+
+	err := list.Load([]byte("0.0.1002\nnot-a-party\n"))
+
+	assert.EqualError(t, err, "line 2: [not-a-party] is neither a Hedera account ID nor an EVM address")
+	denied, _ := list.IsDenied("0.0.1001")
+	assert.True(t, denied)
+	denied, _ = list.IsDenied("0.0.1002")
+	assert.False(t, denied)
+}
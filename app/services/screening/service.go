@@ -0,0 +1,166 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"strings"
+	"time"
+)
+
+type Service struct {
+	provider           service.ScreeningProvider
+	transferRepository repository.Transfer
+	hitRepository      repository.ScreeningHit
+	evmClients         map[uint64]client.EVM
+	hitsCounter        prometheus.Counter
+	logger             *log.Entry
+}
+
+// NewService creates the screening service. Transfers are not screened if the provider is nil
+func NewService(
+	provider service.ScreeningProvider,
+	transferRepository repository.Transfer,
+	hitRepository repository.ScreeningHit,
+	evmClients map[uint64]client.EVM,
+	prometheusService service.Prometheus) *Service {
+	s := &Service{
+		provider:           provider,
+		transferRepository: transferRepository,
+		hitRepository:      hitRepository,
+		evmClients:         evmClients,
+		logger:             config.GetLoggerFor("Screening Service"),
+	}
+
+	if prometheusService.GetIsMonitoringEnabled() {
+		s.hitsCounter = prometheusService.CreateCounterIfNotExists(prometheus.CounterOpts{
+			Name: constants.ScreeningHitsName,
+			Help: constants.ScreeningHitsHelp,
+		})
+	}
+
+	return s
+}
+
+func (s *Service) Screen(tm transfer.Transfer) (bool, error) {
+	if s.provider == nil {
+		return false, nil
+	}
+
+	sender, err := s.sender(tm)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to resolve the sender. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	var hits []*entity.ScreeningHit
+	for _, hit := range []*entity.ScreeningHit{
+		{TransferID: tm.TransactionId, Party: sender, Role: entity.ScreeningRoleSender},
+		{TransferID: tm.TransactionId, Party: normalizeParty(tm.Receiver), Role: entity.ScreeningRoleReceiver},
+	} {
+		denied, err := s.provider.IsDenied(hit.Party)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to screen [%s]. Error: [%s].", tm.TransactionId, hit.Party, err)
+			return false, err
+		}
+		if denied {
+			hits = append(hits, hit)
+		}
+	}
+
+	if len(hits) == 0 {
+		return false, nil
+	}
+
+	for _, hit := range hits {
+		hit.CreatedAt = time.Now().UnixNano()
+		err = s.hitRepository.Create(hit)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to record screening hit of [%s]. Error: [%s].", tm.TransactionId, hit.Party, err)
+			return false, err
+		}
+		if s.hitsCounter != nil {
+			s.hitsCounter.Inc()
+		}
+		s.logger.Warnf("[%s] - The %s [%s] is on the screening list.", tm.TransactionId, strings.ToLower(hit.Role), hit.Party)
+	}
+
+	err = s.transferRepository.UpdateStatusBlocked(tm.TransactionId)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update status to [BLOCKED]. Error: [%s].", tm.TransactionId, err)
+		return false, err
+	}
+
+	s.logger.Warnf("[%s] - Blocked the transfer.", tm.TransactionId)
+	return true, nil
+}
+
+func (s *Service) GetHits() ([]*entity.ScreeningHit, error) {
+	hits, err := s.hitRepository.GetAll()
+	if err != nil {
+		s.logger.Errorf("Failed to query screening hits. Error: [%s].", err)
+		return nil, err
+	}
+
+	return hits, nil
+}
+
+// sender returns the payer account of Hedera transfers and the sender of the EVM transaction, which emitted the event of EVM transfers
+func (s *Service) sender(tm transfer.Transfer) (string, error) {
+	id := strings.Split(tm.TransactionId, "-")[0]
+	if tm.SourceChainId == constants.HederaNetworkId {
+		return id, nil
+	}
+
+	evmClient, ok := s.evmClients[tm.SourceChainId]
+	if !ok {
+		return "", errors.New(fmt.Sprintf("no EVM client for chain [%d]", tm.SourceChainId))
+	}
+
+	tx, _, err := evmClient.GetClient().TransactionByHash(context.Background(), common.HexToHash(id))
+	if err != nil {
+		return "", err
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(new(big.Int).SetUint64(tm.SourceChainId)), tx)
+	if err != nil {
+		return "", err
+	}
+	return sender.String(), nil
+}
+
+// normalizeParty checksums EVM addresses, so that they match the entries of the screening list
+func normalizeParty(party string) string {
+	if common.IsHexAddress(party) {
+		return common.HexToAddress(party).String()
+	}
+	return party
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+)
+
+var (
+	hederaTransfer = transfer.New(
+		"0.0.1001-1631092491-483966000",
+		0,
+		80001,
+		0,
+		"0xabcdef0000000000000000000000000000000001",
+		"0.0.123",
+		"0xwrapped00123",
+		"0.0.123",
+		"100")
+	evmTransferID = "0x1e1f3d2fe66e2f5bc17cac2c1d6d6f33e6d4d9c5c7f2e2d4f7c17e4e3e1d4f21-3"
+	evmTransfer   = transfer.New(
+		evmTransferID,
+		80001,
+		0,
+		0,
+		"0.0.2002",
+		"0xwrapped00123",
+		"0.0.123",
+		"0.0.123",
+		"100")
+)
+
+func Test_Screen_Disabled(t *testing.T) {
+	s := setup(nil)
+
+	blocked, err := s.Screen(*hederaTransfer)
+
+	assert.Nil(t, err)
+	assert.False(t, blocked)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusBlocked", mock.Anything)
+}
+
+func Test_Screen_NotDenied(t *testing.T) {
+	s := setup(list("0.0.3003"))
+
+	blocked, err := s.Screen(*hederaTransfer)
+
+	assert.Nil(t, err)
+	assert.False(t, blocked)
+	mocks.MScreeningHitRepository.AssertNotCalled(t, "Create", mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusBlocked", mock.Anything)
+}
+
+func Test_Screen_HederaSender(t *testing.T) {
+	s := setup(list("0.0.1001"))
+	mocks.MScreeningHitRepository.On("Create", mock.MatchedBy(func(hit *entity.ScreeningHit) bool {
+		return hit.TransferID == hederaTransfer.TransactionId && hit.Party == "0.0.1001" && hit.Role == entity.ScreeningRoleSender
+	})).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusBlocked", hederaTransfer.TransactionId).Return(nil)
+
+	blocked, err := s.Screen(*hederaTransfer)
+
+	assert.Nil(t, err)
+	assert.True(t, blocked)
+	mocks.MScreeningHitRepository.AssertNumberOfCalls(t, "Create", 1)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusBlocked", hederaTransfer.TransactionId)
+}
+
+func Test_Screen_EvmReceiver(t *testing.T) {
+	s := setup(list("0xABCDEF0000000000000000000000000000000001"))
+	mocks.MScreeningHitRepository.On("Create", mock.MatchedBy(func(hit *entity.ScreeningHit) bool {
+		return hit.Party == common.HexToAddress(hederaTransfer.Receiver).String() && hit.Role == entity.ScreeningRoleReceiver
+	})).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusBlocked", hederaTransfer.TransactionId).Return(nil)
+
+	blocked, err := s.Screen(*hederaTransfer)
+
+	assert.Nil(t, err)
+	assert.True(t, blocked)
+}
+
+func Test_Screen_EvmSender(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	tx, _ := types.SignTx(
+		types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil),
+		types.LatestSignerForChainID(big.NewInt(80001)),
+		key)
+	s := setup(list(sender.String()))
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionByHash", mock.Anything, common.HexToHash(evmTransferID[:66])).Return(tx, false, nil)
+	mocks.MScreeningHitRepository.On("Create", mock.MatchedBy(func(hit *entity.ScreeningHit) bool {
+		return hit.Party == sender.String() && hit.Role == entity.ScreeningRoleSender
+	})).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusBlocked", evmTransferID).Return(nil)
+
+	blocked, err := s.Screen(*evmTransfer)
+
+	assert.Nil(t, err)
+	assert.True(t, blocked)
+}
+
+func Test_Screen_EvmSenderFails(t *testing.T) {
+	s := setup(list("0.0.2002"))
+	expectedErr := errors.New("some-error")
+	mocks.MEVMClient.On("GetClient").Return(mocks.MEVMCoreClient)
+	mocks.MEVMCoreClient.On("TransactionByHash", mock.Anything, mock.Anything).Return(nil, false, expectedErr)
+
+	blocked, err := s.Screen(*evmTransfer)
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, blocked)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusBlocked", mock.Anything)
+}
+
+func Test_Screen_UpdateStatusFails(t *testing.T) {
+	s := setup(list("0.0.1001"))
+	expectedErr := errors.New("some-error")
+	mocks.MScreeningHitRepository.On("Create", mock.Anything).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusBlocked", hederaTransfer.TransactionId).Return(expectedErr)
+
+	blocked, err := s.Screen(*hederaTransfer)
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, blocked)
+}
+
+func list(entries string) *List {
+	l := NewList()
+	l.Load([]byte(entries))
+	return l
+}
+
+func setup(list *List) *Service {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	evmClients := map[uint64]client.EVM{80001: mocks.MEVMClient}
+	if list == nil {
+		return NewService(nil, mocks.MTransferRepository, mocks.MScreeningHitRepository, evmClients, mocks.MPrometheusService)
+	}
+	return NewService(list, mocks.MTransferRepository, mocks.MScreeningHitRepository, evmClients, mocks.MPrometheusService)
+}
@@ -20,6 +20,7 @@ import (
 	"crypto/ecdsa"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
 	log "github.com/sirupsen/logrus"
 	"math/big"
 )
@@ -36,6 +37,15 @@ func NewEVMSigner(privateKey string) *Signer {
 	return &Signer{privateKey: pk}
 }
 
+// NewEVMSignerFromKeystore creates a signer with the private key decrypted from the Ethereum V3 keystore at the given path
+func NewEVMSignerFromKeystore(path, passphrase string) *Signer {
+	pk, err := keystore.ReadEVM(path, passphrase)
+	if err != nil {
+		log.Fatalf("Failed to decrypt EVM keystore [%s]. Error: [%s]", path, err)
+	}
+	return &Signer{privateKey: pk}
+}
+
 func (s *Signer) Sign(msg []byte) ([]byte, error) {
 	signature, err := crypto.Sign(msg, s.privateKey)
 	if err != nil {
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	publicKeysPath = "/api/v1/secp256k1/publicKeys"
+	signPath       = "/api/v1/secp256k1/sign/"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// SignRequest is the body of a request to the sign endpoint of the remote signer
+type SignRequest struct {
+	// Digest is the hex encoded 32 byte digest to be signed as is, without hashing or prefixing it
+	Digest string `json:"digest"`
+}
+
+// Signer is a service.Signer, which delegates signing to a remote signing service, so that the
+// private key is kept out of the validator process. The signing service exposes the following API:
+// GET /api/v1/secp256k1/publicKeys returns the hex encoded public keys it holds and
+// POST /api/v1/secp256k1/sign/{publicKey} returns the hex encoded signature of the given digest.
+// The API is not Web3Signer's eth1 API, which hashes the data before signing it and cannot sign a digest as is.
+type Signer struct {
+	url       string
+	publicKey string
+	address   common.Address
+	client    *http.Client
+	logger    *log.Entry
+}
+
+// NewSigner creates a remote signer for the key with the given address
+func NewSigner(c config.Signer, address string) *Signer {
+	logger := config.GetLoggerFor(fmt.Sprintf("Remote Signer [%s]", address))
+	if !common.IsHexAddress(address) {
+		logger.Fatalf("Invalid signer address provided: [%s]", address)
+	}
+
+	client, err := newHttpClient(c)
+	if err != nil {
+		logger.Fatalf("Failed to configure the remote signer client. Error: [%s]", err)
+	}
+
+	s := &Signer{
+		url:     strings.TrimSuffix(c.Url, "/"),
+		address: common.HexToAddress(address),
+		client:  client,
+		logger:  logger,
+	}
+
+	s.publicKey, err = s.findPublicKey()
+	if err != nil {
+		logger.Fatalf("Failed to find the key in the remote signer. Error: [%s]", err)
+	}
+
+	return s
+}
+
+func newHttpClient(c config.Signer) (*http.Client, error) {
+	timeout := c.Timeout * time.Second
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	tlsConfig := &tls.Config{}
+	if c.Tls.CaFile != "" {
+		ca, err := ioutil.ReadFile(c.Tls.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New(fmt.Sprintf("no certificates found in [%s]", c.Tls.CaFile))
+		}
+	}
+	if c.Tls.CertFile != "" || c.Tls.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.Tls.CertFile, c.Tls.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
+
+// findPublicKey returns the public key held by the remote signer, which corresponds to the address of the signer
+func (s *Signer) findPublicKey() (string, error) {
+	body, err := s.do(http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var publicKeys []string
+	err = json.Unmarshal(body, &publicKeys)
+	if err != nil {
+		return "", err
+	}
+
+	for _, publicKey := range publicKeys {
+		raw, err := hexutil.Decode(publicKey)
+		if err != nil {
+			continue
+		}
+		key, err := crypto.UnmarshalPubkey(raw)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*key) == s.address {
+			return publicKey, nil
+		}
+	}
+
+	return "", errors.New(fmt.Sprintf("remote signer does not hold the key of [%s]", s.address))
+}
+
+// Sign signs the given digest, returning the signature with recovery id 27 or 28
+func (s *Signer) Sign(msg []byte) ([]byte, error) {
+	request, err := json.Marshal(SignRequest{Digest: hexutil.Encode(msg)})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.do(http.MethodPost, signPath+s.publicKey, request)
+	if err != nil {
+		s.logger.Errorf("Failed to sign [%s]. Error: [%s]", hexutil.Encode(msg), err)
+		return nil, err
+	}
+
+	signature, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(body)), "\""))
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, errors.New(fmt.Sprintf("invalid signature length [%d]", len(signature)))
+	}
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	// Guards against a misconfigured signer, signing with another key
+	recovered, err := crypto.SigToPub(msg, recoverable(signature))
+	if err != nil {
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(*recovered) != s.address {
+		return nil, errors.New(fmt.Sprintf("signature recovers to [%s] instead of [%s]", crypto.PubkeyToAddress(*recovered), s.address))
+	}
+
+	return signature, nil
+}
+
+// NewKeyTransactor returns transact options, which sign the transactions through the remote signer
+func (s *Signer) NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error) {
+	if chainId == nil {
+		return nil, bind.ErrNoChainID
+	}
+
+	signer := types.LatestSignerForChainID(chainId)
+	return &bind.TransactOpts{
+		From: s.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.address {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			signature, err := s.Sign(signer.Hash(tx).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(signer, recoverable(signature))
+		},
+		Context: context.Background(),
+	}, nil
+}
+
+func (s *Signer) Address() string {
+	return s.address.String()
+}
+
+// recoverable returns a copy of the given signature with recovery id 0 or 1
+func recoverable(signature []byte) []byte {
+	result := make([]byte, len(signature))
+	copy(result, signature)
+	result[crypto.RecoveryIDOffset] -= 27
+	return result
+}
+
+func (s *Signer) do(method, path string, body []byte) ([]byte, error) {
+	request, err := http.NewRequest(method, s.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.New(fmt.Sprintf("remote signer responded with status [%d]: [%s]", response.StatusCode, strings.TrimSpace(string(responseBody))))
+	}
+
+	return responseBody, nil
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	remote_signer "github.com/limechain/hedera-eth-bridge-validator/test/remote-signer"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+var (
+	privateKey = "bb9282e2ba6cb4b8e8b5a7ae8e5c1c4b1d6bbe2b1dc8ad05db6e1a2db09b1b51"
+	digest     = crypto.Keccak256([]byte("message"))
+)
+
+func setup(t *testing.T, handler http.Handler) (*Signer, *ecdsa.PrivateKey, func()) {
+	key, err := crypto.HexToECDSA(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handler == nil {
+		handler = remote_signer.NewServer(key)
+	}
+	server := httptest.NewServer(handler)
+
+	return NewSigner(config.Signer{Url: server.URL}, crypto.PubkeyToAddress(key.PublicKey).String()), key, server.Close
+}
+
+func Test_NewSigner(t *testing.T) {
+	s, key, closeServer := setup(t, nil)
+	defer closeServer()
+
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey).String(), s.Address())
+	assert.Equal(t, hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)), s.publicKey)
+}
+
+func Test_FindPublicKey_NotHeld(t *testing.T) {
+	s, _, closeServer := setup(t, nil)
+	defer closeServer()
+	s.address = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	publicKey, err := s.findPublicKey()
+
+	assert.Empty(t, publicKey)
+	assert.NotNil(t, err)
+}
+
+func Test_Sign(t *testing.T) {
+	s, _, closeServer := setup(t, nil)
+	defer closeServer()
+	expected, err := evm.NewEVMSigner(privateKey).Sign(digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature, err := s.Sign(digest)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expected, signature)
+}
+
+func Test_Sign_WrongKey(t *testing.T) {
+	other, _ := crypto.GenerateKey()
+	key, _ := crypto.HexToECDSA(privateKey)
+	publicKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))
+	s, _, closeServer := setup(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`["` + publicKey + `"]`))
+			return
+		}
+		signature, _ := crypto.Sign(digest, other)
+		w.Write([]byte(hexutil.Encode(signature)))
+	}))
+	defer closeServer()
+
+	signature, err := s.Sign(digest)
+
+	assert.Nil(t, signature)
+	assert.NotNil(t, err)
+}
+
+// web3Signer is a stand-in behaving like the eth1 API of Web3Signer, which signs the keccak256 hash of the given data
+func web3Signer(key *ecdsa.PrivateKey) http.Handler {
+	publicKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth1/publicKeys":
+			w.Write([]byte(`["` + publicKey + `"]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/eth1/sign/"+publicKey:
+			var request struct {
+				Data string `json:"data"`
+			}
+			json.NewDecoder(r.Body).Decode(&request)
+			data, _ := hexutil.Decode(request.Data)
+			signature, _ := crypto.Sign(crypto.Keccak256(data), key)
+			signature[crypto.RecoveryIDOffset] += 27
+			w.Write([]byte(hexutil.Encode(signature)))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func Test_Web3Signer(t *testing.T) {
+	key, _ := crypto.HexToECDSA(privateKey)
+	server := httptest.NewServer(web3Signer(key))
+	defer server.Close()
+	s := &Signer{
+		url:       server.URL,
+		address:   crypto.PubkeyToAddress(key.PublicKey),
+		publicKey: hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)),
+		client:    http.DefaultClient,
+		logger:    config.GetLoggerFor("Remote Signer"),
+	}
+
+	publicKey, err := s.findPublicKey()
+	assert.Empty(t, publicKey)
+	assert.NotNil(t, err)
+
+	signature, err := s.Sign(digest)
+	assert.Nil(t, signature)
+	assert.NotNil(t, err)
+}
+
+func Test_Sign_HashedDigest(t *testing.T) {
+	key, _ := crypto.HexToECDSA(privateKey)
+	publicKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))
+	s, _, closeServer := setup(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`["` + publicKey + `"]`))
+			return
+		}
+		signature, _ := crypto.Sign(crypto.Keccak256(digest), key)
+		w.Write([]byte(hexutil.Encode(signature)))
+	}))
+	defer closeServer()
+
+	signature, err := s.Sign(digest)
+
+	assert.Nil(t, signature)
+	assert.NotNil(t, err)
+}
+
+func Test_Sign_ErrorResponse(t *testing.T) {
+	s, _, closeServer := setup(t, nil)
+	defer closeServer()
+	s.publicKey = "0x01"
+
+	signature, err := s.Sign(digest)
+
+	assert.Nil(t, signature)
+	assert.NotNil(t, err)
+}
+
+func Test_NewKeyTransactor(t *testing.T) {
+	s, key, closeServer := setup(t, nil)
+	defer closeServer()
+	chainId := big.NewInt(80001)
+	tx := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	opts, err := s.NewKeyTransactor(chainId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := opts.Signer(opts.From, tx)
+
+	assert.Nil(t, err)
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+}
+
+func Test_NewKeyTransactor_NotAuthorized(t *testing.T) {
+	s, _, closeServer := setup(t, nil)
+	defer closeServer()
+	tx := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	opts, err := s.NewKeyTransactor(big.NewInt(80001))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := opts.Signer(common.HexToAddress("0x1"), tx)
+
+	assert.Nil(t, signed)
+	assert.NotNil(t, err)
+}
+
+func Test_MutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certificates, err := remote_signer.NewCertificates(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig, err := certificates.ServerTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := crypto.HexToECDSA(privateKey)
+	server := httptest.NewUnstartedServer(remote_signer.NewServer(key))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+	address := crypto.PubkeyToAddress(key.PublicKey).String()
+
+	s := NewSigner(config.Signer{
+		Url: server.URL,
+		Tls: config.SignerTls{
+			CaFile:   certificates.CaFile,
+			CertFile: certificates.ClientCertFile,
+			KeyFile:  certificates.ClientKeyFile,
+		},
+	}, address)
+	signature, err := s.Sign(digest)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, signature)
+
+	withoutCertificate, err := newHttpClient(config.Signer{Tls: config.SignerTls{CaFile: certificates.CaFile}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.client = withoutCertificate
+	_, err = s.findPublicKey()
+	assert.NotNil(t, err)
+}
+
+func Test_NewHttpClient_MissingCertificate(t *testing.T) {
+	client, err := newHttpClient(config.Signer{Tls: config.SignerTls{CertFile: "missing.pem", KeyFile: "missing-key.pem"}})
+
+	assert.Nil(t, client)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+// subscriptionBuffer is the amount of events kept for a subscriber, which has not yet received them
+const subscriptionBuffer = 32
+
+type subscription struct {
+	transferID string
+	events     chan service.TransferEvent
+}
+
+type Service struct {
+	mutex         sync.Mutex
+	closed        bool
+	subscriptions map[*subscription]struct{}
+	logger        *log.Entry
+}
+
+func NewService() *Service {
+	return &Service{
+		subscriptions: make(map[*subscription]struct{}),
+		logger:        config.GetLoggerFor("Transfer Events Service"),
+	}
+}
+
+// Publish delivers the event to the subscribers of its transfer. Subscribers, which do not keep up, miss the event
+func (s *Service) Publish(event service.TransferEvent) {
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixNano()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for sub := range s.subscriptions {
+		if sub.transferID != event.TransferID {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			s.logger.Warnf("[%s] - Subscriber is not keeping up. Dropping [%s] event.", event.TransferID, event.Type)
+		}
+	}
+}
+
+// Subscribe returns the events of the given transfer and a function cancelling the subscription
+func (s *Service) Subscribe(transferID string) (<-chan service.TransferEvent, func()) {
+	sub := &subscription{
+		transferID: transferID,
+		events:     make(chan service.TransferEvent, subscriptionBuffer),
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		close(sub.events)
+		return sub.events, func() {}
+	}
+	s.subscriptions[sub] = struct{}{}
+
+	return sub.events, func() {
+		s.unsubscribe(sub)
+	}
+}
+
+// Close closes all subscriptions. Events published afterwards are dropped
+func (s *Service) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.closed = true
+	for sub := range s.subscriptions {
+		delete(s.subscriptions, sub)
+		close(sub.events)
+	}
+}
+
+func (s *Service) unsubscribe(sub *subscription) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.subscriptions[sub]; ok {
+		delete(s.subscriptions, sub)
+		close(sub.events)
+	}
+}
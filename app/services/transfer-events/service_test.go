@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var event = service.TransferEvent{
+	TransferID: "0.0.1-1-1",
+	Type:       service.TransferEventSignature,
+	Timestamp:  1,
+	Signer:     "0xsigner",
+}
+
+func Test_Publish(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(event.TransferID)
+	defer unsubscribe()
+	otherEvents, unsubscribeOther := s.Subscribe("0.0.1-2-2")
+	defer unsubscribeOther()
+
+	s.Publish(event)
+
+	assert.Equal(t, event, <-events)
+	assert.Len(t, otherEvents, 0)
+}
+
+func Test_Publish_SetsTimestamp(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(event.TransferID)
+	defer unsubscribe()
+
+	s.Publish(service.TransferEvent{TransferID: event.TransferID, Type: service.TransferEventMajority})
+
+	assert.NotZero(t, (<-events).Timestamp)
+}
+
+func Test_Publish_DropsEventsOfSlowSubscribers(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(event.TransferID)
+	defer unsubscribe()
+
+	for i := 0; i < subscriptionBuffer+1; i++ {
+		s.Publish(event)
+	}
+
+	assert.Len(t, events, subscriptionBuffer)
+}
+
+func Test_Unsubscribe(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(event.TransferID)
+
+	unsubscribe()
+	unsubscribe()
+	s.Publish(event)
+
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.Empty(t, s.subscriptions)
+}
+
+func Test_Close(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(event.TransferID)
+
+	s.Close()
+	unsubscribe()
+	s.Publish(event)
+
+	_, ok := <-events
+	assert.False(t, ok)
+
+	events, _ = s.Subscribe(event.TransferID)
+	_, ok = <-events
+	assert.False(t, ok)
+}
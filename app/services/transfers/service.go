@@ -17,7 +17,10 @@
 package transfers
 
 import (
+	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -39,10 +42,17 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// The default amount of transfers listed in a single page
+const defaultPageSize = 25
+
+// The maximum amount of transfers listed in a single page
+const maxPageSize = 100
+
 type Service struct {
 	logger             *log.Entry
 	hederaNode         client.HederaNode
@@ -51,14 +61,16 @@ type Service struct {
 	transferRepository repository.Transfer
 	scheduleRepository repository.Schedule
 	feeRepository      repository.Fee
+	evmEventRepository repository.EvmEvent
 	distributor        service.Distributor
 	feeService         service.Fee
 	scheduledService   service.Scheduled
 	messageService     service.Messages
 	prometheusService  service.Prometheus
+	transferEvents     service.TransferEvents
 	topicID            hedera.TopicID
 	bridgeAccountID    hedera.AccountID
-	hederaNftFees      map[string]int64
+	assets             config.Assets
 }
 
 func NewService(
@@ -68,14 +80,16 @@ func NewService(
 	transferRepository repository.Transfer,
 	scheduleRepository repository.Schedule,
 	feeRepository repository.Fee,
+	evmEventRepository repository.EvmEvent,
 	feeService service.Fee,
 	distributor service.Distributor,
 	topicID string,
 	bridgeAccount string,
-	hederaNftFees map[string]int64,
+	assets config.Assets,
 	scheduledService service.Scheduled,
 	messageService service.Messages,
 	prometheusService service.Prometheus,
+	transferEvents service.TransferEvents,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
@@ -94,14 +108,16 @@ func NewService(
 		transferRepository: transferRepository,
 		scheduleRepository: scheduleRepository,
 		feeRepository:      feeRepository,
+		evmEventRepository: evmEventRepository,
 		topicID:            tID,
 		feeService:         feeService,
 		distributor:        distributor,
 		bridgeAccountID:    bridgeAccountID,
 		scheduledService:   scheduledService,
 		messageService:     messageService,
-		hederaNftFees:      hederaNftFees,
+		assets:             assets,
 		prometheusService:  prometheusService,
+		transferEvents:     transferEvents,
 	}
 }
 
@@ -129,18 +145,89 @@ func (ts *Service) InitiateNewTransfer(tm model.Transfer) (*entity.Transfer, err
 
 	if dbTransaction != nil {
 		ts.logger.Infof("[%s] - Transaction already added", tm.TransactionId)
+		if dbTransaction.Status == status.Revoked {
+			return ts.reinstateIfCanonical(dbTransaction)
+		}
 		return dbTransaction, err
 	}
 
 	ts.logger.Debugf("[%s] - Adding new Transaction Record", tm.TransactionId)
+	tm.ConfigVersion = ts.assets.Version()
 	tx, err := ts.transferRepository.Create(&tm)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to create a transaction record. Error [%s].", tm.TransactionId, err)
 		return nil, err
 	}
+
+	if tm.SourceChainId != constants.HederaNetworkId {
+		tx, err = ts.revokeIfOrphaned(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ts.transferEvents.Publish(service.TransferEvent{
+		TransferID: tm.TransactionId,
+		Type:       service.TransferEventCreated,
+		Timestamp:  tx.CreatedAt,
+	})
+	return tx, nil
+}
+
+// revokeIfOrphaned revokes the given transfer if the EVM event it originates from was orphaned by a chain reorganisation
+func (ts *Service) revokeIfOrphaned(tx *entity.Transfer) (*entity.Transfer, error) {
+	orphaned, err := ts.isSourceOrphaned(tx.TransactionID)
+	if err != nil || !orphaned {
+		return tx, err
+	}
+
+	err = ts.transferRepository.UpdateStatusRevoked(tx.TransactionID)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to revoke transfer. Error [%s].", tx.TransactionID, err)
+		return nil, err
+	}
+
+	ts.logger.Warnf("[%s] - Revoked transfer originating from an orphaned EVM event.", tx.TransactionID)
+	tx.Status = status.Revoked
 	return tx, nil
 }
 
+// reinstateIfCanonical moves the given revoked transfer back to INITIAL
+// once the EVM event it originates from is part of the canonical chain again
+func (ts *Service) reinstateIfCanonical(tx *entity.Transfer) (*entity.Transfer, error) {
+	orphaned, err := ts.isSourceOrphaned(tx.TransactionID)
+	if err != nil || orphaned {
+		return tx, err
+	}
+
+	err = ts.transferRepository.ReinstateRevoked(tx.TransactionID)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to reinstate transfer. Error [%s].", tx.TransactionID, err)
+		return nil, err
+	}
+
+	ts.logger.Infof("[%s] - Reinstated transfer, which originates from an EVM event included in the canonical chain again.", tx.TransactionID)
+	tx.Status = status.Initial
+	return tx, nil
+}
+
+// isSourceOrphaned returns whether the EVM event the given transfer originates from is orphaned
+func (ts *Service) isSourceOrphaned(txId string) (bool, error) {
+	events, err := ts.evmEventRepository.GetByTransferID(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query EVM events. Error [%s].", txId, err)
+		return false, err
+	}
+
+	for _, e := range events {
+		if e.IsSource() {
+			return e.Orphaned, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRevert func()) {
 	onSuccess = func() {
 		ts.logger.Debugf("Authorisation Signature TX successfully executed for TX [%s]", txId)
@@ -152,7 +239,7 @@ func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRev
 	return onSuccess, onRevert
 }
 
-func (ts *Service) ProcessNativeTransfer(tm model.Transfer) error {
+func (ts *Service) ProcessNativeTransfer(ctx context.Context, tm model.Transfer) error {
 	intAmount, err := strconv.ParseInt(tm.Amount, 10, 64)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", tm.TransactionId, err)
@@ -165,7 +252,7 @@ func (ts *Service) ProcessNativeTransfer(tm model.Transfer) error {
 		remainder += fee - validFee
 	}
 
-	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
+	go ts.processFeeTransfer(ctx, validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
 
 	wrappedAmount := strconv.FormatInt(remainder, 10)
 
@@ -175,24 +262,24 @@ func (ts *Service) ProcessNativeTransfer(tm model.Transfer) error {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) ProcessNativeNftTransfer(tm model.Transfer) error {
-	fee := ts.hederaNftFees[tm.SourceAsset]
+func (ts *Service) ProcessNativeNftTransfer(ctx context.Context, tm model.Transfer) error {
+	fee := ts.assets.NftFee(tm.SourceAsset)
 	validFee := ts.distributor.ValidAmount(fee)
 
-	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
+	go ts.processFeeTransfer(ctx, validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
 
 	signatureMessage, err := ts.messageService.SignNftMessage(tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) ProcessWrappedTransfer(tm model.Transfer) error {
+func (ts *Service) ProcessWrappedTransfer(ctx context.Context, tm model.Transfer) error {
 	amount, err := big_numbers.ToBigInt(tm.Amount)
 	if err != nil {
 		return err
@@ -205,29 +292,106 @@ func (ts *Service) ProcessWrappedTransfer(tm model.Transfer) error {
 	status := make(chan string)
 	onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.TransactionId, &status)
 	onTokenBurnSuccess, onTokenBurnFail := ts.scheduledBurnTxMinedCallbacks(&status)
-	ts.scheduledService.ExecuteScheduledBurnTransaction(tm.TransactionId, tm.SourceAsset, properAmount.Int64(), &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
+	ts.scheduledService.ExecuteScheduledBurnTransaction(ctx, tm.TransactionId, tm.SourceAsset, properAmount.Int64(), &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
 
-statusBlocker:
-	for {
-		switch <-status {
-		case syncHelper.DONE:
-			ts.logger.Debugf("[%s] - Proceeding to sign and submit unlock permission messages.", tm.TransactionId)
-			break statusBlocker
-		case syncHelper.FAIL:
-			ts.logger.Errorf("[%s] - Failed to await the execution of Scheduled Burn Transaction.", tm.TransactionId)
-			return errors.New("failed-scheduled-burn")
-		}
+	err = ts.awaitBurn(ctx, tm.TransactionId, &status)
+	if err != nil {
+		return err
 	}
+	ts.logger.Debugf("[%s] - Proceeding to sign and submit unlock permission messages.", tm.TransactionId)
 
 	signatureMessage, err := ts.messageService.SignFungibleMessage(tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, signatureMessageBytes []byte) error {
+func (ts *Service) ProcessWrappedNftTransfer(ctx context.Context, tm model.Transfer) error {
+	status := make(chan string)
+	onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.TransactionId, &status)
+	onTokenBurnSuccess, onTokenBurnFail := ts.scheduledBurnTxMinedCallbacks(&status)
+	ts.scheduledService.ExecuteScheduledNftBurnTransaction(ctx, tm.TransactionId, tm.SourceAsset, tm.WrappedSerialNum, &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
+
+	err := ts.awaitBurn(ctx, tm.TransactionId, &status)
+	if err != nil {
+		return err
+	}
+	ts.logger.Debugf("[%s] - Proceeding to sign and submit NFT unlock permission messages.", tm.TransactionId)
+
+	signatureMessage, err := ts.messageService.SignNftMessage(tm)
+	if err != nil {
+		return err
+	}
+
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
+}
+
+// ProcessErc1155Transfer burns every wrapped HTS token of the ERC-1155 batch, one at a time,
+// and then submits the signed unlock permission message for the whole batch
+func (ts *Service) ProcessErc1155Transfer(ctx context.Context, tm model.Transfer) error {
+	status := make(chan string)
+	for _, item := range tm.Erc1155Items {
+		amount, err := strconv.ParseInt(item.Amount, 10, 64)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to parse amount [%s] of token ID [%d]. Error [%s].", tm.TransactionId, item.Amount, item.TokenId, err)
+			return err
+		}
+
+		onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.TransactionId, &status)
+		onTokenBurnSuccess, onTokenBurnFail := ts.scheduledBurnTxMinedCallbacks(&status)
+		ts.scheduledService.ExecuteScheduledBurnTransaction(ctx, tm.TransactionId, item.WrappedAsset, amount, &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
+
+		err = ts.awaitBurn(ctx, tm.TransactionId, &status)
+		if err != nil {
+			return err
+		}
+		ts.logger.Debugf("[%s] - Burned [%s] of token ID [%d].", tm.TransactionId, item.Amount, item.TokenId)
+	}
+
+	ts.logger.Debugf("[%s] - Proceeding to sign and submit ERC-1155 unlock permission messages.", tm.TransactionId)
+	signatureMessage, err := ts.messageService.SignErc1155Message(tm)
+	if err != nil {
+		return err
+	}
+
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
+}
+
+// LockedNftTokenId returns the token ID of the EVM native NFT, which is locked for the given wrapped Hedera NFT
+func (ts *Service) LockedNftTokenId(wrappedAsset string, serialNum int64) (int64, error) {
+	lockTransfer, err := ts.transferRepository.GetByWrappedNft(wrappedAsset, serialNum)
+	if err != nil {
+		ts.logger.Errorf("Failed to query the transfer, which minted [%s] - [%d]. Error: [%s].", wrappedAsset, serialNum, err)
+		return 0, err
+	}
+	if lockTransfer == nil {
+		return 0, service.ErrNotFound
+	}
+	return lockTransfer.SerialNumber, nil
+}
+
+// awaitBurn blocks until the scheduled burn reports its outcome on the given status channel or the context is done
+func (ts *Service) awaitBurn(ctx context.Context, transferID string, status *chan string) error {
+	for {
+		select {
+		case result := <-*status:
+			switch result {
+			case syncHelper.DONE:
+				return nil
+			case syncHelper.FAIL:
+				ts.logger.Errorf("[%s] - Failed to await the execution of Scheduled Burn Transaction.", transferID)
+				return errors.New("failed-scheduled-burn")
+			}
+		case <-ctx.Done():
+			ts.logger.Errorf("[%s] - Stopped awaiting the execution of Scheduled Burn Transaction. Error: [%s]", transferID, ctx.Err())
+			return ctx.Err()
+		}
+	}
+}
+
+func (ts *Service) submitTopicMessageAndWaitForTransaction(ctx context.Context, transferID string, signatureMessageBytes []byte) error {
 	messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(
 		ts.topicID,
 		signatureMessageBytes)
@@ -239,11 +403,11 @@ func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, si
 	// Attach update callbacks on Signature HCS Message
 	ts.logger.Infof("[%s] - Submitted signature on Topic [%s]", transferID, ts.topicID)
 	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(transferID)
-	ts.mirrorNode.WaitForTransaction(hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	ts.mirrorNode.WaitForTransaction(ctx, hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
-func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string) {
+func (ts *Service) processFeeTransfer(ctx context.Context, totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string) {
 
 	transfers, err := ts.distributor.CalculateMemberDistribution(totalFee)
 	if err != nil {
@@ -275,7 +439,7 @@ func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChain
 		onExecutionSuccess, onExecutionFail := ts.scheduledTxExecutionCallbacks(transferID, strconv.FormatInt(fee, 10))
 		onSuccess, onFail := ts.scheduledTxMinedCallbacks(feeOutParams, splitTransfer)
 
-		ts.scheduledService.ExecuteScheduledTransferTransaction(transferID, nativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+		ts.scheduledService.ExecuteScheduledTransferTransaction(ctx, transferID, nativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	}
 
 	if ts.prometheusService.GetIsMonitoringEnabled() {
@@ -510,6 +674,7 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 
 	transferData := service.TransferData{
 		IsNft:         t.IsNft,
+		IsErc1155:     t.IsErc1155,
 		Recipient:     t.Receiver,
 		RouterAddress: ts.contractServices[t.TargetChainID].Address().String(),
 		SourceChainId: t.SourceChainID,
@@ -535,6 +700,15 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 	transferData.Signatures = signatures
 	transferData.Majority = reachedMajority
 
+	if t.IsErc1155 {
+		tokenIds, amounts := erc1155Batch(t)
+		return service.Erc1155TransferData{
+			TransferData: transferData,
+			TokenIds:     tokenIds,
+			Amounts:      amounts,
+		}, nil
+	}
+
 	if !t.IsNft {
 		signedAmount := t.Amount
 		if t.NativeChainID == constants.HederaNetworkId {
@@ -563,3 +737,241 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 		Metadata:     t.Metadata,
 	}, nil
 }
+
+// TransferTimeline returns the lifecycle events of the given transfer, oldest first
+func (ts *Service) TransferTimeline(txId string) (*service.TransferTimeline, error) {
+	t, err := ts.transferRepository.GetWithPreloads(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query Transfer with preloads. Error: [%s].", txId, err)
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, service.ErrNotFound
+	}
+
+	evmEvents, err := ts.evmEventRepository.GetByTransferID(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query EVM events. Error: [%s].", txId, err)
+		return nil, err
+	}
+
+	events := []service.TimelineEvent{
+		{
+			Type:      service.TimelineEventCreated,
+			Timestamp: t.CreatedAt,
+		},
+	}
+	for _, m := range t.Messages {
+		events = append(events, service.TimelineEvent{
+			Type:      service.TimelineEventSignature,
+			Timestamp: m.TransactionTimestamp,
+			Signer:    m.Signer,
+		})
+	}
+	for _, f := range t.Fees {
+		events = append(events, service.TimelineEvent{
+			Type:          service.TimelineEventFee,
+			Timestamp:     ts.transactionTimestamp(txId, f.TransactionID),
+			Status:        f.Status,
+			TransactionId: f.TransactionID,
+			ScheduleId:    f.ScheduleID,
+			Amount:        f.Amount,
+		})
+	}
+	for _, s := range t.Schedules {
+		events = append(events, service.TimelineEvent{
+			Type:          service.TimelineEventSchedule,
+			Timestamp:     ts.transactionTimestamp(txId, s.TransactionID),
+			Status:        s.Status,
+			TransactionId: s.TransactionID,
+			ScheduleId:    s.ScheduleID,
+			Operation:     s.Operation,
+		})
+	}
+	for _, e := range evmEvents {
+		if e.Orphaned {
+			continue
+		}
+		events = append(events, service.TimelineEvent{
+			Type:            timelineEventTypes[e.Name],
+			Timestamp:       e.Timestamp,
+			ChainId:         e.ChainID,
+			TransactionHash: e.TransactionHash,
+			BlockNumber:     e.BlockNumber,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	return &service.TransferTimeline{
+		TransactionId: t.TransactionID,
+		Status:        t.Status,
+		Events:        events,
+	}, nil
+}
+
+// timelineEventTypes maps the names of the EVM events to their timeline types
+var timelineEventTypes = map[string]string{
+	entity.EvmEventLock:          service.TimelineEventLock,
+	entity.EvmEventBurn:          service.TimelineEventBurn,
+	entity.EvmEventBurnERC721:    service.TimelineEventBurn,
+	entity.EvmEventLockERC721:    service.TimelineEventLock,
+	entity.EvmEventLockERC1155:   service.TimelineEventLock,
+	entity.EvmEventMint:          service.TimelineEventMint,
+	entity.EvmEventUnlock:        service.TimelineEventUnlock,
+	entity.EvmEventUnlockERC721:  service.TimelineEventUnlock,
+	entity.EvmEventUnlockERC1155: service.TimelineEventUnlock,
+}
+
+// transactionTimestamp returns the valid start of the given Hedera transaction or 0 if it cannot be parsed
+func (ts *Service) transactionTimestamp(txId, transactionID string) int64 {
+	timestamp, err := hederaHelper.TimestampFromMirrorNodeTransactionID(transactionID)
+	if err != nil {
+		ts.logger.Debugf("[%s] - Failed to parse timestamp of transaction [%s]. Error: [%s].", txId, transactionID, err)
+		return 0
+	}
+
+	return timestamp
+}
+
+// TransfersPage returns up to limit transfers matching the given filter, newest first.
+// The page begins after the given cursor, if any, and holds the cursor of the next page.
+func (ts *Service) TransfersPage(filter model.Filter, cursor string, limit int) (*service.TransfersPage, error) {
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	var after *model.Cursor
+	if cursor != "" {
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			ts.logger.Debugf("Failed to decode cursor [%s]. Error: [%s]", cursor, err)
+			return nil, service.ErrInvalidCursor
+		}
+		after = decoded
+	}
+
+	// An additional transfer is requested to determine whether there is a following page
+	transfers, err := ts.transferRepository.GetPage(&filter, after, limit+1)
+	if err != nil {
+		ts.logger.Errorf("Failed to query Transfers page. Error: [%s].", err)
+		return nil, err
+	}
+
+	page := &service.TransfersPage{
+		Transfers: make([]service.TransferListItem, 0, limit),
+	}
+	if len(transfers) > limit {
+		transfers = transfers[:limit]
+		last := transfers[limit-1]
+		page.Next, err = encodeCursor(&model.Cursor{CreatedAt: last.CreatedAt, TransactionId: last.TransactionID})
+		if err != nil {
+			ts.logger.Errorf("Failed to encode cursor. Error: [%s].", err)
+			return nil, err
+		}
+	}
+
+	for _, t := range transfers {
+		page.Transfers = append(page.Transfers, *ts.transferListItem(t))
+	}
+
+	return page, nil
+}
+
+// transferListItem returns the listed data of the given transfer. Unlike TransferData, the majority of the signatures is
+// not reported, as checking it calls the router of the target network for every listed transfer
+func (ts *Service) transferListItem(t *entity.Transfer) *service.TransferListItem {
+	signatures := make([]string, 0, len(t.Messages))
+	for _, m := range t.Messages {
+		signatures = append(signatures, m.Signature)
+	}
+
+	item := &service.TransferListItem{
+		TransferData: service.TransferData{
+			IsNft:         t.IsNft,
+			IsErc1155:     t.IsErc1155,
+			Recipient:     t.Receiver,
+			SourceChainId: t.SourceChainID,
+			TargetChainId: t.TargetChainID,
+			SourceAsset:   t.SourceAsset,
+			NativeAsset:   t.NativeAsset,
+			TargetAsset:   t.TargetAsset,
+			Signatures:    signatures,
+		},
+		TransactionId: t.TransactionID,
+		Status:        t.Status,
+		Fee:           t.Fee,
+		CreatedAt:     t.CreatedAt,
+		ConfigVersion: t.ConfigVersion,
+		Schedules:     make([]service.ScheduleData, 0, len(t.Schedules)),
+	}
+
+	if t.IsErc1155 {
+		item.TokenIds, item.Amounts = erc1155Batch(t)
+	} else if t.IsNft {
+		item.TokenId = t.SerialNumber
+		item.Metadata = t.Metadata
+	} else {
+		item.Amount = t.Amount
+	}
+
+	// Signatures are collected only for transfers, which are completed on an EVM network
+	if contractService, ok := ts.contractServices[t.TargetChainID]; ok {
+		item.RouterAddress = contractService.Address().String()
+	}
+
+	for _, s := range t.Schedules {
+		item.Schedules = append(item.Schedules, service.ScheduleData{
+			TransactionId: s.TransactionID,
+			ScheduleId:    s.ScheduleID,
+			Operation:     s.Operation,
+			Status:        s.Status,
+		})
+	}
+
+	return item
+}
+
+// erc1155Batch returns the token IDs and amounts of the ERC-1155 batch of the given transfer
+func erc1155Batch(t *entity.Transfer) (tokenIds []int64, amounts []string) {
+	tokenIds = make([]int64, 0, len(t.Erc1155Items))
+	amounts = make([]string, 0, len(t.Erc1155Items))
+	for _, item := range t.Erc1155Items {
+		tokenIds = append(tokenIds, item.TokenID)
+		amounts = append(amounts, item.Amount)
+	}
+	return tokenIds, amounts
+}
+
+func encodeCursor(cursor *model.Cursor) (string, error) {
+	bytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func decodeCursor(encoded string) (*model.Cursor, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &model.Cursor{}
+	err = json.Unmarshal(bytes, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor.TransactionId == "" {
+		return nil, errors.New("missing transaction id")
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfers
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+)
+
+var (
+	evmChainId      = uint64(80001)
+	listedTransfers = []*entity.Transfer{
+		{
+			TransactionID: "0.0.1-1-3",
+			TargetChainID: evmChainId,
+			Amount:        "100",
+			Fee:           "10",
+			Status:        status.Completed,
+			CreatedAt:     3,
+			Messages:      []entity.Message{{Signature: "some-signature"}},
+			Schedules:     []entity.Schedule{{TransactionID: "0.0.2-1-3", ScheduleID: "0.0.3", Operation: schedule.BURN, Status: status.Completed}},
+		},
+		{
+			TransactionID: "0.0.1-1-2",
+			IsNft:         true,
+			SerialNumber:  5,
+			Metadata:      "some-metadata",
+			Status:        status.Initial,
+			CreatedAt:     2,
+		},
+		{
+			TransactionID: "0.0.1-1-1",
+			Status:        status.Initial,
+			CreatedAt:     1,
+		},
+	}
+)
+
+func Test_TransfersPage(t *testing.T) {
+	s := setupPage()
+	filter := model.Filter{Status: status.Completed}
+	mocks.MTransferRepository.On("GetPage", &filter, (*model.Cursor)(nil), 3).Return(listedTransfers[:2], nil)
+
+	page, err := s.TransfersPage(filter, "", 2)
+
+	assert.Nil(t, err)
+	assert.Empty(t, page.Next)
+	assert.Len(t, page.Transfers, 2)
+	assert.Equal(t, "0.0.1-1-3", page.Transfers[0].TransactionId)
+	assert.Equal(t, "100", page.Transfers[0].Amount)
+	assert.Equal(t, "10", page.Transfers[0].Fee)
+	assert.Nil(t, page.Transfers[0].Majority)
+	encoded, err := json.Marshal(page.Transfers[0])
+	assert.Nil(t, err)
+	assert.NotContains(t, string(encoded), `"majority"`)
+	assert.Equal(t, []string{"some-signature"}, page.Transfers[0].Signatures)
+	assert.Equal(t, []service.ScheduleData{{TransactionId: "0.0.2-1-3", ScheduleId: "0.0.3", Operation: schedule.BURN, Status: status.Completed}}, page.Transfers[0].Schedules)
+	assert.Equal(t, int64(5), page.Transfers[1].TokenId)
+	assert.Equal(t, "some-metadata", page.Transfers[1].Metadata)
+	mocks.MBridgeContractService.AssertNotCalled(t, "HasValidSignaturesLength", mock.Anything)
+}
+
+func Test_TransfersPage_Next(t *testing.T) {
+	s := setupPage()
+	mocks.MTransferRepository.On("GetPage", &model.Filter{}, (*model.Cursor)(nil), 3).Return(listedTransfers, nil)
+
+	page, err := s.TransfersPage(model.Filter{}, "", 2)
+
+	assert.Nil(t, err)
+	assert.Len(t, page.Transfers, 2)
+	cursor, err := decodeCursor(page.Next)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.Cursor{CreatedAt: 2, TransactionId: "0.0.1-1-2"}, cursor)
+
+	mocks.MTransferRepository.On("GetPage", &model.Filter{}, cursor, 3).Return(listedTransfers[2:], nil)
+
+	page, err = s.TransfersPage(model.Filter{}, page.Next, 2)
+
+	assert.Nil(t, err)
+	assert.Empty(t, page.Next)
+	assert.Len(t, page.Transfers, 1)
+	assert.Equal(t, "0.0.1-1-1", page.Transfers[0].TransactionId)
+}
+
+func Test_TransfersPage_Limit(t *testing.T) {
+	s := setupPage()
+	mocks.MTransferRepository.On("GetPage", &model.Filter{}, (*model.Cursor)(nil), defaultPageSize+1).Return([]*entity.Transfer{}, nil)
+	mocks.MTransferRepository.On("GetPage", &model.Filter{}, (*model.Cursor)(nil), maxPageSize+1).Return([]*entity.Transfer{}, nil)
+
+	_, err := s.TransfersPage(model.Filter{}, "", 0)
+	assert.Nil(t, err)
+	_, err = s.TransfersPage(model.Filter{}, "", maxPageSize+1)
+	assert.Nil(t, err)
+
+	mocks.MTransferRepository.AssertCalled(t, "GetPage", &model.Filter{}, (*model.Cursor)(nil), defaultPageSize+1)
+	mocks.MTransferRepository.AssertCalled(t, "GetPage", &model.Filter{}, (*model.Cursor)(nil), maxPageSize+1)
+}
+
+func Test_TransfersPage_InvalidCursor(t *testing.T) {
+	s := setupPage()
+
+	page, err := s.TransfersPage(model.Filter{}, "invalid-cursor", 2)
+
+	assert.Nil(t, page)
+	assert.Equal(t, service.ErrInvalidCursor, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetPage")
+}
+
+func Test_TransfersPage_RepositoryFails(t *testing.T) {
+	s := setupPage()
+	expectedErr := errors.New("some-error")
+	mocks.MTransferRepository.On("GetPage", &model.Filter{}, (*model.Cursor)(nil), 3).Return(nil, expectedErr)
+
+	page, err := s.TransfersPage(model.Filter{}, "", 2)
+
+	assert.Nil(t, page)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_TransferTimeline(t *testing.T) {
+	s := setupPage()
+	txId := "0.0.1-1-3"
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(&entity.Transfer{
+		TransactionID: txId,
+		Status:        status.Completed,
+		CreatedAt:     1000000000,
+		Messages: []entity.Message{
+			{Signer: "0xsigner", TransactionTimestamp: 3000000000},
+		},
+		Fees: []entity.Fee{
+			{TransactionID: "0.0.2-2-0", ScheduleID: "0.0.3", Amount: "10", Status: status.Completed},
+		},
+		Schedules: []entity.Schedule{
+			{TransactionID: "invalid", ScheduleID: "0.0.4", Operation: schedule.TRANSFER, Status: status.Completed},
+		},
+	}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", txId).Return([]*entity.EvmEvent{
+		{TransactionHash: "0xhash", Name: entity.EvmEventUnlock, ChainID: evmChainId, BlockNumber: 5, Timestamp: 4000000000},
+	}, nil)
+
+	timeline, err := s.TransferTimeline(txId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &service.TransferTimeline{
+		TransactionId: txId,
+		Status:        status.Completed,
+		Events: []service.TimelineEvent{
+			{Type: service.TimelineEventSchedule, Status: status.Completed, TransactionId: "invalid", ScheduleId: "0.0.4", Operation: schedule.TRANSFER},
+			{Type: service.TimelineEventCreated, Timestamp: 1000000000},
+			{Type: service.TimelineEventFee, Timestamp: 2000000000, Status: status.Completed, TransactionId: "0.0.2-2-0", ScheduleId: "0.0.3", Amount: "10"},
+			{Type: service.TimelineEventSignature, Timestamp: 3000000000, Signer: "0xsigner"},
+			{Type: service.TimelineEventUnlock, Timestamp: 4000000000, ChainId: evmChainId, TransactionHash: "0xhash", BlockNumber: 5},
+		},
+	}, timeline)
+}
+
+func Test_TransferTimeline_NotFound(t *testing.T) {
+	s := setupPage()
+	mocks.MTransferRepository.On("GetWithPreloads", "0.0.1-1-3").Return(nil, nil)
+
+	timeline, err := s.TransferTimeline("0.0.1-1-3")
+
+	assert.Nil(t, timeline)
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MEvmEventRepository.AssertNotCalled(t, "GetByTransferID", "0.0.1-1-3")
+}
+
+func Test_TransferTimeline_EvmEventsFail(t *testing.T) {
+	s := setupPage()
+	expectedErr := errors.New("some-error")
+	mocks.MTransferRepository.On("GetWithPreloads", "0.0.1-1-3").Return(&entity.Transfer{TransactionID: "0.0.1-1-3"}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", "0.0.1-1-3").Return(nil, expectedErr)
+
+	timeline, err := s.TransferTimeline("0.0.1-1-3")
+
+	assert.Nil(t, timeline)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_LockedNftTokenId(t *testing.T) {
+	s := setupPage()
+	mocks.MTransferRepository.On("GetByWrappedNft", "0.0.5001", int64(3)).Return(&entity.Transfer{SerialNumber: 7}, nil)
+
+	tokenId, err := s.LockedNftTokenId("0.0.5001", 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), tokenId)
+}
+
+func Test_LockedNftTokenId_NotFound(t *testing.T) {
+	s := setupPage()
+	mocks.MTransferRepository.On("GetByWrappedNft", "0.0.5001", int64(3)).Return(nil, nil)
+
+	_, err := s.LockedNftTokenId("0.0.5001", 3)
+
+	assert.Equal(t, service.ErrNotFound, err)
+}
+
+func Test_TransferData_Erc1155(t *testing.T) {
+	s := setupPage()
+	erc1155Transfer := &entity.Transfer{
+		TransactionID: "0.0.1-1-5",
+		SourceChainID: 0,
+		TargetChainID: evmChainId,
+		NativeChainID: evmChainId,
+		SourceAsset:   "0x0000000000000000000000000000000000000005",
+		TargetAsset:   "0x0000000000000000000000000000000000000005",
+		NativeAsset:   "0x0000000000000000000000000000000000000005",
+		IsErc1155:     true,
+		Erc1155Items: []entity.Erc1155Item{
+			{TokenID: 1, Amount: "10", WrappedAsset: "0.0.6001"},
+			{TokenID: 2, Amount: "20", WrappedAsset: "0.0.6002"},
+		},
+		Messages: []entity.Message{{Signature: "some-signature"}},
+	}
+	mocks.MTransferRepository.On("GetWithPreloads", erc1155Transfer.TransactionID).Return(erc1155Transfer, nil)
+	mocks.MBridgeContractService.On("Address").Return(common.HexToAddress("0x1"))
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(1)).Return(true, nil)
+
+	data, err := s.TransferData(erc1155Transfer.TransactionID)
+
+	assert.Nil(t, err)
+	erc1155Data, ok := data.(service.Erc1155TransferData)
+	assert.True(t, ok)
+	assert.True(t, erc1155Data.IsErc1155)
+	assert.Equal(t, []int64{1, 2}, erc1155Data.TokenIds)
+	assert.Equal(t, []string{"10", "20"}, erc1155Data.Amounts)
+	assert.Equal(t, []string{"some-signature"}, erc1155Data.Signatures)
+	assert.True(t, erc1155Data.Majority)
+}
+
+func Test_InitiateNewTransfer_RevokesOrphaned(t *testing.T) {
+	s := setupPage()
+	tm := model.Transfer{TransactionId: "0xaa-1", SourceChainId: evmChainId}
+	mocks.MTransferRepository.On("GetByTransactionId", tm.TransactionId).Return(nil, nil)
+	mocks.MTransferRepository.On("Create", &tm).Return(&entity.Transfer{TransactionID: tm.TransactionId, Status: status.Initial}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", tm.TransactionId).Return([]*entity.EvmEvent{
+		{TransferID: tm.TransactionId, Name: entity.EvmEventLock, Orphaned: true},
+	}, nil)
+	mocks.MTransferRepository.On("UpdateStatusRevoked", tm.TransactionId).Return(nil)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	tx, err := s.InitiateNewTransfer(tm)
+
+	assert.Nil(t, err)
+	assert.Equal(t, status.Revoked, tx.Status)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusRevoked", tm.TransactionId)
+}
+
+func Test_InitiateNewTransfer_CanonicalNotRevoked(t *testing.T) {
+	s := setupPage()
+	tm := model.Transfer{TransactionId: "0xaa-1", SourceChainId: evmChainId}
+	mocks.MTransferRepository.On("GetByTransactionId", tm.TransactionId).Return(nil, nil)
+	mocks.MTransferRepository.On("Create", &tm).Return(&entity.Transfer{TransactionID: tm.TransactionId, Status: status.Initial}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", tm.TransactionId).Return([]*entity.EvmEvent{
+		{TransferID: tm.TransactionId, Name: entity.EvmEventLock},
+	}, nil)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	tx, err := s.InitiateNewTransfer(tm)
+
+	assert.Nil(t, err)
+	assert.Equal(t, status.Initial, tx.Status)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusRevoked", tm.TransactionId)
+}
+
+func Test_InitiateNewTransfer_ReinstatesRevoked(t *testing.T) {
+	s := setupPage()
+	tm := model.Transfer{TransactionId: "0xaa-1", SourceChainId: evmChainId}
+	mocks.MTransferRepository.On("GetByTransactionId", tm.TransactionId).Return(&entity.Transfer{TransactionID: tm.TransactionId, Status: status.Revoked}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", tm.TransactionId).Return([]*entity.EvmEvent{
+		{TransferID: tm.TransactionId, Name: entity.EvmEventLock},
+	}, nil)
+	mocks.MTransferRepository.On("ReinstateRevoked", tm.TransactionId).Return(nil)
+
+	tx, err := s.InitiateNewTransfer(tm)
+
+	assert.Nil(t, err)
+	assert.Equal(t, status.Initial, tx.Status)
+	mocks.MTransferRepository.AssertNotCalled(t, "Create", &tm)
+}
+
+func Test_InitiateNewTransfer_RevokedStillOrphaned(t *testing.T) {
+	s := setupPage()
+	tm := model.Transfer{TransactionId: "0xaa-1", SourceChainId: evmChainId}
+	mocks.MTransferRepository.On("GetByTransactionId", tm.TransactionId).Return(&entity.Transfer{TransactionID: tm.TransactionId, Status: status.Revoked}, nil)
+	mocks.MEvmEventRepository.On("GetByTransferID", tm.TransactionId).Return([]*entity.EvmEvent{
+		{TransferID: tm.TransactionId, Name: entity.EvmEventLock, Orphaned: true},
+	}, nil)
+
+	tx, err := s.InitiateNewTransfer(tm)
+
+	assert.Nil(t, err)
+	assert.Equal(t, status.Revoked, tx.Status)
+	mocks.MTransferRepository.AssertNotCalled(t, "ReinstateRevoked", tm.TransactionId)
+}
+
+func setupPage() *Service {
+	mocks.Setup()
+	return &Service{
+		logger:             config.GetLoggerFor("Transfers Service"),
+		contractServices:   map[uint64]service.Contracts{evmChainId: mocks.MBridgeContractService},
+		transferRepository: mocks.MTransferRepository,
+		evmEventRepository: mocks.MEvmEventRepository,
+		transferEvents:     mocks.MTransferEventsService,
+	}
+}
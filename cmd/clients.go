@@ -21,6 +21,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 )
 
@@ -32,15 +33,15 @@ type Clients struct {
 }
 
 // PrepareClients instantiates all the necessary clients for a validator node
-func PrepareClients(config config.Clients) *Clients {
+func PrepareClients(config config.Clients, keystore config.Keystore, prometheusService service.Prometheus) *Clients {
 	EVMClients := make(map[uint64]client.EVM)
 	for chainId, ec := range config.Evm {
-		EVMClients[chainId] = evm.NewClient(ec)
+		EVMClients[chainId] = evm.NewClient(chainId, ec, prometheusService)
 	}
 
 	return &Clients{
-		HederaNode: hedera.NewNodeClient(config.Hedera),
-		MirrorNode: mirror_node.NewClient(config.MirrorNode.ApiAddress, config.MirrorNode.PollingInterval),
+		HederaNode: hedera.NewNodeClient(config.Hedera, keystore),
+		MirrorNode: mirror_node.NewClient(config.MirrorNode, prometheusService),
 		EVMClients: EVMClients,
 	}
 }
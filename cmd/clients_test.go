@@ -20,13 +20,16 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	tc "github.com/limechain/hedera-eth-bridge-validator/test/test-config"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func TestPrepareClients(t *testing.T) {
-	clients := PrepareClients(tc.TestConfig.Node.Clients)
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	clients := PrepareClients(tc.TestConfig.Node.Clients, tc.TestConfig.Node.Keystore, mocks.MPrometheusService)
 	assert.NotEmpty(t, clients)
 
 	assert.IsType(t, map[uint64]client.EVM{}, clients.EVMClients)
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	keyTypeEvm    = "evm"
+	keyTypeHedera = "hedera"
+
+	keysUsage = `Usage: node keys <command> [flags]
+
+Commands:
+  create  -type evm|hedera -out <file>   Generates a new key and writes it encrypted to <file>
+  import  -type evm|hedera -out <file>   Reads a plaintext private key from stdin and writes it encrypted to <file>
+  inspect -type evm|hedera <file>        Decrypts <file> and prints the address or public key of the key
+
+The passphrase is read from the VALIDATOR_KEYSTORE_PASSPHRASE environment variable or from the file given by -passphrase-file.
+`
+)
+
+// runKeys executes the keys subcommand with the given arguments and returns the exit code
+func runKeys(args []string, stdin io.Reader, stdout io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stdout, keysUsage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("keys "+args[0], flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	keyType := flags.String("type", keyTypeEvm, "The type of the key: evm or hedera")
+	out := flags.String("out", "", "The file to write the encrypted key to")
+	passphraseFile := flags.String("passphrase-file", "", "The file holding the passphrase")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	passphrase, err := config.Keystore{
+		Passphrase:     os.Getenv("VALIDATOR_KEYSTORE_PASSPHRASE"),
+		PassphraseFile: *passphraseFile,
+	}.GetPassphrase()
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+
+	switch args[0] {
+	case "create":
+		err = createKey(*keyType, *out, passphrase, stdout)
+	case "import":
+		err = importKey(*keyType, *out, passphrase, stdin, stdout)
+	case "inspect":
+		err = inspectKey(*keyType, flags.Arg(0), passphrase, stdout)
+	default:
+		fmt.Fprint(stdout, keysUsage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+	return 0
+}
+
+func createKey(keyType, out, passphrase string, stdout io.Writer) error {
+	switch keyType {
+	case keyTypeEvm:
+		privateKey, err := crypto.GenerateKey()
+		if err != nil {
+			return err
+		}
+		return writeEvmKey(hex.EncodeToString(crypto.FromECDSA(privateKey)), out, passphrase, stdout)
+	case keyTypeHedera:
+		privateKey, err := hedera.GeneratePrivateKey()
+		if err != nil {
+			return err
+		}
+		return writeHederaKey(privateKey.String(), out, passphrase, stdout)
+	default:
+		return errors.New(fmt.Sprintf("unsupported key type [%s]", keyType))
+	}
+}
+
+func importKey(keyType, out, passphrase string, stdin io.Reader, stdout io.Writer) error {
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return err
+	}
+	privateKey := strings.TrimSpace(line)
+
+	switch keyType {
+	case keyTypeEvm:
+		return writeEvmKey(strings.TrimPrefix(privateKey, "0x"), out, passphrase, stdout)
+	case keyTypeHedera:
+		return writeHederaKey(privateKey, out, passphrase, stdout)
+	default:
+		return errors.New(fmt.Sprintf("unsupported key type [%s]", keyType))
+	}
+}
+
+func inspectKey(keyType, file, passphrase string, stdout io.Writer) error {
+	if file == "" {
+		return errors.New("keystore file is required")
+	}
+
+	switch keyType {
+	case keyTypeEvm:
+		privateKey, err := keystore.ReadEVM(file, passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Address: %s\n", crypto.PubkeyToAddress(privateKey.PublicKey))
+	case keyTypeHedera:
+		privateKey, err := keystore.ReadHedera(file, passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Public key: %s\n", privateKey.PublicKey())
+	default:
+		return errors.New(fmt.Sprintf("unsupported key type [%s]", keyType))
+	}
+	return nil
+}
+
+func writeEvmKey(hexKey, out, passphrase string, stdout io.Writer) error {
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return errors.New("invalid EVM private key")
+	}
+
+	keyJson, err := keystore.NewEVM(privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+
+	err = writeKeystore(out, keyJson)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Address: %s\nKeystore: %s\n", crypto.PubkeyToAddress(privateKey.PublicKey), out)
+	return nil
+}
+
+func writeHederaKey(key, out, passphrase string, stdout io.Writer) error {
+	privateKey, err := hedera.PrivateKeyFromString(key)
+	if err != nil {
+		return errors.New("invalid Hedera private key")
+	}
+
+	ks, err := keystore.NewHedera(privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+
+	err = writeKeystore(out, ks)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "Public key: %s\nKeystore: %s\n", privateKey.PublicKey(), out)
+	return nil
+}
+
+// writeKeystore writes the keystore to the given file, refusing to overwrite an existing one
+func writeKeystore(out string, content []byte) error {
+	if out == "" {
+		return errors.New("-out is required")
+	}
+
+	file, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(content)
+	return err
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const evmPrivateKey = "bb9282e2ba6cb4b8e8b5a7ae8e5c1c4b1d6bbe2b1dc8ad05db6e1a2db09b1b51"
+
+// setupKeys creates a temporary directory and sets the keystore passphrase. The returned function reverts both
+func setupKeys(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("VALIDATOR_KEYSTORE_PASSPHRASE", "passphrase")
+	return dir, func() {
+		os.RemoveAll(dir)
+		os.Unsetenv("VALIDATOR_KEYSTORE_PASSPHRASE")
+	}
+}
+
+func Test_Keys_ImportInspectEvm(t *testing.T) {
+	dir, cleanup := setupKeys(t)
+	defer cleanup()
+	out := filepath.Join(dir, "evm.json")
+	key, _ := crypto.HexToECDSA(evmPrivateKey)
+	address := crypto.PubkeyToAddress(key.PublicKey).String()
+
+	var stdout bytes.Buffer
+	code := runKeys([]string{"import", "-type", "evm", "-out", out}, strings.NewReader("0x"+evmPrivateKey+"\n"), &stdout)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), address)
+
+	stdout.Reset()
+	code = runKeys([]string{"inspect", "-type", "evm", out}, nil, &stdout)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "Address: "+address+"\n", stdout.String())
+}
+
+func Test_Keys_CreateInspectHedera(t *testing.T) {
+	dir, cleanup := setupKeys(t)
+	defer cleanup()
+	out := filepath.Join(dir, "hedera.json")
+
+	var created bytes.Buffer
+	code := runKeys([]string{"create", "-type", "hedera", "-out", out}, nil, &created)
+	assert.Equal(t, 0, code)
+
+	var inspected bytes.Buffer
+	code = runKeys([]string{"inspect", "-type", "hedera", out}, nil, &inspected)
+	assert.Equal(t, 0, code)
+	assert.True(t, strings.HasPrefix(created.String(), inspected.String()))
+}
+
+func Test_Keys_RefusesOverwrite(t *testing.T) {
+	dir, cleanup := setupKeys(t)
+	defer cleanup()
+	out := filepath.Join(dir, "existing.json")
+	ioutil.WriteFile(out, []byte("existing"), 0600)
+
+	var stdout bytes.Buffer
+	code := runKeys([]string{"import", "-type", "evm", "-out", out}, strings.NewReader(evmPrivateKey), &stdout)
+
+	assert.Equal(t, 1, code)
+	content, _ := ioutil.ReadFile(out)
+	assert.Equal(t, "existing", string(content))
+}
+
+func Test_Keys_InvalidKey(t *testing.T) {
+	dir, cleanup := setupKeys(t)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runKeys([]string{"import", "-type", "evm", "-out", filepath.Join(dir, "evm.json")}, strings.NewReader("invalid"), &stdout)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "invalid EVM private key")
+}
+
+func Test_Keys_PassphraseNotConfigured(t *testing.T) {
+	var stdout bytes.Buffer
+	code := runKeys([]string{"inspect", "-type", "evm", "evm.json"}, nil, &stdout)
+
+	assert.Equal(t, 1, code)
+}
+
+func Test_Keys_UnknownCommand(t *testing.T) {
+	_, cleanup := setupKeys(t)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runKeys([]string{"delete"}, nil, &stdout)
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stdout.String(), "Usage")
+}
@@ -19,72 +19,170 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
 	burn_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/burn-message"
+	ebmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/erc1155/burn-message"
+	emh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/erc1155/mint"
 	fee_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-message"
 	fee_transfer "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-transfer"
 	mh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message"
 	message_submission "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message-submission"
 	mint_hts "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/mint-hts"
+	nbmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/burn-message"
 	nfmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/fee-message"
+	nmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/mint"
 	nth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/handler/pausable"
 	rbh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/burn"
+	rebh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/erc1155/burn"
+	remh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/erc1155/mint"
 	rfh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/fee"
 	rfth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/fee-transfer"
 	rmth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/mint-hts"
 	rnfmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/fee"
+	rnmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/mint"
 	rnth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/transfer"
 	rthh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/handler/screened"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/recovery"
+	bfw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/backfill"
+	bcw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/bridge-config"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/evm"
 	cmw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/message"
 	pw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/prometheus"
+	slw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/screening-list"
+	sw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/supply"
 	tw "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/transfer"
 	apirouter "github.com/limechain/hedera-eth-bridge-validator/app/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/backfill"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/router/burn-event"
 	config_bridge "github.com/limechain/hedera-eth-bridge-validator/app/router/config-bridge"
+	dead_letter "github.com/limechain/hedera-eth-bridge-validator/app/router/dead-letter"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/healthcheck"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/pause"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/review"
+	screening_hit "github.com/limechain/hedera-eth-bridge-validator/app/router/screening-hit"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/supply"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer"
+	backfill_service "github.com/limechain/hedera-eth-bridge-validator/app/services/backfill"
+	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/services/bridge-config"
+	dead_letters "github.com/limechain/hedera-eth-bridge-validator/app/services/dead-letters"
+	pause_service "github.com/limechain/hedera-eth-bridge-validator/app/services/pause"
+	prometheusServices "github.com/limechain/hedera-eth-bridge-validator/app/services/prometheus"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/reviews"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
-	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "keys" {
+		os.Exit(runKeys(os.Args[2:], os.Stdin, os.Stdout))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "validate-config" {
+		os.Exit(runValidateConfig(os.Args[2:], os.Stdout))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "pause" {
+		os.Exit(runPause(os.Args[2:], os.Stdout))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:], os.Stdout))
+	}
+
 	// Config
-	configuration, parsedBridge := config.LoadConfig()
+	configuration, parsedBridge := config.LoadValidConfig()
 	config.InitLogger(configuration.Node.LogLevel)
 
-	// Prepare Clients
-	clients := PrepareClients(configuration.Node.Clients)
+	prometheusService := prometheusServices.NewService(configuration.Bridge.Assets, configuration.Node.Monitoring.Enable)
 
-	// Prepare Node
-	server := server.NewServer()
+	// Prepare Clients
+	clients := PrepareClients(configuration.Node.Clients, configuration.Node.Keystore, prometheusService)
 
 	var services *Services = nil
 	db := persistence.NewDatabase(configuration.Node.Database)
 	// Prepare repositories
 	repositories := PrepareRepositories(db)
 	// Prepare Services
-	services = PrepareServices(configuration, *clients, *repositories)
+	services = PrepareServices(configuration, *clients, *repositories, prometheusService)
+
+	ctx := shutdownContext()
+
+	// Prepare Node
+	q := prepareQueue(ctx, configuration.Node.Queue, repositories)
+	server := server.NewServer(q, configuration.Node.Workers, configuration.Node.ShutdownTimeout*time.Second, services.prometheus)
+	services.deadLetters = dead_letters.NewService(repositories.deadLetter, q)
+	services.reviews = reviews.NewService(repositories.review, repositories.transfer, q)
+	services.pause = pause_service.NewService(repositories.pause, repositories.pausedMessage, q)
+	services.bridgeConfig = bridge_config.NewService(parsedBridge, configuration.Bridge.Assets)
 
-	initializeServerPairs(server, services, repositories, clients, configuration)
+	initializeServerPairs(server, services, repositories, clients, configuration, q)
 
 	initializeMonitoring(services.prometheus, server, configuration, clients.MirrorNode, clients.EVMClients)
 
-	apiRouter := initializeAPIRouter(services, parsedBridge)
+	apiRouter := initializeAPIRouter(services, configuration.Node.Admin)
 
-	executeRecovery(repositories.fee, repositories.schedule, clients.MirrorNode)
+	executeRecovery(ctx, repositories, services, clients.MirrorNode, q, configuration)
 
 	// Start
-	server.Run(apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
+	go func() {
+		// Event streams are long-lived requests, which would otherwise hold off the shutdown of the HTTP server
+		<-ctx.Done()
+		services.transferEvents.Close()
+	}()
+	server.Run(ctx, apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
+
+	err := db.Close()
+	if err != nil {
+		log.Errorf("Failed to close the database connection. Error: [%s]", err)
+	}
+}
+
+// shutdownContext returns a context, which is done once the process receives SIGINT or SIGTERM
+func shutdownContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Infof("Received [%s] signal.", sig)
+		cancel()
+		signal.Stop(signals)
+	}()
+
+	return ctx
+}
+
+func prepareQueue(ctx context.Context, configuration config.Queue, repositories *Repositories) qi.Queue {
+	if !configuration.Persistent {
+		log.Infoln("Persistent queue is disabled. Unhandled messages will be lost on restart.")
+		return queue.NewQueue(
+			ctx,
+			repositories.transferStatus,
+			repositories.deadLetter,
+			repositories.pausedMessage,
+			configuration.MaxAttempts,
+			configuration.RetryDelay*time.Second)
+	}
+
+	return queue.NewPersistentQueue(
+		ctx,
+		repositories.queue,
+		configuration.VisibilityTimeout*time.Second,
+		configuration.PollingInterval*time.Second,
+		configuration.MaxAttempts,
+		configuration.RetryDelay*time.Second)
 }
 
 func initializeMonitoring(
@@ -101,51 +199,122 @@ func initializeMonitoring(
 	}
 }
 
-func initializeAPIRouter(services *Services, bridgeConfig parser.Bridge) *apirouter.APIRouter {
+func initializeAPIRouter(services *Services, adminConfig config.Admin) *apirouter.APIRouter {
 	apiRouter := apirouter.NewAPIRouter()
 	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter())
-	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers))
+	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers, services.transferEvents))
 	apiRouter.AddV1Router(burn_event.Route, burn_event.NewRouter(services.burnEvents))
 	apiRouter.AddV1Router("/metrics", promhttp.Handler())
-	apiRouter.AddV1Router(config_bridge.Route, config_bridge.NewRouter(bridgeConfig))
+	apiRouter.AddV1Router(config_bridge.Route, config_bridge.NewRouter(services.bridgeConfig, adminConfig.ApiKey))
+	apiRouter.AddV1Router(supply.Route, supply.NewRouter(services.reconciliation))
+
+	if adminConfig.ApiKey != "" {
+		apiRouter.AddV1Router(dead_letter.Route, dead_letter.NewRouter(services.deadLetters, adminConfig.ApiKey))
+		apiRouter.AddV1Router(review.Route, review.NewRouter(services.reviews, adminConfig.ApiKey))
+		apiRouter.AddV1Router(pause.Route, pause.NewRouter(services.pause, adminConfig.ApiKey))
+		apiRouter.AddV1Router(screening_hit.Route, screening_hit.NewRouter(services.screening, adminConfig.ApiKey))
+		apiRouter.AddV1Router(backfill.Route, backfill.NewRouter(services.backfill, adminConfig.ApiKey))
+	} else {
+		log.Infoln("Admin API key is not configured. Admin API is disabled.")
+	}
 
 	return apiRouter
 }
 
-func executeRecovery(feeRepository repository.Fee, scheduleRepository repository.Schedule, client client.MirrorNode) {
-	r := recovery.New(feeRepository, scheduleRepository, client)
+func executeRecovery(ctx context.Context, repositories *Repositories, services *Services, client client.MirrorNode, q qi.Queue, configuration config.Config) {
+	r := recovery.New(
+		repositories.fee,
+		repositories.schedule,
+		client,
+		repositories.transfer,
+		repositories.evmEvent,
+		repositories.queue,
+		repositories.pausedMessage,
+		repositories.deadLetter,
+		services.signers,
+		services.contractServices,
+		configuration.Bridge.TopicId,
+		configuration.Bridge.Hedera.BridgeAccount,
+		q,
+		configuration.Node.Validator,
+		configuration.Node.Recovery)
 
-	r.Execute()
+	r.Execute(ctx)
 }
 
-func initializeServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration config.Config) {
-	server.AddWatcher(addTransferWatcher(
+func initializeServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration config.Config, q qi.Queue) {
+	transferWatcher := addTransferWatcher(
 		&configuration,
 		services.transfers,
 		clients.MirrorNode,
 		&repositories.transferStatus,
+		repositories.transfer,
 		services.contractServices,
-		services.prometheus))
+		services.prometheus)
+	server.AddWatcher(transferWatcher)
 
-	server.AddHandler(constants.TopicMessageSubmission,
+	addTransferHandler(server, services, constants.TopicMessageSubmission,
 		message_submission.NewHandler(
 			clients.HederaNode,
 			clients.MirrorNode,
 			services.transfers,
 			repositories.transfer,
 			services.messages,
+			services.limits,
 			configuration.Bridge.TopicId))
 
-	server.AddHandler(constants.HederaMintHtsTransfer, mint_hts.NewHandler(services.lockEvents))
-	server.AddHandler(constants.HederaBurnMessageSubmission, burn_message.NewHandler(services.transfers))
-	server.AddHandler(constants.HederaFeeTransfer, fee_transfer.NewHandler(services.burnEvents))
-	server.AddHandler(constants.HederaTransferMessageSubmission, fee_message.NewHandler(services.transfers))
+	addTransferHandler(server, services, constants.HederaMintHtsTransfer, mint_hts.NewHandler(services.lockEvents))
+	addTransferHandler(server, services, constants.HederaBurnMessageSubmission, burn_message.NewHandler(services.transfers, services.limits))
+	addTransferHandler(server, services, constants.HederaFeeTransfer, fee_transfer.NewHandler(services.burnEvents))
+	addTransferHandler(server, services, constants.HederaTransferMessageSubmission, fee_message.NewHandler(services.transfers, services.limits, constants.HederaTransferMessageSubmission))
+	addTransferHandler(server, services, constants.WrappedFeeMessageSubmission, fee_message.NewHandler(services.transfers, services.limits, constants.WrappedFeeMessageSubmission))
 
-	server.AddWatcher(
-		addConsensusTopicWatcher(
-			&configuration,
-			clients.MirrorNode,
-			repositories.messageStatus))
+	if configuration.Node.BridgeConfig.ReloadInterval > 0 {
+		server.AddWatcher(bcw.NewWatcher(
+			config.DefaultBridgeFile,
+			configuration.Node.BridgeConfig.ReloadInterval*time.Second,
+			services.bridgeConfig))
+	} else {
+		log.Infoln("Bridge configuration reloading is disabled. Changes to the bridge configuration file require a restart.")
+	}
+
+	if services.screeningList != nil && configuration.Node.Screening.ReloadInterval > 0 {
+		server.AddWatcher(slw.NewWatcher(
+			configuration.Node.Screening.ListFile,
+			configuration.Node.Screening.ReloadInterval*time.Second,
+			services.screeningList))
+	}
+
+	if configuration.Node.Reconciliation.Interval > 0 {
+		server.AddWatcher(sw.NewWatcher(
+			configuration.Node.Reconciliation.Interval*time.Second,
+			services.reconciliation))
+	} else {
+		log.Infoln("Supply reconciliation is disabled.")
+	}
+
+	topicWatcher := addConsensusTopicWatcher(
+		&configuration,
+		clients.MirrorNode,
+		repositories.messageStatus,
+		repositories.message)
+	server.AddWatcher(topicWatcher)
+
+	services.backfill = backfill_service.NewService(
+		[]service.BackfillSource{transferWatcher, topicWatcher},
+		q,
+		services.prometheus)
+	backfillConfig := configuration.Node.Backfill
+	if backfillConfig.Interval > 0 {
+		server.AddWatcher(bfw.NewWatcher(
+			backfillConfig.Interval*time.Second,
+			backfillConfig.Window*time.Second,
+			backfillConfig.Delay*time.Second,
+			backfillConfig.DryRun,
+			services.backfill))
+	} else {
+		log.Infoln("Backfill audit is disabled.")
+	}
 	server.AddHandler(constants.TopicMessageValidation, mh.NewHandler(
 		configuration.Bridge.TopicId,
 		repositories.transfer,
@@ -153,7 +322,8 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 		services.contractServices,
 		services.messages,
 		services.prometheus,
-		configuration.Bridge.Assets))
+		configuration.Bridge.Assets,
+		services.transferEvents))
 
 	for _, evmClient := range clients.EVMClients {
 		chain, err := evmClient.ChainID(context.Background())
@@ -169,7 +339,11 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 		server.AddWatcher(
 			evm.NewWatcher(
 				repositories.transferStatus,
+				repositories.evmEvent,
+				repositories.evmBlock,
+				repositories.transfer,
 				contractService,
+				services.contractServices,
 				services.prometheus,
 				evmClient,
 				configuration.Bridge.Assets,
@@ -204,6 +378,17 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 		services.transfers,
 		services.readOnly,
 		services.prometheus))
+	server.AddHandler(constants.ReadOnlyWrappedFeeTransfer, rfh.NewHandler(
+		repositories.transfer,
+		repositories.fee,
+		repositories.schedule,
+		clients.MirrorNode,
+		configuration.Bridge.Hedera.BridgeAccount,
+		services.distributor,
+		services.fees,
+		services.transfers,
+		services.readOnly,
+		services.prometheus))
 	server.AddHandler(constants.ReadOnlyHederaBurn, rbh.NewHandler(
 		configuration.Bridge.Hedera.BridgeAccount,
 		clients.MirrorNode,
@@ -220,7 +405,7 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 	server.AddHandler(constants.ReadOnlyTransferSave, rthh.NewHandler(services.transfers))
 
 	// Hedera Native Nft handlers
-	server.AddHandler(constants.HederaNativeNftTransfer, nfmh.NewHandler(services.transfers))
+	addTransferHandler(server, services, constants.HederaNativeNftTransfer, nfmh.NewHandler(services.transfers))
 	server.AddHandler(constants.ReadOnlyHederaNativeNftTransfer, rnfmh.NewHandler(
 		repositories.transfer,
 		repositories.fee,
@@ -229,11 +414,11 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 		configuration.Bridge.Hedera.BridgeAccount,
 		services.distributor,
 		services.transfers,
-		configuration.Bridge.Hedera.NftFees,
+		configuration.Bridge.Assets,
 		services.readOnly))
 
 	// Hedera Native unlock Nft Handlers
-	server.AddHandler(constants.HederaNftTransfer, nth.NewHandler(
+	addTransferHandler(server, services, constants.HederaNftTransfer, nth.NewHandler(
 		configuration.Bridge.Hedera.BridgeAccount,
 		repositories.transfer,
 		repositories.schedule,
@@ -245,6 +430,42 @@ func initializeServerPairs(server *server.Server, services *Services, repositori
 		repositories.schedule,
 		services.readOnly,
 		services.transfers))
+
+	// EVM Native Nft handlers
+	addTransferHandler(server, services, constants.HederaMintNftTransfer, nmh.NewHandler(services.lockEvents))
+	addTransferHandler(server, services, constants.HederaBurnNftMessageSubmission, nbmh.NewHandler(services.transfers))
+	server.AddHandler(constants.ReadOnlyHederaMintNftTransfer, rnmh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		clients.MirrorNode,
+		repositories.transfer,
+		repositories.schedule,
+		services.transfers,
+		services.readOnly))
+
+	// EVM Native ERC-1155 handlers
+	addTransferHandler(server, services, constants.HederaMintErc1155Transfer, emh.NewHandler(services.lockEvents))
+	addTransferHandler(server, services, constants.HederaBurnErc1155MessageSubmission, ebmh.NewHandler(services.transfers))
+	server.AddHandler(constants.ReadOnlyHederaMintErc1155, remh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		clients.MirrorNode,
+		repositories.schedule,
+		services.transfers,
+		services.readOnly))
+	server.AddHandler(constants.ReadOnlyHederaBurnErc1155, rebh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		clients.MirrorNode,
+		repositories.schedule,
+		services.transfers,
+		services.readOnly))
+}
+
+// addTransferHandler registers the handler of a topic, which signs, submits or schedules transactions for transfers.
+// Transfers of screened parties are blocked and the ones, which are paused, are deferred
+func addTransferHandler(server *server.Server, services *Services, topic string, handler server.Handler) {
+	server.AddHandler(topic, screened.NewHandler(
+		pausable.NewHandler(topic, handler, services.transfers, services.pause),
+		services.transfers,
+		services.screening))
 }
 
 func initializePrometheusWatcher(
@@ -268,6 +489,7 @@ func addTransferWatcher(configuration *config.Config,
 	bridgeService service.Transfers,
 	mirrorNode client.MirrorNode,
 	repository *repository.Status,
+	transferRepository repository.Transfer,
 	contractServices map[uint64]service.Contracts,
 	prometheusService service.Prometheus,
 ) *tw.Watcher {
@@ -280,10 +502,10 @@ func addTransferWatcher(configuration *config.Config,
 		account,
 		configuration.Node.Clients.MirrorNode.PollingInterval,
 		*repository,
+		transferRepository,
 		configuration.Node.Clients.Hedera.StartTimestamp,
 		contractServices,
 		configuration.Bridge.Assets,
-		configuration.Bridge.Hedera.NftFees,
 		configuration.Node.Validator,
 		prometheusService,
 	)
@@ -292,12 +514,14 @@ func addTransferWatcher(configuration *config.Config,
 func addConsensusTopicWatcher(configuration *config.Config,
 	client client.MirrorNode,
 	repository repository.Status,
+	messageRepository repository.Message,
 ) *cmw.Watcher {
 	topic := configuration.Bridge.TopicId
 	log.Debugf("Added Topic Watcher for topic [%s]\n", topic)
 	return cmw.NewWatcher(client,
 		topic,
 		repository,
+		messageRepository,
 		configuration.Node.Clients.MirrorNode.PollingInterval,
 		configuration.Node.Clients.Hedera.StartTimestamp)
 }
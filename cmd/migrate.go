@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+const migrateUsage = `Usage: node migrate <command> [flags]
+
+Commands:
+  up      Applies all pending migrations
+  down    Reverts the latest applied migration
+  status  Lists the migrations and whether they are applied
+
+The database is read from the node configuration file given by -node.
+`
+
+// schemaMigrator applies and reverts the migrations of the validator schema
+type schemaMigrator interface {
+	Up() (int, error)
+	Down() (*migrations.Migration, error)
+	Status() ([]migrations.Status, error)
+}
+
+// runMigrate executes the migrate subcommand with the given arguments and returns the exit code
+func runMigrate(args []string, stdout io.Writer) int {
+	if len(args) == 0 || (args[0] != "up" && args[0] != "down" && args[0] != "status") {
+		fmt.Fprint(stdout, migrateUsage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	nodeFile := flags.String("node", config.DefaultNodeFile, "The node configuration file")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	parsed, err := config.ReadNodeConfig(*nodeFile)
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+
+	db, err := persistence.Open(config.New(parsed.Node).Database)
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+	migrator, err := persistence.NewMigrator(db)
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+
+	return migrate(args[0], migrator, stdout)
+}
+
+// migrate executes the given migrate command and returns the exit code
+func migrate(command string, migrator schemaMigrator, stdout io.Writer) int {
+	switch command {
+	case "up":
+		count, err := migrator.Up()
+		if err != nil {
+			fmt.Fprintf(stdout, "Error: %s\n", err)
+			return 1
+		}
+		fmt.Fprintf(stdout, "Applied %d migration(s).\n", count)
+	case "down":
+		migration, err := migrator.Down()
+		if err != nil {
+			fmt.Fprintf(stdout, "Error: %s\n", err)
+			return 1
+		}
+		if migration == nil {
+			fmt.Fprintln(stdout, "No migration to revert.")
+			return 0
+		}
+		fmt.Fprintf(stdout, "Reverted migration %d_%s.\n", migration.Version, migration.Name)
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			fmt.Fprintf(stdout, "Error: %s\n", err)
+			return 1
+		}
+		w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+		for _, status := range statuses {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, describeStatus(status))
+		}
+		w.Flush()
+	}
+	return 0
+}
+
+func describeStatus(status migrations.Status) string {
+	if !status.Applied {
+		return "pending"
+	}
+	appliedAt := time.Unix(0, status.AppliedAt).UTC().Format(time.RFC3339)
+	if !status.Known {
+		return fmt.Sprintf("applied at %s, unknown to this release", appliedAt)
+	}
+	return fmt.Sprintf("applied at %s", appliedAt)
+}
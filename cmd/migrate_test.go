@@ -0,0 +1,100 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/migrations"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeMigrator struct {
+	applied  int
+	reverted *migrations.Migration
+	statuses []migrations.Status
+	err      error
+}
+
+func (m *fakeMigrator) Up() (int, error) {
+	return m.applied, m.err
+}
+
+func (m *fakeMigrator) Down() (*migrations.Migration, error) {
+	return m.reverted, m.err
+}
+
+func (m *fakeMigrator) Status() ([]migrations.Status, error) {
+	return m.statuses, m.err
+}
+
+func Test_Migrate_Usage(t *testing.T) {
+	for _, args := range [][]string{{}, {"sideways"}} {
+		var out bytes.Buffer
+		assert.Equal(t, 2, runMigrate(args, &out))
+		assert.Equal(t, migrateUsage, out.String())
+	}
+}
+
+func Test_Migrate_MissingNodeFile(t *testing.T) {
+	var out bytes.Buffer
+	assert.Equal(t, 1, runMigrate([]string{"up", "-node", "missing.yml"}, &out))
+	assert.Contains(t, out.String(), "Error:")
+}
+
+func Test_Migrate_Up(t *testing.T) {
+	var out bytes.Buffer
+	assert.Equal(t, 0, migrate("up", &fakeMigrator{applied: 2}, &out))
+	assert.Equal(t, "Applied 2 migration(s).\n", out.String())
+}
+
+func Test_Migrate_Down(t *testing.T) {
+	var out bytes.Buffer
+	assert.Equal(t, 0, migrate("down", &fakeMigrator{reverted: &migrations.Migration{Version: 1, Name: "baseline"}}, &out))
+	assert.Equal(t, "Reverted migration 1_baseline.\n", out.String())
+
+	out.Reset()
+	assert.Equal(t, 0, migrate("down", &fakeMigrator{}, &out))
+	assert.Equal(t, "No migration to revert.\n", out.String())
+}
+
+func Test_Migrate_Status(t *testing.T) {
+	appliedAt := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC).UnixNano()
+	var out bytes.Buffer
+
+	code := migrate("status", &fakeMigrator{statuses: []migrations.Status{
+		{Version: 1, Name: "baseline", Applied: true, AppliedAt: appliedAt, Known: true},
+		{Version: 2, Name: "add_column", Known: true},
+		{Version: 3, Name: "newer", Applied: true, AppliedAt: appliedAt},
+	}}, &out)
+
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "VERSION  NAME        STATUS\n"+
+		"1        baseline    applied at 2022-03-01T12:00:00Z\n"+
+		"2        add_column  pending\n"+
+		"3        newer       applied at 2022-03-01T12:00:00Z, unknown to this release\n", out.String())
+}
+
+func Test_Migrate_Error(t *testing.T) {
+	for _, command := range []string{"up", "down", "status"} {
+		var out bytes.Buffer
+		assert.Equal(t, 1, migrate(command, &fakeMigrator{err: errors.New("some-error")}, &out))
+		assert.Equal(t, "Error: some-error\n", out.String())
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	pauseUsage = `Usage: node pause <command> [flags]
+
+Commands:
+  list                                  Lists the active pauses of the validator
+  pause  [-chain <id> | -asset <asset>]  Pauses the given chain or asset, or all transfers if neither is given
+  resume [-chain <id> | -asset <asset>]  Resumes the given chain or asset, or all transfers if neither is given
+
+The admin API key is read from the VALIDATOR_ADMIN_API_KEY environment variable.
+`
+
+	defaultValidatorUrl = "http://localhost:5200"
+	pausesPath          = "/api/v1/pauses"
+)
+
+// runPause executes the pause subcommand with the given arguments against the admin API of a running validator and returns the exit code
+func runPause(args []string, stdout io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stdout, pauseUsage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("pause "+args[0], flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	url := flags.String("url", defaultValidatorUrl, "The URL of the validator API")
+	chain := flags.String("chain", "", "The id of the chain to pause or resume")
+	asset := flags.String("asset", "", "The asset to pause or resume")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	var method string
+	switch args[0] {
+	case "list":
+		method = http.MethodGet
+	case "pause":
+		method = http.MethodPost
+	case "resume":
+		method = http.MethodDelete
+	default:
+		fmt.Fprint(stdout, pauseUsage)
+		return 2
+	}
+
+	path, err := pausePath(method, *chain, *asset)
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 2
+	}
+
+	body, err := callAdminApi(method, strings.TrimSuffix(*url, "/")+path, os.Getenv("VALIDATOR_ADMIN_API_KEY"))
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+
+	switch method {
+	case http.MethodGet:
+		fmt.Fprintln(stdout, string(body))
+	case http.MethodPost:
+		fmt.Fprintln(stdout, "Paused.")
+	case http.MethodDelete:
+		fmt.Fprintln(stdout, "Resumed.")
+	}
+	return 0
+}
+
+// pausePath returns the path of the pauses API for the given command flags
+func pausePath(method, chain, asset string) (string, error) {
+	if chain != "" && asset != "" {
+		return "", errors.New("only one of -chain and -asset can be given")
+	}
+
+	switch {
+	case method == http.MethodGet:
+		return pausesPath, nil
+	case chain != "":
+		return fmt.Sprintf("%s/chains/%s", pausesPath, chain), nil
+	case asset != "":
+		return fmt.Sprintf("%s/assets/%s", pausesPath, asset), nil
+	default:
+		return pausesPath + "/global", nil
+	}
+}
+
+// callAdminApi sends the request, authorised with the given API key, and returns the body of the response
+func callAdminApi(method, url, apiKey string) ([]byte, error) {
+	if apiKey == "" {
+		return nil, errors.New("VALIDATOR_ADMIN_API_KEY is not set")
+	}
+
+	request, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+apiKey)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, errors.New(fmt.Sprintf("request failed with status [%d]: %s", response.StatusCode, strings.TrimSpace(string(body))))
+	}
+
+	return body, nil
+}
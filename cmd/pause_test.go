@@ -0,0 +1,100 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+type pauseRequest struct {
+	method        string
+	path          string
+	authorization string
+}
+
+func setupPause(status int) (*httptest.Server, *pauseRequest, func()) {
+	request := &pauseRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request.method = r.Method
+		request.path = r.URL.Path
+		request.authorization = r.Header.Get("Authorization")
+		w.WriteHeader(status)
+		w.Write([]byte(`[{"scope":"GLOBAL","createdAt":1}]`))
+	}))
+	os.Setenv("VALIDATOR_ADMIN_API_KEY", "api-key")
+	return server, request, func() {
+		server.Close()
+		os.Unsetenv("VALIDATOR_ADMIN_API_KEY")
+	}
+}
+
+func Test_Pause_Commands(t *testing.T) {
+	for _, c := range []struct {
+		args   []string
+		method string
+		path   string
+		output string
+	}{
+		{[]string{"list"}, http.MethodGet, "/api/v1/pauses", `[{"scope":"GLOBAL","createdAt":1}]` + "\n"},
+		{[]string{"pause"}, http.MethodPost, "/api/v1/pauses/global", "Paused.\n"},
+		{[]string{"pause", "-chain", "80001"}, http.MethodPost, "/api/v1/pauses/chains/80001", "Paused.\n"},
+		{[]string{"resume", "-asset", "0.0.111"}, http.MethodDelete, "/api/v1/pauses/assets/0.0.111", "Resumed.\n"},
+	} {
+		server, request, cleanup := setupPause(http.StatusOK)
+
+		var stdout bytes.Buffer
+		code := runPause(append(c.args, "-url", server.URL), &stdout)
+		cleanup()
+
+		assert.Equal(t, 0, code)
+		assert.Equal(t, c.output, stdout.String())
+		assert.Equal(t, c.method, request.method)
+		assert.Equal(t, c.path, request.path)
+		assert.Equal(t, "Bearer api-key", request.authorization)
+	}
+}
+
+func Test_Pause_FailedRequest(t *testing.T) {
+	server, _, cleanup := setupPause(http.StatusNotFound)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runPause([]string{"resume", "-url", server.URL}, &stdout)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "status [404]")
+}
+
+func Test_Pause_ChainAndAsset(t *testing.T) {
+	var stdout bytes.Buffer
+	code := runPause([]string{"pause", "-chain", "1", "-asset", "0.0.111"}, &stdout)
+
+	assert.Equal(t, 2, code)
+}
+
+func Test_Pause_MissingApiKey(t *testing.T) {
+	var stdout bytes.Buffer
+	code := runPause([]string{"list"}, &stdout)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "VALIDATOR_ADMIN_API_KEY")
+}
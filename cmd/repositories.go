@@ -19,10 +19,19 @@ package main
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	dead_letter "github.com/limechain/hedera-eth-bridge-validator/app/persistence/dead-letter"
+	evm_block "github.com/limechain/hedera-eth-bridge-validator/app/persistence/evm-block"
+	evm_event "github.com/limechain/hedera-eth-bridge-validator/app/persistence/evm-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/fee"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/pause"
+	paused_message "github.com/limechain/hedera-eth-bridge-validator/app/persistence/paused-message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/review"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/schedule"
+	screening_hit "github.com/limechain/hedera-eth-bridge-validator/app/persistence/screening-hit"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/status"
+	supply_snapshot "github.com/limechain/hedera-eth-bridge-validator/app/persistence/supply-snapshot"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/transfer"
 )
 
@@ -34,6 +43,15 @@ type Repositories struct {
 	message        repository.Message
 	fee            repository.Fee
 	schedule       repository.Schedule
+	queue          repository.Queue
+	deadLetter     repository.DeadLetter
+	review         repository.Review
+	pause          repository.Pause
+	pausedMessage  repository.PausedMessage
+	screeningHit   repository.ScreeningHit
+	supplySnapshot repository.SupplySnapshot
+	evmEvent       repository.EvmEvent
+	evmBlock       repository.EvmBlock
 }
 
 // PrepareRepositories initialises connection to the Database and instantiates the repositories
@@ -46,5 +64,14 @@ func PrepareRepositories(db database.Database) *Repositories {
 		message:        message.NewRepository(connection),
 		fee:            fee.NewRepository(connection),
 		schedule:       schedule.NewRepository(connection),
+		queue:          queue.NewRepository(connection),
+		deadLetter:     dead_letter.NewRepository(connection),
+		review:         review.NewRepository(connection),
+		pause:          pause.NewRepository(connection),
+		pausedMessage:  paused_message.NewRepository(connection),
+		screeningHit:   screening_hit.NewRepository(connection),
+		supplySnapshot: supply_snapshot.NewRepository(connection),
+		evmEvent:       evm_event.NewRepository(connection),
+		evmBlock:       evm_block.NewRepository(connection),
 	}
 }
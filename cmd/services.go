@@ -18,19 +18,26 @@ package main
 
 import (
 	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/services/burn-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/contracts"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/calculator"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/limits"
 	lock_event "github.com/limechain/hedera-eth-bridge-validator/app/services/lock-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/messages"
-	prometheusServices "github.com/limechain/hedera-eth-bridge-validator/app/services/prometheus"
 	read_only "github.com/limechain/hedera-eth-bridge-validator/app/services/read-only"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/reconciliation"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/scheduled"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/screening"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/remote"
+	transfer_events "github.com/limechain/hedera-eth-bridge-validator/app/services/transfer-events"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/transfers"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"io/ioutil"
 )
 
 type Services struct {
@@ -45,10 +52,20 @@ type Services struct {
 	scheduled        service.Scheduled
 	readOnly         service.ReadOnly
 	prometheus       service.Prometheus
+	deadLetters      service.DeadLetters
+	limits           service.Limits
+	reviews          service.Reviews
+	pause            service.Pause
+	screening        service.Screening
+	screeningList    service.ScreeningList
+	reconciliation   service.Reconciliation
+	transferEvents   service.TransferEvents
+	bridgeConfig     service.BridgeConfig
+	backfill         service.Backfill
 }
 
 // PrepareServices instantiates all the necessary services with their required context and parameters
-func PrepareServices(c config.Config, clients Clients, repositories Repositories) *Services {
+func PrepareServices(c config.Config, clients Clients, repositories Repositories, prometheus service.Prometheus) *Services {
 	evmSigners := make(map[uint64]service.Signer)
 	contractServices := make(map[uint64]service.Contracts)
 	for _, client := range clients.EVMClients {
@@ -57,15 +74,15 @@ func PrepareServices(c config.Config, clients Clients, repositories Repositories
 			panic(err)
 		}
 		chainId := chain.Uint64()
-		evmSigners[chainId] = evm.NewEVMSigner(client.GetPrivateKey())
+		evmSigners[chainId] = prepareEVMSigner(c.Node.Signer, c.Node.Keystore, c.Node.Clients.Evm[chainId], client)
 		contractServices[chainId] = contracts.NewService(client, c.Bridge.EVMs[chainId].RouterContractAddress, c.Bridge.Assets.FungibleNetworkAssets(chainId))
 	}
 
-	fees := calculator.New(c.Bridge.Hedera.FeePercentages)
+	fees := calculator.New(c.Bridge.Assets)
 	distributor := distributor.New(c.Bridge.Hedera.Members)
-	scheduled := scheduled.New(c.Bridge.Hedera.PayerAccount, clients.HederaNode, clients.MirrorNode)
+	transferEvents := transfer_events.NewService()
+	scheduled := scheduled.New(c.Bridge.Hedera.PayerAccount, clients.HederaNode, clients.MirrorNode, transferEvents)
 
-	prometheus := prometheusServices.NewService(c.Bridge.Assets, c.Node.Monitoring.Enable)
 	messages := messages.NewService(
 		evmSigners,
 		contractServices,
@@ -74,7 +91,8 @@ func PrepareServices(c config.Config, clients Clients, repositories Repositories
 		clients.MirrorNode,
 		clients.EVMClients,
 		c.Bridge.TopicId,
-		c.Bridge.Assets)
+		c.Bridge.Assets,
+		transferEvents)
 
 	transfers := transfers.NewService(
 		clients.HederaNode,
@@ -83,14 +101,18 @@ func PrepareServices(c config.Config, clients Clients, repositories Repositories
 		repositories.transfer,
 		repositories.schedule,
 		repositories.fee,
+		repositories.evmEvent,
 		fees,
 		distributor,
 		c.Bridge.TopicId,
 		c.Bridge.Hedera.BridgeAccount,
-		c.Bridge.Hedera.NftFees,
+		c.Bridge.Assets,
 		scheduled,
 		messages,
-		prometheus)
+		prometheus,
+		transferEvents)
+
+	limits := limits.NewService(repositories.transfer, repositories.review, c.Bridge.Assets, clients.MirrorNode, clients.EVMClients)
 
 	burnEvent := burn_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
@@ -101,16 +123,31 @@ func PrepareServices(c config.Config, clients Clients, repositories Repositories
 		scheduled,
 		fees,
 		transfers,
+		limits,
 		prometheus)
 
 	lockEvent := lock_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
+		clients.MirrorNode,
 		repositories.transfer,
 		repositories.schedule,
 		scheduled,
 		transfers,
+		limits,
 		prometheus)
 
+	screeningList := prepareScreeningList(c.Node.Screening)
+	screening := screening.NewService(screeningList, repositories.transfer, repositories.screeningHit, clients.EVMClients, prometheus)
+
+	reconciliation := reconciliation.NewService(
+		clients.MirrorNode,
+		clients.EVMClients,
+		c.Bridge,
+		repositories.transfer,
+		repositories.supplySnapshot,
+		prometheus,
+		c.Node.Reconciliation.Tolerance)
+
 	readOnly := read_only.New(clients.MirrorNode, repositories.transfer, c.Node.Clients.MirrorNode.PollingInterval)
 
 	return &Services{
@@ -125,7 +162,46 @@ func PrepareServices(c config.Config, clients Clients, repositories Repositories
 		scheduled:        scheduled,
 		readOnly:         readOnly,
 		prometheus:       prometheus,
+		limits:           limits,
+		screening:        screening,
+		screeningList:    screeningList,
+		reconciliation:   reconciliation,
+		transferEvents:   transferEvents,
+	}
+}
+
+// prepareScreeningList loads the screening list file. Returns nil if no list file is configured
+func prepareScreeningList(screeningConfig config.Screening) service.ScreeningList {
+	if screeningConfig.ListFile == "" {
+		log.Infoln("Screening list is not configured. Senders and receivers of transfers are not screened.")
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(screeningConfig.ListFile)
+	if err != nil {
+		log.Fatalf("Failed to read screening list [%s]. Error: [%s]", screeningConfig.ListFile, err)
+	}
+	list := screening.NewList()
+	if err := list.Load(content); err != nil {
+		log.Fatalf("Failed to load screening list [%s]. Error: [%s]", screeningConfig.ListFile, err)
+	}
+	return list
+}
+
+// prepareEVMSigner instantiates the signer of the given EVM network. Keys are held by the remote signer, if one is
+// configured, and otherwise decrypted from the keystore or loaded from the private key of the EVM client
+func prepareEVMSigner(signerConfig config.Signer, keystoreConfig config.Keystore, evmConfig config.Evm, evmClient client.EVM) service.Signer {
+	if signerConfig.Url != "" {
+		return remote.NewSigner(signerConfig, evmConfig.SignerAddress)
+	}
+	if evmConfig.Keystore != "" {
+		passphrase, err := keystoreConfig.GetPassphrase()
+		if err != nil {
+			log.Fatalf("Failed to get the passphrase of EVM keystore [%s]. Error: [%s]", evmConfig.Keystore, err)
+		}
+		return evm.NewEVMSignerFromKeystore(evmConfig.Keystore, passphrase)
 	}
+	return evm.NewEVMSigner(evmClient.GetPrivateKey())
 }
 
 // PrepareApiOnlyServices instantiates all the necessary services with their
@@ -144,5 +220,6 @@ func PrepareApiOnlyServices(c config.Config, clients Clients) *Services {
 
 	return &Services{
 		contractServices: contractServices,
+		transferEvents:   transfer_events.NewService(),
 	}
 }
@@ -25,18 +25,21 @@ import (
 )
 
 func TestPrepareServices(t *testing.T) {
-	client := PrepareClients(tc.TestConfig.Node.Clients)
-
 	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	client := PrepareClients(tc.TestConfig.Node.Clients, tc.TestConfig.Node.Keystore, mocks.MPrometheusService)
+
 	mocks.MDatabase.On("GetConnection").Return(&gorm.DB{})
 	repositories := PrepareRepositories(mocks.MDatabase)
 
-	res := PrepareServices(tc.TestConfig, *client, *repositories)
+	res := PrepareServices(tc.TestConfig, *client, *repositories, mocks.MPrometheusService)
 	assert.NotEmpty(t, res)
 }
 
 func TestPrepareApiOnlyServices(t *testing.T) {
-	client := PrepareClients(tc.TestConfig.Node.Clients)
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	client := PrepareClients(tc.TestConfig.Node.Clients, tc.TestConfig.Node.Keystore, mocks.MPrometheusService)
 	res := PrepareApiOnlyServices(tc.TestConfig, *client)
 	assert.NotEmpty(t, res)
 }
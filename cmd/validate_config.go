@@ -0,0 +1,178 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm"
+	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"io"
+	"sort"
+)
+
+// runValidateConfig executes the validate-config subcommand with the given arguments and returns the exit code
+func runValidateConfig(args []string, stdout io.Writer) int {
+	flags := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	bridgeFile := flags.String("bridge", config.DefaultBridgeFile, "The bridge configuration file")
+	nodeFile := flags.String("node", config.DefaultNodeFile, "The node configuration file")
+	onChain := flags.Bool("on-chain", false, "Verify the configured topic, accounts, tokens and contracts against the mirror node and the EVM nodes")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	parsed, err := config.ReadConfig(*bridgeFile, *nodeFile)
+	if err != nil {
+		fmt.Fprintf(stdout, "Error: %s\n", err)
+		return 1
+	}
+
+	problems := config.Validate(parsed)
+	if *onChain {
+		if len(problems) == 0 {
+			configuration := config.Config{
+				Node:   config.New(parsed.Node),
+				Bridge: config.NewBridge(parsed.Bridge),
+			}
+			mirrorNode, evmClients := prepareVerificationClients(configuration.Node.Clients)
+			problems = verifyOnChain(configuration, mirrorNode, evmClients)
+		} else {
+			fmt.Fprintln(stdout, "On-chain verification is skipped, because the configuration is invalid.")
+		}
+	}
+
+	if len(problems) == 0 {
+		fmt.Fprintln(stdout, "Configuration is valid.")
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "Found %d problem(s) in the configuration:\n", len(problems))
+	for _, problem := range problems {
+		fmt.Fprintf(stdout, "  - %s\n", problem)
+	}
+	return 1
+}
+
+// prepareVerificationClients creates the read-only clients, needed for the on-chain verification
+func prepareVerificationClients(c config.Clients) (client.MirrorNode, map[uint64]client.EVM) {
+	evmClients := make(map[uint64]client.EVM)
+	for chainId, ec := range c.Evm {
+		evmClients[chainId] = evm.NewClient(chainId, ec, nil)
+	}
+
+	return mirror_node.NewClient(c.MirrorNode, nil), evmClients
+}
+
+// verifyOnChain checks that the configured topic, accounts, tokens and contracts exist
+// and that the EVM clients are connected to the configured networks
+func verifyOnChain(c config.Config, mirrorNode client.MirrorNode, evmClients map[uint64]client.EVM) []error {
+	ctx := context.Background()
+	var problems []error
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, errors.New(fmt.Sprintf(format, args...)))
+	}
+
+	topicId, _ := hedera.TopicIDFromString(c.Bridge.TopicId)
+	if !mirrorNode.TopicExists(ctx, topicId) {
+		add("Topic [%s] does not exist", c.Bridge.TopicId)
+	}
+
+	accounts := append([]string{c.Bridge.Hedera.BridgeAccount, c.Bridge.Hedera.PayerAccount}, c.Bridge.Hedera.Members...)
+	for _, account := range accounts {
+		accountId, _ := hedera.AccountIDFromString(account)
+		if !mirrorNode.AccountExists(ctx, accountId) {
+			add("Account [%s] does not exist", account)
+		}
+	}
+
+	assets := networkAssets(c.Bridge.Assets)
+	for _, asset := range assets[constants.HederaNetworkId] {
+		if asset == constants.Hbar {
+			continue
+		}
+		if _, err := mirrorNode.GetToken(ctx, asset); err != nil {
+			add("Token [%s] cannot be retrieved from the mirror node: %s", asset, err)
+		}
+	}
+
+	chainIds := make([]uint64, 0, len(evmClients))
+	for chainId := range evmClients {
+		chainIds = append(chainIds, chainId)
+	}
+	sort.Slice(chainIds, func(i, j int) bool { return chainIds[i] < chainIds[j] })
+
+	for _, chainId := range chainIds {
+		evmClient := evmClients[chainId]
+		actual, err := evmClient.ChainID(ctx)
+		if err != nil {
+			add("Failed to retrieve the chain ID of the node(s) for network [%d]: %s", chainId, err)
+			continue
+		}
+		if actual.Uint64() != chainId {
+			add("The node(s) for network [%d] are connected to network [%d]", chainId, actual.Uint64())
+			continue
+		}
+
+		router := c.Bridge.EVMs[chainId].RouterContractAddress
+		if _, err := evmClient.ValidateContractDeployedAt(router); err != nil {
+			add("Router contract on network [%d]: %s", chainId, err)
+		}
+		for _, asset := range assets[chainId] {
+			if _, err := evmClient.ValidateContractDeployedAt(asset); err != nil {
+				add("Token contract on network [%d]: %s", chainId, err)
+			}
+		}
+	}
+
+	return problems
+}
+
+// networkAssets returns the sorted native and wrapped assets of every network
+func networkAssets(assets config.Assets) map[uint64][]string {
+	unique := make(map[uint64]map[string]bool)
+	add := func(chainId uint64, asset string) {
+		if unique[chainId] == nil {
+			unique[chainId] = make(map[string]bool)
+		}
+		unique[chainId][asset] = true
+	}
+
+	for nativeChainId, nativeAssets := range assets.GetNativeToWrapped() {
+		for nativeAsset, wrapped := range nativeAssets {
+			add(nativeChainId, nativeAsset)
+			for wrappedChainId, wrappedAsset := range wrapped {
+				add(wrappedChainId, wrappedAsset)
+			}
+		}
+	}
+
+	result := make(map[uint64][]string)
+	for chainId, chainAssets := range unique {
+		for asset := range chainAssets {
+			result[chainId] = append(result[chainId], asset)
+		}
+		sort.Strings(result[chainId])
+	}
+	return result
+}
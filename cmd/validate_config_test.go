@@ -0,0 +1,195 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	validateRouter  = "0x0000000000000000000000000000000000000001"
+	validateWrapped = "0x0000000000000000000000000000000000000002"
+
+	validateBridgeYml = `bridge:
+  topic_id: 0.0.2001
+  networks:
+    0:
+      name: Hedera
+      bridge_account: 0.0.3001
+      payer_account: 0.0.3002
+      members:
+        - 0.0.3003
+      tokens:
+        fungible:
+          "HBAR":
+            fee_percentage: 10000
+            networks:
+              80001: ` + validateWrapped + `
+    80001:
+      name: Mumbai
+      router_contract_address: ` + validateRouter + `
+`
+	validateNodeYml = `node:
+  clients:
+    evm:
+      80001:
+        block_confirmations: 5
+        node_url: http://localhost:8545
+        private_key: ` + evmPrivateKey + `
+    hedera:
+      network: testnet
+      operator:
+        account_id: 0.0.1001
+        private_key: 302e020100300506032b657004220420b10d4e3ac2e3f1b8a0a1d4e2f1d9c8b7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1
+    mirror_node:
+      api_address: http://localhost:5551/api/v1/
+`
+)
+
+func writeValidateConfig(t *testing.T, bridge, node string) (string, string, func()) {
+	dir, err := ioutil.TempDir("", "validate-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	bridgeFile := filepath.Join(dir, "bridge.yml")
+	nodeFile := filepath.Join(dir, "node.yml")
+	if err := ioutil.WriteFile(bridgeFile, []byte(bridge), 0600); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(nodeFile, []byte(node), 0600); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return bridgeFile, nodeFile, cleanup
+}
+
+func Test_ValidateConfig(t *testing.T) {
+	bridgeFile, nodeFile, cleanup := writeValidateConfig(t, validateBridgeYml, validateNodeYml)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runValidateConfig([]string{"-bridge", bridgeFile, "-node", nodeFile}, &stdout)
+
+	assert.Equal(t, 0, code, stdout.String())
+	assert.Equal(t, "Configuration is valid.\n", stdout.String())
+}
+
+func Test_ValidateConfig_Invalid(t *testing.T) {
+	bridgeFile, nodeFile, cleanup := writeValidateConfig(t, validateBridgeYml+`    5:
+      name: Goerli
+`, validateNodeYml)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runValidateConfig([]string{"-bridge", bridgeFile, "-node", nodeFile, "-on-chain"}, &stdout)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "On-chain verification is skipped")
+	assert.Contains(t, stdout.String(), "Found 2 problem(s) in the configuration:\n")
+	assert.Contains(t, stdout.String(), "  - bridge.networks.5.router_contract_address [] is not a valid address\n")
+	assert.Contains(t, stdout.String(), "  - bridge.networks.5 has no corresponding client in node.clients.evm\n")
+}
+
+func Test_ValidateConfig_MalformedFile(t *testing.T) {
+	bridgeFile, nodeFile, cleanup := writeValidateConfig(t, "bridge: [", validateNodeYml)
+	defer cleanup()
+
+	var stdout bytes.Buffer
+	code := runValidateConfig([]string{"-bridge", bridgeFile, "-node", nodeFile}, &stdout)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), "Error: failed to parse")
+}
+
+func verificationConfig() config.Config {
+	return config.Config{
+		Bridge: config.NewBridge(parser.Bridge{
+			TopicId: "0.0.2001",
+			Networks: map[uint64]*parser.Network{
+				0: {
+					Name:          "Hedera",
+					BridgeAccount: "0.0.3001",
+					PayerAccount:  "0.0.3002",
+					Members:       []string{"0.0.3003"},
+					Tokens: parser.Tokens{
+						Fungible: map[string]parser.Token{
+							"HBAR":     {Networks: map[uint64]string{80001: validateWrapped}},
+							"0.0.4001": {Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000003"}},
+						},
+					},
+				},
+				80001: {
+					Name:                  "Mumbai",
+					RouterContractAddress: validateRouter,
+				},
+			},
+		}),
+	}
+}
+
+func Test_VerifyOnChain(t *testing.T) {
+	mocks.Setup()
+	mocks.MHederaMirrorClient.On("TopicExists", mock.Anything).Return(true)
+	mocks.MHederaMirrorClient.On("AccountExists", mock.Anything).Return(true)
+	mocks.MHederaMirrorClient.On("GetToken", "0.0.4001").Return(&model.TokenResponse{}, nil)
+	mocks.MEVMClient.On("ChainID", mock.Anything).Return(big.NewInt(80001), nil)
+	mocks.MEVMClient.On("ValidateContractDeployedAt", mock.Anything).Return(&common.Address{}, nil)
+
+	problems := verifyOnChain(verificationConfig(), mocks.MHederaMirrorClient, map[uint64]client.EVM{80001: mocks.MEVMClient})
+
+	assert.Empty(t, problems)
+	mocks.MEVMClient.AssertCalled(t, "ValidateContractDeployedAt", validateRouter)
+	mocks.MEVMClient.AssertCalled(t, "ValidateContractDeployedAt", common.HexToAddress(validateWrapped).String())
+}
+
+func Test_VerifyOnChain_Mismatches(t *testing.T) {
+	mocks.Setup()
+	topicId, _ := hedera.TopicIDFromString("0.0.2001")
+	payer, _ := hedera.AccountIDFromString("0.0.3002")
+	mocks.MHederaMirrorClient.On("TopicExists", topicId).Return(false)
+	mocks.MHederaMirrorClient.On("AccountExists", payer).Return(false)
+	mocks.MHederaMirrorClient.On("AccountExists", mock.Anything).Return(true)
+	mocks.MHederaMirrorClient.On("GetToken", "0.0.4001").Return((*model.TokenResponse)(nil), errors.New("not found"))
+	mocks.MEVMClient.On("ChainID", mock.Anything).Return(big.NewInt(5), nil)
+
+	problems := verifyOnChain(verificationConfig(), mocks.MHederaMirrorClient, map[uint64]client.EVM{80001: mocks.MEVMClient})
+
+	assert.Len(t, problems, 4)
+	assert.Equal(t, "Topic [0.0.2001] does not exist", problems[0].Error())
+	assert.Equal(t, "Account [0.0.3002] does not exist", problems[1].Error())
+	assert.Equal(t, "Token [0.0.4001] cannot be retrieved from the mirror node: not found", problems[2].Error())
+	assert.Equal(t, "The node(s) for network [80001] are connected to network [5]", problems[3].Error())
+	mocks.MEVMClient.AssertNotCalled(t, "ValidateContractDeployedAt", mock.Anything)
+}
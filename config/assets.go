@@ -17,6 +17,9 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"github.com/ethereum/go-ethereum/common"
 	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
@@ -24,9 +27,18 @@ import (
 	log "github.com/sirupsen/logrus"
 	"math/big"
 	"regexp"
+	"sync/atomic"
 )
 
+// Assets provides access to the active asset mapping and fees. All copies of an Assets share
+// the active mapping, so that a Reload applies to all of them at once
 type Assets struct {
+	current *atomic.Value
+}
+
+type assetsSnapshot struct {
+	// The version of the bridge configuration, from which the snapshot is loaded
+	version string
 	// A mapping, storing all networks' native tokens and their corresponding wrapped tokens
 	nativeToWrapped map[uint64]map[string]map[uint64]string
 	// A mapping, storing all networks' wrapped tokens and their corresponding native asset
@@ -35,55 +47,121 @@ type Assets struct {
 	fungibleNetworkAssets map[uint64][]string
 	// A mapping, storing all fungible native assets per network
 	fungibleNativeAssets map[uint64]map[string]*NativeAsset
+	// A mapping, storing the fee percentages of Hedera native fungible tokens
+	feePercentages map[string]int64
+	// A mapping, storing the fees of Hedera native NFTs
+	nftFees map[string]int64
+	// A mapping, storing the HTS tokens, which wrap the token IDs of EVM native ERC-1155 contracts
+	erc1155Wrapped map[uint64]map[string]map[int64]string
+	// A mapping, storing the ERC-1155 token IDs, which HTS tokens wrap
+	erc1155Native map[string]*Erc1155Asset
+}
+
+// Version returns the version of the active bridge configuration
+func (a Assets) Version() string {
+	return a.snapshot().version
+}
+
+// FeePercentage returns the fee percentage of the given Hedera native fungible token
+func (a Assets) FeePercentage(asset string) int64 {
+	return a.snapshot().feePercentages[asset]
+}
+
+// NftFee returns the fee of the given Hedera native NFT
+func (a Assets) NftFee(asset string) int64 {
+	return a.snapshot().nftFees[asset]
+}
+
+// Reload atomically replaces the active asset mapping and fees with the ones of the given networks.
+// The networks are expected to be validated beforehand
+func (a Assets) Reload(networks map[uint64]*parser.Network) {
+	a.current.Store(loadSnapshot(networks))
+}
+
+func (a Assets) snapshot() *assetsSnapshot {
+	if a.current == nil {
+		return &assetsSnapshot{}
+	}
+	return a.current.Load().(*assetsSnapshot)
 }
 
 type NativeAsset struct {
 	MinAmount *big.Int
-	ChainId   uint64
-	Asset     string
+	// Limits, above which transfers of the asset are held for review. Nil if not limited
+	MaxAmount   *big.Int
+	HourlyLimit *big.Int
+	DailyLimit  *big.Int
+	ChainId     uint64
+	Asset       string
+}
+
+// Erc1155Asset is a single token ID of an EVM native ERC-1155 contract
+type Erc1155Asset struct {
+	ChainId uint64
+	Asset   string
+	TokenId int64
+}
+
+// Erc1155Wrapped returns the HTS token, which wraps the given token ID of the ERC-1155 contract
+func (a Assets) Erc1155Wrapped(chainId uint64, asset string, tokenId int64) string {
+	return a.snapshot().erc1155Wrapped[chainId][asset][tokenId]
+}
+
+// Erc1155Native returns the ERC-1155 token ID, which the given HTS token wraps.
+// Returns nil if the HTS token does not wrap an ERC-1155 token ID
+func (a Assets) Erc1155Native(wrappedAsset string) *Erc1155Asset {
+	return a.snapshot().erc1155Native[wrappedAsset]
 }
 
 func (a Assets) GetFungibleNetworkAssets() map[uint64][]string {
-	return a.fungibleNetworkAssets
+	return a.snapshot().fungibleNetworkAssets
 }
 
 func (a Assets) WrappedFromNative(nativeChainId uint64, nativeAsset string) map[uint64]string {
-	return a.nativeToWrapped[nativeChainId][nativeAsset]
+	return a.snapshot().nativeToWrapped[nativeChainId][nativeAsset]
 }
 
 func (a Assets) GetNativeToWrapped() map[uint64]map[string]map[uint64]string {
-	return a.nativeToWrapped
+	return a.snapshot().nativeToWrapped
 }
 
 func (a Assets) NativeToWrapped(nativeAsset string, nativeChainId, targetChainId uint64) string {
-	return a.nativeToWrapped[nativeChainId][nativeAsset][targetChainId]
+	return a.snapshot().nativeToWrapped[nativeChainId][nativeAsset][targetChainId]
 }
 
 func (a Assets) WrappedToNative(wrappedAsset string, wrappedChainId uint64) *NativeAsset {
-	return a.wrappedToNative[wrappedChainId][wrappedAsset]
+	return a.snapshot().wrappedToNative[wrappedChainId][wrappedAsset]
 }
 
 func (a Assets) FungibleNetworkAssets(id uint64) []string {
-	return a.fungibleNetworkAssets[id]
+	return a.snapshot().fungibleNetworkAssets[id]
 }
 
 func (a Assets) FungibleNativeAsset(id uint64, asset string) *NativeAsset {
-	return a.fungibleNativeAssets[id][asset]
+	return a.snapshot().fungibleNativeAssets[id][asset]
 }
 
 func (a Assets) IsNative(networkId uint64, asset string) bool {
-	_, isNative := a.nativeToWrapped[networkId][asset]
+	_, isNative := a.snapshot().nativeToWrapped[networkId][asset]
 	return isNative
 }
 
+// GetOppositeAsset returns the counterpart of the given asset on the other chain of a transfer.
+// Wrapped assets of wrapped to wrapped transfers are resolved to the wrapped asset of the other chain.
 func (a Assets) GetOppositeAsset(sourceChainId uint64, targetChainId uint64, asset string) string {
 	nativeAssetForTargetChain := a.WrappedToNative(asset, sourceChainId)
 	if nativeAssetForTargetChain != nil {
+		if nativeAssetForTargetChain.ChainId != targetChainId {
+			return a.NativeToWrapped(nativeAssetForTargetChain.Asset, nativeAssetForTargetChain.ChainId, targetChainId)
+		}
 		return nativeAssetForTargetChain.Asset
 	}
 
 	nativeAssetForSourceChain := a.WrappedToNative(asset, targetChainId)
 	if nativeAssetForSourceChain != nil {
+		if nativeAssetForSourceChain.ChainId != sourceChainId {
+			return a.NativeToWrapped(nativeAssetForSourceChain.Asset, nativeAssetForSourceChain.ChainId, sourceChainId)
+		}
 		return nativeAssetForSourceChain.Asset
 	}
 
@@ -95,10 +173,18 @@ func (a Assets) GetOppositeAsset(sourceChainId uint64, targetChainId uint64, ass
 }
 
 func LoadAssets(networks map[uint64]*parser.Network) Assets {
+	current := &atomic.Value{}
+	current.Store(loadSnapshot(networks))
+	return Assets{current: current}
+}
+
+func loadSnapshot(networks map[uint64]*parser.Network) *assetsSnapshot {
 	nativeToWrapped := make(map[uint64]map[string]map[uint64]string)
 	wrappedToNative := make(map[uint64]map[string]*NativeAsset)
 	fungibleNetworkAssets := make(map[uint64][]string)
 	fungibleNativeAssets := make(map[uint64]map[string]*NativeAsset)
+	erc1155Wrapped := make(map[uint64]map[string]map[int64]string)
+	erc1155Native := make(map[string]*Erc1155Asset)
 
 	re, _ := regexp.Compile(constants.EvmCompatibleAddressPattern)
 
@@ -124,9 +210,12 @@ func LoadAssets(networks map[uint64]*parser.Network) Assets {
 				log.Fatalf("Failed to parse min amount [%s]. Error: [%s]", nativeAssetMapping.MinAmount, err)
 			}
 			asset := &NativeAsset{
-				MinAmount: minAmount,
-				ChainId:   nativeChainId,
-				Asset:     nativeAsset,
+				MinAmount:   minAmount,
+				MaxAmount:   parseLimit(nativeAssetMapping.MaxAmount),
+				HourlyLimit: parseLimit(nativeAssetMapping.HourlyLimit),
+				DailyLimit:  parseLimit(nativeAssetMapping.DailyLimit),
+				ChainId:     nativeChainId,
+				Asset:       nativeAsset,
 			}
 			fungibleNativeAssets[nativeChainId][nativeAsset] = asset
 
@@ -169,14 +258,52 @@ func LoadAssets(networks map[uint64]*parser.Network) Assets {
 				}
 			}
 		}
+
+		for nativeAsset, erc1155Token := range network.Tokens.Erc1155 {
+			nativeAsset = common.HexToAddress(nativeAsset).String()
+			if erc1155Wrapped[nativeChainId] == nil {
+				erc1155Wrapped[nativeChainId] = make(map[string]map[int64]string)
+			}
+			erc1155Wrapped[nativeChainId][nativeAsset] = make(map[int64]string)
+
+			for tokenId, wrappedAsset := range erc1155Token.TokenIds {
+				erc1155Wrapped[nativeChainId][nativeAsset][tokenId] = wrappedAsset
+				erc1155Native[wrappedAsset] = &Erc1155Asset{
+					ChainId: nativeChainId,
+					Asset:   nativeAsset,
+					TokenId: tokenId,
+				}
+			}
+		}
 	}
 
-	return Assets{
+	feePercentages, nftFees := map[string]int64{}, map[string]int64{}
+	if hederaNetwork := networks[constants.HederaNetworkId]; hederaNetwork != nil {
+		feePercentages, nftFees = LoadHederaFees(hederaNetwork.Tokens)
+	}
+
+	return &assetsSnapshot{
+		version:               bridgeVersion(networks),
 		nativeToWrapped:       nativeToWrapped,
 		wrappedToNative:       wrappedToNative,
 		fungibleNativeAssets:  fungibleNativeAssets,
 		fungibleNetworkAssets: fungibleNetworkAssets,
+		feePercentages:        feePercentages,
+		nftFees:               nftFees,
+		erc1155Wrapped:        erc1155Wrapped,
+		erc1155Native:         erc1155Native,
+	}
+}
+
+// bridgeVersion derives the version of the bridge configuration from its networks.
+// Identical configurations result in the same version on every validator
+func bridgeVersion(networks map[uint64]*parser.Network) string {
+	content, err := json.Marshal(networks)
+	if err != nil {
+		log.Fatalf("Failed to marshal the bridge networks. Error: [%s]", err)
 	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:8])
 }
 
 func parseAmount(amount string) (*big.Int, error) {
@@ -186,3 +313,16 @@ func parseAmount(amount string) (*big.Int, error) {
 
 	return big_numbers.ToBigInt(amount)
 }
+
+// parseLimit parses an amount limit. Returns nil if the limit is not set
+func parseLimit(limit string) *big.Int {
+	if limit == "" {
+		return nil
+	}
+
+	amount, err := big_numbers.ToBigInt(limit)
+	if err != nil {
+		log.Fatalf("Failed to parse amount limit [%s]. Error: [%s]", limit, err)
+	}
+	return amount
+}
@@ -17,6 +17,7 @@
 package config
 
 import (
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
 	"github.com/stretchr/testify/assert"
@@ -56,6 +57,16 @@ func Test_GetOppositeAsset(t *testing.T) {
 
 }
 
+func Test_GetOppositeAsset_WrappedToWrapped(t *testing.T) {
+	assets := LoadAssets(testConstants.Networks)
+
+	actual := assets.GetOppositeAsset(0, 1, constants.Hbar)
+	assert.Equal(t, "0xsome-other-eth-address", actual)
+
+	actual = assets.GetOppositeAsset(0, 1, "0xsome-other-eth-address")
+	assert.Equal(t, constants.Hbar, actual)
+}
+
 func Test_NativeToWrapped(t *testing.T) {
 	assets := LoadAssets(testConstants.Networks)
 
@@ -74,3 +85,48 @@ func Test_WrappedToNative(t *testing.T) {
 	assert.NotNil(t, actual)
 	assert.Equal(t, expected, actual.Asset)
 }
+
+func Test_Reload(t *testing.T) {
+	assets := LoadAssets(testConstants.Networks)
+	shared := assets
+	version := assets.Version()
+
+	assets.Reload(map[uint64]*parser.Network{
+		constants.HederaNetworkId: {
+			Tokens: parser.Tokens{
+				Fungible: map[string]parser.Token{constants.Hbar: {FeePercentage: 5000, Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000001"}}},
+				Nft:      map[string]parser.Token{"0.0.4001": {Fee: 100}},
+			},
+		},
+	})
+
+	assert.NotEqual(t, version, shared.Version())
+	assert.Equal(t, int64(5000), shared.FeePercentage(constants.Hbar))
+	assert.Equal(t, int64(100), shared.NftFee("0.0.4001"))
+	assert.Equal(t, "0x0000000000000000000000000000000000000001", shared.NativeToWrapped(constants.Hbar, constants.HederaNetworkId, 80001))
+	assert.Nil(t, shared.WrappedToNative("0x0000000000000000000000000000000000000001", 33))
+}
+
+func Test_Version(t *testing.T) {
+	assert.Equal(t, LoadAssets(testConstants.Networks).Version(), LoadAssets(testConstants.Networks).Version())
+	assert.Len(t, LoadAssets(testConstants.Networks).Version(), 16)
+	assert.Empty(t, Assets{}.Version())
+}
+
+func Test_Erc1155(t *testing.T) {
+	assets := LoadAssets(map[uint64]*parser.Network{
+		80001: {
+			Tokens: parser.Tokens{
+				Erc1155: map[string]parser.Erc1155Token{
+					"0x0000000000000000000000000000000000000abc": {TokenIds: map[int64]string{1: "0.0.5001", 2: "0.0.5002"}},
+				},
+			},
+		},
+	})
+	contract := "0x0000000000000000000000000000000000000aBc"
+
+	assert.Equal(t, "0.0.5002", assets.Erc1155Wrapped(80001, contract, 2))
+	assert.Equal(t, "", assets.Erc1155Wrapped(80001, contract, 3))
+	assert.Equal(t, &Erc1155Asset{ChainId: 80001, Asset: contract, TokenId: 1}, assets.Erc1155Native("0.0.5001"))
+	assert.Nil(t, assets.Erc1155Native("0.0.5003"))
+}
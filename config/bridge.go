@@ -31,18 +31,19 @@ type Bridge struct {
 }
 
 type BridgeHedera struct {
-	BridgeAccount  string
-	PayerAccount   string
-	Members        []string
-	Tokens         map[string]HederaToken
-	FeePercentages map[string]int64
-	NftFees        map[string]int64
+	BridgeAccount string
+	PayerAccount  string
+	Members       []string
+	Tokens        map[string]HederaToken
 }
 
 type HederaToken struct {
 	Fee           int64
 	FeePercentage int64
 	MinAmount     string
+	MaxAmount     string
+	HourlyLimit   string
+	DailyLimit    string
 	Networks      map[uint64]string
 }
 
@@ -81,19 +82,19 @@ func NewBridge(bridge parser.Bridge) Bridge {
 			for name, value := range value.Tokens.Nft {
 				config.Hedera.Tokens[name] = HederaToken(value)
 			}
-			hederaFeePercentages, hederaNftFees := LoadHederaFees(value.Tokens)
-			config.Hedera.FeePercentages = hederaFeePercentages
-			config.Hedera.NftFees = hederaNftFees
 			continue
 		}
 		config.EVMs[key] = BridgeEvm{
 			RouterContractAddress: value.RouterContractAddress,
 			Tokens:                make(map[string]Token),
 		}
-		// Currently, only EVM Fungible native tokens are supported
 		for name, value := range value.Tokens.Fungible {
 			config.EVMs[key].Tokens[name] = Token{Networks: value.Networks}
 		}
+		// EVM native NFTs are bridged only to Hedera
+		for name, value := range value.Tokens.Nft {
+			config.EVMs[key].Tokens[name] = Token{Networks: value.Networks}
+		}
 	}
 
 	return config
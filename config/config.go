@@ -17,6 +17,8 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"github.com/caarlos0/env/v6"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"io/ioutil"
@@ -28,24 +30,75 @@ import (
 )
 
 const (
-	defaultBridgeFile = "config/bridge.yml"
-	defaultNodeFile   = "config/node.yml"
+	DefaultBridgeFile = "config/bridge.yml"
+	DefaultNodeFile   = "config/node.yml"
 )
 
 func LoadConfig() (Config, parser.Bridge) {
-	var parsed parser.Config
-	GetConfig(&parsed, defaultBridgeFile)
-	GetConfig(&parsed, defaultNodeFile)
+	parsed := parseDefaultConfig()
+	return Config{
+		Node:   New(parsed.Node),
+		Bridge: NewBridge(parsed.Bridge),
+	}, parsed.Bridge
+}
 
-	if err := env.Parse(&parsed); err != nil {
-		panic(err)
+// LoadValidConfig loads the configuration the same way as LoadConfig, but validates it beforehand.
+// If the configuration is invalid, all problems found are logged and the process exits
+func LoadValidConfig() (Config, parser.Bridge) {
+	parsed := parseDefaultConfig()
+	if problems := Validate(parsed); len(problems) > 0 {
+		for _, problem := range problems {
+			log.Error(problem)
+		}
+		log.Fatalf("Invalid configuration. Found [%d] problem(s). Run the validate-config command for details.", len(problems))
 	}
+
 	return Config{
 		Node:   New(parsed.Node),
 		Bridge: NewBridge(parsed.Bridge),
 	}, parsed.Bridge
 }
 
+// ReadConfig parses the given bridge and node configuration files and the environment.
+// Contrary to LoadConfig, missing or malformed files are reported as errors
+func ReadConfig(bridgePath, nodePath string) (parser.Config, error) {
+	return readConfig(bridgePath, nodePath)
+}
+
+// ReadNodeConfig parses the given node configuration file and the environment, leaving the bridge configuration empty
+func ReadNodeConfig(nodePath string) (parser.Config, error) {
+	return readConfig(nodePath)
+}
+
+func readConfig(paths ...string) (parser.Config, error) {
+	var parsed parser.Config
+	for _, path := range paths {
+		yamlFile, err := ioutil.ReadFile(path)
+		if err != nil {
+			return parsed, err
+		}
+		if err := yaml.Unmarshal(yamlFile, &parsed); err != nil {
+			return parsed, errors.New(fmt.Sprintf("failed to parse [%s]: %s", path, err))
+		}
+	}
+
+	if err := env.Parse(&parsed); err != nil {
+		return parsed, err
+	}
+	return parsed, nil
+}
+
+func parseDefaultConfig() parser.Config {
+	var parsed parser.Config
+	GetConfig(&parsed, DefaultBridgeFile)
+	GetConfig(&parsed, DefaultNodeFile)
+
+	if err := env.Parse(&parsed); err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
 func GetConfig(config interface{}, path string) error {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return err
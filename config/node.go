@@ -17,19 +17,33 @@
 package config
 
 import (
+	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	log "github.com/sirupsen/logrus"
+	"io/ioutil"
+	"strings"
 	"time"
 )
 
 type Node struct {
-	Database   Database
-	Clients    Clients
-	LogLevel   string
-	Port       string
-	Validator  bool
-	Monitoring Monitoring
+	Database        Database
+	Clients         Clients
+	LogLevel        string
+	Port            string
+	Validator       bool
+	Monitoring      Monitoring
+	Queue           Queue
+	Workers         Workers
+	Admin           Admin
+	Signer          Signer
+	Keystore        Keystore
+	BridgeConfig    BridgeConfig
+	Screening       Screening
+	Reconciliation  Reconciliation
+	Recovery        Recovery
+	Backfill        Backfill
+	ShutdownTimeout time.Duration
 }
 
 type Database struct {
@@ -49,10 +63,17 @@ type Clients struct {
 type Evm struct {
 	BlockConfirmations uint64
 	NodeUrl            string
+	NodeUrls           []string
 	PrivateKey         string
+	Keystore           string
+	SignerAddress      string
 	StartBlock         int64
 	PollingInterval    time.Duration
 	MaxLogsBlocks      int64
+	RpcQuorum          int
+	RpcRequestTimeout  time.Duration
+	RpcHealthCheck     time.Duration
+	RpcMaxBlockLag     uint64
 }
 
 type Hedera struct {
@@ -65,12 +86,29 @@ type Hedera struct {
 type Operator struct {
 	AccountId  string
 	PrivateKey string
+	Keystore   string
 }
 
 type MirrorNode struct {
 	ClientAddress   string
 	ApiAddress      string
 	PollingInterval time.Duration
+	// The number of entries requested per page of the list queries
+	PageSize int
+	// The maximum number of pages read per poll of the transfer and topic watchers. 0 means no limit
+	MaxPages int
+	// The timeout of a single request in seconds. 0 means no timeout
+	RequestTimeout time.Duration
+	// The number of retries of requests failing with a timeout, a connection error, 429 or 5xx
+	MaxRetries int
+	// The delay before the first retry in seconds, doubled with every following retry
+	RetryDelay time.Duration
+	// The maximum number of requests per second. 0 means no limit
+	RateLimit float64
+	// The number of consecutive failed requests, which open the circuit breaker. 0 disables the circuit breaker
+	CircuitBreakerThreshold int
+	// The number of seconds, for which the circuit breaker rejects requests once open
+	CircuitBreakerCooldown time.Duration
 }
 
 type Monitoring struct {
@@ -78,9 +116,100 @@ type Monitoring struct {
 	DashboardPolling time.Duration
 }
 
+type Queue struct {
+	Persistent        bool
+	VisibilityTimeout time.Duration
+	PollingInterval   time.Duration
+	MaxAttempts       int
+	RetryDelay        time.Duration
+}
+
+type Workers struct {
+	Concurrency int
+	BufferSize  int
+	Topics      map[string]int
+}
+
+type Admin struct {
+	ApiKey string
+}
+
+// Signer configures the remote signing service holding the EVM keys.
+// If Url is empty, the private keys of the EVM clients are used.
+type Signer struct {
+	Url     string
+	Timeout time.Duration
+	Tls     SignerTls
+}
+
+type SignerTls struct {
+	CaFile   string
+	CertFile string
+	KeyFile  string
+}
+
+// BridgeConfig configures the reloading of the bridge configuration at runtime
+type BridgeConfig struct {
+	ReloadInterval time.Duration
+}
+
+// Screening configures the screening of the senders and receivers of transfers against a list file.
+// If ListFile is empty, transfers are not screened
+type Screening struct {
+	ListFile       string
+	ReloadInterval time.Duration
+}
+
+// Reconciliation configures the periodic check, that the locked balance of every native fungible asset
+// matches the total supply of its wrapped assets. If Interval is 0, the check is disabled
+type Reconciliation struct {
+	Interval time.Duration
+	// The allowed difference in percent of the locked balance
+	Tolerance float64
+}
+
+// Keystore configures the passphrase of the encrypted key files
+type Keystore struct {
+	Passphrase     string
+	PassphraseFile string
+}
+
+// GetPassphrase returns the passphrase of the encrypted key files, preferring
+// the VALIDATOR_KEYSTORE_PASSPHRASE environment variable over the passphrase file
+func (k Keystore) GetPassphrase() (string, error) {
+	if k.Passphrase != "" {
+		return k.Passphrase, nil
+	}
+	if k.PassphraseFile == "" {
+		return "", errors.New("keystore passphrase is not configured")
+	}
+
+	passphrase, err := ioutil.ReadFile(k.PassphraseFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(passphrase), "\r\n"), nil
+}
+
 type Recovery struct {
 	StartTimestamp int64
 	StartBlock     int64
+	// Only transfers stuck in INITIAL, which were recorded within MaxAge, are recovered on startup. 0 means no limit
+	MaxAge time.Duration
+	// If set, the transfers stuck in INITIAL are only reported and not re-enqueued
+	DryRun bool
+}
+
+// Backfill configures the periodic audit, which re-scans the recent history of the bridge account and topic for
+// transfers and messages the watchers skipped. If Interval is 0, the audit is disabled
+type Backfill struct {
+	Interval time.Duration
+	// The length of the history re-scanned on every audit
+	Window time.Duration
+	// The most recent history, which is still being processed by the watchers, is skipped
+	Delay time.Duration
+	// If set, the missing transfers and messages are only reported and not enqueued
+	DryRun bool
 }
 
 func New(node parser.Node) Node {
@@ -112,6 +241,24 @@ func New(node parser.Node) Node {
 			Enable:           node.Monitoring.Enable,
 			DashboardPolling: node.Monitoring.DashboardPolling,
 		},
+		Queue:           Queue(node.Queue),
+		Workers:         Workers(node.Workers),
+		Admin:           Admin(node.Admin),
+		ShutdownTimeout: node.ShutdownTimeout,
+		Keystore:        Keystore(node.Keystore),
+		BridgeConfig:    BridgeConfig(node.BridgeConfig),
+		Screening:       Screening(node.Screening),
+		Reconciliation:  Reconciliation(node.Reconciliation),
+		Recovery: Recovery{
+			MaxAge: node.Recovery.MaxAge,
+			DryRun: node.Recovery.DryRun,
+		},
+		Backfill: Backfill(node.Backfill),
+		Signer: Signer{
+			Url:     node.Signer.Url,
+			Timeout: node.Signer.Timeout,
+			Tls:     SignerTls(node.Signer.Tls),
+		},
 	}
 
 	for key, value := range node.Clients.Evm {
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_Keystore_GetPassphrase(t *testing.T) {
+	passphrase, err := Keystore{Passphrase: "from-env", PassphraseFile: "missing"}.GetPassphrase()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "from-env", passphrase)
+}
+
+func Test_Keystore_GetPassphrase_File(t *testing.T) {
+	file, err := ioutil.TempFile("", "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("from-file\n")
+	file.Close()
+
+	passphrase, err := Keystore{PassphraseFile: file.Name()}.GetPassphrase()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "from-file", passphrase)
+}
+
+func Test_Keystore_GetPassphrase_NotConfigured(t *testing.T) {
+	passphrase, err := Keystore{}.GetPassphrase()
+
+	assert.NotNil(t, err)
+	assert.Empty(t, passphrase)
+}
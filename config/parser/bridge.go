@@ -34,13 +34,22 @@ type Network struct {
 }
 
 type Tokens struct {
-	Fungible map[string]Token `yaml:"fungible" json:"fungible,omitempty"`
-	Nft      map[string]Token `yaml:"nft" json:"nft,omitempty"`
+	Fungible map[string]Token        `yaml:"fungible" json:"fungible,omitempty"`
+	Nft      map[string]Token        `yaml:"nft" json:"nft,omitempty"`
+	Erc1155  map[string]Erc1155Token `yaml:"erc1155" json:"erc1155,omitempty"`
 }
 
 type Token struct {
 	Fee           int64             `yaml:"fee" json:"fee,omitempty"`                      // Represent a constant fee for Non-Fungible tokens. Applies only for Hedera Native Tokens
 	FeePercentage int64             `yaml:"fee_percentage" json:"feePercentage,omitempty"` // Represents a constant fee for Fungible Tokens. Applies only for Hedera Native Tokens
 	MinAmount     string            `yaml:"min_amount" json:"minAmount,omitempty"`         // Represents a constant minimum amount for each Native token.
+	MaxAmount     string            `yaml:"max_amount" json:"maxAmount,omitempty"`         // Represents the maximum amount of a single transfer of each Native token, before it is held for review.
+	HourlyLimit   string            `yaml:"hourly_limit" json:"hourlyLimit,omitempty"`     // Represents the maximum amount of each Native token, transferred within the last hour, before transfers are held for review.
+	DailyLimit    string            `yaml:"daily_limit" json:"dailyLimit,omitempty"`       // Represents the maximum amount of each Native token, transferred within the last 24 hours, before transfers are held for review.
 	Networks      map[uint64]string `yaml:"networks" json:"networks,omitempty"`
 }
+
+// Erc1155Token maps the token IDs of an EVM native ERC-1155 contract to the HTS tokens, which wrap them on Hedera
+type Erc1155Token struct {
+	TokenIds map[int64]string `yaml:"token_ids" json:"tokenIds,omitempty"`
+}
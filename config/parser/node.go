@@ -22,12 +22,23 @@ import "time"
 	Structs used to parse the node YAML configuration
 */
 type Node struct {
-	Database   Database   `yaml:"database"`
-	Clients    Clients    `yaml:"clients"`
-	LogLevel   string     `yaml:"log_level"`
-	Port       string     `yaml:"port"`
-	Validator  bool       `yaml:"validator"`
-	Monitoring Monitoring `yaml:"monitoring"`
+	Database        Database       `yaml:"database"`
+	Clients         Clients        `yaml:"clients"`
+	LogLevel        string         `yaml:"log_level"`
+	Port            string         `yaml:"port"`
+	Validator       bool           `yaml:"validator"`
+	Monitoring      Monitoring     `yaml:"monitoring"`
+	Queue           Queue          `yaml:"queue"`
+	Workers         Workers        `yaml:"workers"`
+	Admin           Admin          `yaml:"admin"`
+	Signer          Signer         `yaml:"signer"`
+	Keystore        Keystore       `yaml:"keystore"`
+	BridgeConfig    BridgeConfig   `yaml:"bridge_config"`
+	Screening       Screening      `yaml:"screening"`
+	Reconciliation  Reconciliation `yaml:"reconciliation"`
+	Recovery        Recovery       `yaml:"recovery"`
+	Backfill        Backfill       `yaml:"backfill"`
+	ShutdownTimeout time.Duration  `yaml:"shutdown_timeout"`
 }
 
 type Database struct {
@@ -47,10 +58,17 @@ type Clients struct {
 type Evm struct {
 	BlockConfirmations uint64        `yaml:"block_confirmations"`
 	NodeUrl            string        `yaml:"node_url"`
+	NodeUrls           []string      `yaml:"node_urls"`
 	PrivateKey         string        `yaml:"private_key"`
+	Keystore           string        `yaml:"keystore"`
+	SignerAddress      string        `yaml:"signer_address"`
 	StartBlock         int64         `yaml:"start_block"`
 	PollingInterval    time.Duration `yaml:"polling_interval"`
 	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	RpcQuorum          int           `yaml:"rpc_quorum"`
+	RpcRequestTimeout  time.Duration `yaml:"rpc_request_timeout"`
+	RpcHealthCheck     time.Duration `yaml:"rpc_health_check_interval"`
+	RpcMaxBlockLag     uint64        `yaml:"rpc_max_block_lag"`
 }
 
 type Hedera struct {
@@ -63,15 +81,85 @@ type Hedera struct {
 type Operator struct {
 	AccountId  string `yaml:"account_id"`
 	PrivateKey string `yaml:"private_key"`
+	Keystore   string `yaml:"keystore"`
 }
 
 type MirrorNode struct {
-	ClientAddress   string        `yaml:"client_address"`
-	ApiAddress      string        `yaml:"api_address"`
-	PollingInterval time.Duration `yaml:"polling_interval"`
+	ClientAddress           string        `yaml:"client_address"`
+	ApiAddress              string        `yaml:"api_address"`
+	PollingInterval         time.Duration `yaml:"polling_interval"`
+	PageSize                int           `yaml:"page_size"`
+	MaxPages                int           `yaml:"max_pages"`
+	RequestTimeout          time.Duration `yaml:"request_timeout"`
+	MaxRetries              int           `yaml:"max_retries"`
+	RetryDelay              time.Duration `yaml:"retry_delay"`
+	RateLimit               float64       `yaml:"rate_limit"`
+	CircuitBreakerThreshold int           `yaml:"circuit_breaker_threshold"`
+	CircuitBreakerCooldown  time.Duration `yaml:"circuit_breaker_cooldown"`
 }
 
 type Monitoring struct {
 	Enable           bool          `yaml:"enable"`
 	DashboardPolling time.Duration `yaml:"dashboard_polling"`
 }
+
+type Queue struct {
+	Persistent        bool          `yaml:"persistent"`
+	VisibilityTimeout time.Duration `yaml:"visibility_timeout"`
+	PollingInterval   time.Duration `yaml:"polling_interval"`
+	MaxAttempts       int           `yaml:"max_attempts"`
+	RetryDelay        time.Duration `yaml:"retry_delay"`
+}
+
+type Workers struct {
+	Concurrency int            `yaml:"concurrency"`
+	BufferSize  int            `yaml:"buffer_size"`
+	Topics      map[string]int `yaml:"topics"`
+}
+
+type Admin struct {
+	ApiKey string `yaml:"api_key" env:"VALIDATOR_ADMIN_API_KEY"`
+}
+
+type Signer struct {
+	Url     string        `yaml:"url" env:"VALIDATOR_SIGNER_URL"`
+	Timeout time.Duration `yaml:"timeout"`
+	Tls     SignerTls     `yaml:"tls"`
+}
+
+type SignerTls struct {
+	CaFile   string `yaml:"ca_file"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+type BridgeConfig struct {
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type Screening struct {
+	ListFile       string        `yaml:"list_file"`
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type Reconciliation struct {
+	Interval  time.Duration `yaml:"interval"`
+	Tolerance float64       `yaml:"tolerance"`
+}
+
+type Recovery struct {
+	MaxAge time.Duration `yaml:"max_age"`
+	DryRun bool          `yaml:"dry_run"`
+}
+
+type Backfill struct {
+	Interval time.Duration `yaml:"interval"`
+	Window   time.Duration `yaml:"window"`
+	Delay    time.Duration `yaml:"delay"`
+	DryRun   bool          `yaml:"dry_run"`
+}
+
+type Keystore struct {
+	Passphrase     string `yaml:"-" env:"VALIDATOR_KEYSTORE_PASSPHRASE"`
+	PassphraseFile string `yaml:"passphrase_file"`
+}
@@ -0,0 +1,474 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"sort"
+	"strings"
+)
+
+const hederaNetworkName = "Hedera"
+
+// Validate cross-checks the parsed node and bridge configuration and returns all problems found.
+// A configuration, for which no problems are returned, can be safely loaded with New and NewBridge
+func Validate(parsed parser.Config) []error {
+	v := &validation{}
+	v.node(parsed.Node)
+	v.bridge(parsed.Bridge, parsed.Node)
+	return v.problems
+}
+
+type validation struct {
+	problems []error
+}
+
+func (v *validation) add(format string, args ...interface{}) {
+	v.problems = append(v.problems, errors.New(fmt.Sprintf(format, args...)))
+}
+
+func (v *validation) node(node parser.Node) {
+	v.hederaClient(node.Clients.Hedera, node.Keystore)
+
+	if node.Clients.MirrorNode.ApiAddress == "" {
+		v.add("node.clients.mirror_node.api_address is not configured")
+	}
+	if pageSize := node.Clients.MirrorNode.PageSize; pageSize < 0 || pageSize > 100 {
+		v.add("node.clients.mirror_node.page_size [%d] must be between 0 and 100", pageSize)
+	}
+	if node.Clients.MirrorNode.MaxPages < 0 {
+		v.add("node.clients.mirror_node.max_pages [%d] cannot be negative", node.Clients.MirrorNode.MaxPages)
+	}
+	v.mirrorNodeResilience(node.Clients.MirrorNode)
+
+	for _, chainId := range sortedChainIds(node.Clients.Evm) {
+		v.evmClient(chainId, node.Clients.Evm[chainId], node.Signer, node.Keystore)
+	}
+
+	if node.Reconciliation.Tolerance < 0 {
+		v.add("node.reconciliation.tolerance [%v] cannot be negative", node.Reconciliation.Tolerance)
+	}
+	if node.Recovery.MaxAge < 0 {
+		v.add("node.recovery.max_age [%v] cannot be negative", node.Recovery.MaxAge)
+	}
+	if node.Backfill.Delay < 0 {
+		v.add("node.backfill.delay [%v] cannot be negative", node.Backfill.Delay)
+	}
+	if node.Backfill.Interval > 0 && node.Backfill.Window < node.Backfill.Interval {
+		v.add("node.backfill.window [%v] cannot be shorter than node.backfill.interval [%v]", node.Backfill.Window, node.Backfill.Interval)
+	}
+}
+
+func (v *validation) hederaClient(c parser.Hedera, keystore parser.Keystore) {
+	switch c.Network {
+	case "mainnet", "testnet", "previewnet":
+	default:
+		v.add("node.clients.hedera.network [%s] is not one of mainnet, testnet or previewnet", c.Network)
+	}
+
+	if _, err := hedera.AccountIDFromString(c.Operator.AccountId); err != nil {
+		v.add("node.clients.hedera.operator.account_id [%s] is not a valid account ID", c.Operator.AccountId)
+	}
+
+	switch {
+	case c.Operator.Keystore != "":
+		v.passphrase("node.clients.hedera.operator.keystore", keystore)
+	case c.Operator.PrivateKey == "":
+		v.add("node.clients.hedera.operator has neither private_key nor keystore configured")
+	default:
+		if _, err := hedera.PrivateKeyFromString(c.Operator.PrivateKey); err != nil {
+			v.add("node.clients.hedera.operator.private_key is not a valid private key")
+		}
+	}
+}
+
+func (v *validation) mirrorNodeResilience(c parser.MirrorNode) {
+	if c.RequestTimeout < 0 {
+		v.add("node.clients.mirror_node.request_timeout [%d] cannot be negative", c.RequestTimeout)
+	}
+	if c.MaxRetries < 0 {
+		v.add("node.clients.mirror_node.max_retries [%d] cannot be negative", c.MaxRetries)
+	}
+	if c.RetryDelay < 0 {
+		v.add("node.clients.mirror_node.retry_delay [%d] cannot be negative", c.RetryDelay)
+	}
+	if c.RateLimit < 0 {
+		v.add("node.clients.mirror_node.rate_limit [%g] cannot be negative", c.RateLimit)
+	}
+	if c.CircuitBreakerThreshold < 0 {
+		v.add("node.clients.mirror_node.circuit_breaker_threshold [%d] cannot be negative", c.CircuitBreakerThreshold)
+	}
+	if c.CircuitBreakerThreshold > 0 && c.CircuitBreakerCooldown <= 0 {
+		v.add("node.clients.mirror_node.circuit_breaker_cooldown [%d] must be positive, if the circuit breaker is enabled", c.CircuitBreakerCooldown)
+	}
+}
+
+func (v *validation) evmClient(chainId uint64, c parser.Evm, signer parser.Signer, keystore parser.Keystore) {
+	endpoints := len(c.NodeUrls)
+	if c.NodeUrl != "" {
+		endpoints++
+	}
+	if endpoints == 0 {
+		v.add("node.clients.evm.%d has neither node_url nor node_urls configured", chainId)
+	} else if c.RpcQuorum < 0 || c.RpcQuorum > endpoints {
+		v.add("node.clients.evm.%d.rpc_quorum [%d] is not between 1 and the number of endpoints [%d]", chainId, c.RpcQuorum, endpoints)
+	}
+	if c.BlockConfirmations < 1 {
+		v.add("node.clients.evm.%d.block_confirmations must be positive", chainId)
+	}
+
+	switch {
+	case signer.Url != "":
+		if c.SignerAddress != "" && !common.IsHexAddress(c.SignerAddress) {
+			v.add("node.clients.evm.%d.signer_address [%s] is not a valid address", chainId, c.SignerAddress)
+		}
+	case c.Keystore != "":
+		v.passphrase(fmt.Sprintf("node.clients.evm.%d.keystore", chainId), keystore)
+	case c.PrivateKey == "":
+		v.add("node.clients.evm.%d has neither private_key, keystore nor a remote signer configured", chainId)
+	default:
+		if _, err := crypto.HexToECDSA(c.PrivateKey); err != nil {
+			v.add("node.clients.evm.%d.private_key is not a valid private key", chainId)
+		}
+	}
+}
+
+func (v *validation) passphrase(key string, keystore parser.Keystore) {
+	if _, err := Keystore(keystore).GetPassphrase(); err != nil {
+		v.add("%s is configured, but the passphrase cannot be read: %s", key, err)
+	}
+}
+
+func (v *validation) bridge(bridge parser.Bridge, node parser.Node) {
+	if _, err := hedera.TopicIDFromString(bridge.TopicId); err != nil {
+		v.add("bridge.topic_id [%s] is not a valid topic ID", bridge.TopicId)
+	}
+
+	hederaNetwork, ok := bridge.Networks[constants.HederaNetworkId]
+	if !ok || hederaNetwork == nil {
+		v.add("bridge.networks.%d (Hedera) is not configured", constants.HederaNetworkId)
+	} else {
+		v.hederaNetwork(hederaNetwork)
+	}
+
+	for _, chainId := range sortedChainIds(bridge.Networks) {
+		network := bridge.Networks[chainId]
+		if network == nil || chainId == constants.HederaNetworkId {
+			continue
+		}
+		if network.Name == hederaNetworkName {
+			v.add("bridge.networks.%d is named [%s], which is reserved for network %d", chainId, hederaNetworkName, constants.HederaNetworkId)
+		}
+		v.evmNetwork(chainId, network, node.Clients.Evm)
+	}
+
+	for _, chainId := range sortedChainIds(node.Clients.Evm) {
+		if network, ok := bridge.Networks[chainId]; !ok || network == nil || chainId == constants.HederaNetworkId {
+			v.add("node.clients.evm.%d has no corresponding EVM network in bridge.networks", chainId)
+		}
+	}
+
+	v.bridgeTokens(bridge.Networks)
+}
+
+// ValidateReload validates a bridge configuration, which is to replace the active one at runtime.
+// Only the tokens of the networks, i.e. the asset mappings, fees and min amounts, can change without a restart
+func ValidateReload(active, updated parser.Bridge) []error {
+	v := &validation{}
+	if updated.TopicId != active.TopicId {
+		v.add("bridge.topic_id cannot change without a restart")
+	}
+
+	for _, chainId := range sortedChainIds(active.Networks) {
+		if _, ok := updated.Networks[chainId]; !ok {
+			v.add("bridge.networks.%d cannot be removed without a restart", chainId)
+		}
+	}
+	for _, chainId := range sortedChainIds(updated.Networks) {
+		network := updated.Networks[chainId]
+		activeNetwork, ok := active.Networks[chainId]
+		if !ok || activeNetwork == nil {
+			v.add("bridge.networks.%d cannot be added without a restart", chainId)
+			continue
+		}
+		if network == nil {
+			v.add("bridge.networks.%d is empty", chainId)
+			continue
+		}
+		if network.Name != activeNetwork.Name ||
+			network.BridgeAccount != activeNetwork.BridgeAccount ||
+			network.PayerAccount != activeNetwork.PayerAccount ||
+			network.RouterContractAddress != activeNetwork.RouterContractAddress ||
+			strings.Join(network.Members, ",") != strings.Join(activeNetwork.Members, ",") {
+			v.add("bridge.networks.%d can change only its tokens without a restart", chainId)
+		}
+	}
+
+	if len(v.problems) == 0 {
+		v.bridgeTokens(updated.Networks)
+	}
+	return v.problems
+}
+
+func (v *validation) bridgeTokens(networks map[uint64]*parser.Network) {
+	for _, chainId := range sortedChainIds(networks) {
+		network := networks[chainId]
+		if network == nil {
+			continue
+		}
+		v.tokens(chainId, network.Tokens, networks)
+	}
+
+	v.uniqueWrappedAssets(networks)
+}
+
+func (v *validation) hederaNetwork(network *parser.Network) {
+	if network.Name != hederaNetworkName {
+		v.add("bridge.networks.%d.name [%s] must be [%s]", constants.HederaNetworkId, network.Name, hederaNetworkName)
+	}
+	if _, err := hedera.AccountIDFromString(network.BridgeAccount); err != nil {
+		v.add("bridge.networks.%d.bridge_account [%s] is not a valid account ID", constants.HederaNetworkId, network.BridgeAccount)
+	}
+	if _, err := hedera.AccountIDFromString(network.PayerAccount); err != nil {
+		v.add("bridge.networks.%d.payer_account [%s] is not a valid account ID", constants.HederaNetworkId, network.PayerAccount)
+	}
+	if len(network.Members) == 0 {
+		v.add("bridge.networks.%d.members is empty", constants.HederaNetworkId)
+	}
+	for _, member := range network.Members {
+		if _, err := hedera.AccountIDFromString(member); err != nil {
+			v.add("bridge.networks.%d.members contains [%s], which is not a valid account ID", constants.HederaNetworkId, member)
+		}
+	}
+}
+
+func (v *validation) evmNetwork(chainId uint64, network *parser.Network, clients map[uint64]parser.Evm) {
+	if !common.IsHexAddress(network.RouterContractAddress) {
+		v.add("bridge.networks.%d.router_contract_address [%s] is not a valid address", chainId, network.RouterContractAddress)
+	}
+	if _, ok := clients[chainId]; !ok {
+		v.add("bridge.networks.%d has no corresponding client in node.clients.evm", chainId)
+	}
+}
+
+func (v *validation) tokens(chainId uint64, tokens parser.Tokens, networks map[uint64]*parser.Network) {
+	for _, asset := range sortedAssets(tokens.Fungible) {
+		token := tokens.Fungible[asset]
+		key := fmt.Sprintf("bridge.networks.%d.tokens.fungible.%s", chainId, asset)
+
+		v.asset(key, chainId, asset)
+		if _, err := parseAmount(token.MinAmount); err != nil {
+			v.add("%s.min_amount [%s] is not a valid amount", key, token.MinAmount)
+		}
+		v.limit(key+".max_amount", token.MaxAmount)
+		v.limit(key+".hourly_limit", token.HourlyLimit)
+		v.limit(key+".daily_limit", token.DailyLimit)
+		if chainId == constants.HederaNetworkId && (token.FeePercentage < constants.FeeMinPercentage || token.FeePercentage > constants.FeeMaxPercentage) {
+			v.add("%s.fee_percentage [%d] is not between %d and %d", key, token.FeePercentage, constants.FeeMinPercentage, constants.FeeMaxPercentage)
+		}
+		v.wrappedAssets(key, chainId, token.Networks, networks)
+	}
+
+	for _, asset := range sortedAssets(tokens.Nft) {
+		token := tokens.Nft[asset]
+		key := fmt.Sprintf("bridge.networks.%d.tokens.nft.%s", chainId, asset)
+
+		v.asset(key, chainId, asset)
+		if chainId == constants.HederaNetworkId && token.Fee <= 0 {
+			v.add("%s.fee [%d] must be positive", key, token.Fee)
+		}
+		if chainId != constants.HederaNetworkId {
+			for _, wrappedChainId := range sortedChainIds(token.Networks) {
+				if wrappedChainId != constants.HederaNetworkId {
+					v.add("%s.networks.%d is not supported, EVM native NFTs can be wrapped only on Hedera", key, wrappedChainId)
+				}
+			}
+		}
+		v.wrappedAssets(key, chainId, token.Networks, networks)
+	}
+
+	for _, asset := range sortedAssets(tokens.Erc1155) {
+		key := fmt.Sprintf("bridge.networks.%d.tokens.erc1155.%s", chainId, asset)
+		if chainId == constants.HederaNetworkId {
+			v.add("%s is not supported, ERC-1155 tokens can be native only on EVM networks", key)
+			continue
+		}
+
+		v.asset(key, chainId, asset)
+		tokenIds := tokens.Erc1155[asset].TokenIds
+		if len(tokenIds) == 0 {
+			v.add("%s.token_ids is empty", key)
+		}
+		for _, tokenId := range sortedTokenIds(tokenIds) {
+			tokenKey := fmt.Sprintf("%s.token_ids.%d", key, tokenId)
+			if tokenId < 0 {
+				v.add("%s is not a valid token ID", tokenKey)
+			}
+			if tokenIds[tokenId] == "" {
+				v.add("%s has no wrapped asset configured", tokenKey)
+				continue
+			}
+			v.asset(tokenKey, constants.HederaNetworkId, tokenIds[tokenId])
+		}
+	}
+}
+
+func (v *validation) wrappedAssets(key string, nativeChainId uint64, wrapped map[uint64]string, networks map[uint64]*parser.Network) {
+	for _, chainId := range sortedChainIds(wrapped) {
+		asset := wrapped[chainId]
+		if chainId == nativeChainId {
+			v.add("%s.networks.%d references the native network of the asset", key, chainId)
+			continue
+		}
+		if network, ok := networks[chainId]; !ok || network == nil {
+			v.add("%s.networks.%d references a network, which is not configured in bridge.networks", key, chainId)
+			continue
+		}
+		if asset == "" {
+			v.add("%s.networks.%d has no wrapped asset configured", key, chainId)
+			continue
+		}
+		v.asset(fmt.Sprintf("%s.networks.%d", key, chainId), chainId, asset)
+	}
+}
+
+// limit checks that the amount limit is either not set or a positive amount
+func (v *validation) limit(key, limit string) {
+	if limit == "" {
+		return
+	}
+	amount, err := big_numbers.ToBigInt(limit)
+	if err != nil || amount.Sign() <= 0 {
+		v.add("%s [%s] is not a positive amount", key, limit)
+	}
+}
+
+// asset checks that the asset identifier is valid for the given network
+func (v *validation) asset(key string, chainId uint64, asset string) {
+	if chainId != constants.HederaNetworkId {
+		if !common.IsHexAddress(asset) {
+			v.add("%s: [%s] is not a valid address", key, asset)
+		}
+		return
+	}
+
+	if asset == constants.Hbar {
+		return
+	}
+	if _, err := hedera.TokenIDFromString(asset); err != nil {
+		v.add("%s: [%s] is not a valid token ID", key, asset)
+	}
+}
+
+// uniqueWrappedAssets checks that every wrapped asset represents a single native asset
+func (v *validation) uniqueWrappedAssets(networks map[uint64]*parser.Network) {
+	natives := make(map[uint64]map[string]string)
+	check := func(nativeChainId uint64, nativeAsset string, wrapped map[uint64]string) {
+		for _, chainId := range sortedChainIds(wrapped) {
+			asset := wrapped[chainId]
+			if asset == "" {
+				continue
+			}
+			if chainId != constants.HederaNetworkId {
+				asset = strings.ToLower(asset)
+			}
+			if natives[chainId] == nil {
+				natives[chainId] = make(map[string]string)
+			}
+
+			native := fmt.Sprintf("%s on network %d", nativeAsset, nativeChainId)
+			if existing, ok := natives[chainId][asset]; ok {
+				v.add("wrapped asset [%s] on network %d is configured for both [%s] and [%s]", wrapped[chainId], chainId, existing, native)
+				continue
+			}
+			natives[chainId][asset] = native
+		}
+	}
+
+	for _, chainId := range sortedChainIds(networks) {
+		network := networks[chainId]
+		if network == nil {
+			continue
+		}
+		for _, asset := range sortedAssets(network.Tokens.Fungible) {
+			check(chainId, asset, network.Tokens.Fungible[asset].Networks)
+		}
+		for _, asset := range sortedAssets(network.Tokens.Nft) {
+			check(chainId, asset, network.Tokens.Nft[asset].Networks)
+		}
+		for _, asset := range sortedAssets(network.Tokens.Erc1155) {
+			tokenIds := network.Tokens.Erc1155[asset].TokenIds
+			for _, tokenId := range sortedTokenIds(tokenIds) {
+				check(chainId, fmt.Sprintf("%s token ID %d", asset, tokenId), map[uint64]string{constants.HederaNetworkId: tokenIds[tokenId]})
+			}
+		}
+	}
+}
+
+// sortedChainIds returns the keys of a chain ID map in ascending order, so that problems are reported deterministically
+func sortedChainIds(m interface{}) []uint64 {
+	var ids []uint64
+	switch typed := m.(type) {
+	case map[uint64]parser.Evm:
+		for id := range typed {
+			ids = append(ids, id)
+		}
+	case map[uint64]*parser.Network:
+		for id := range typed {
+			ids = append(ids, id)
+		}
+	case map[uint64]string:
+		for id := range typed {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortedAssets(m interface{}) []string {
+	var assets []string
+	switch typed := m.(type) {
+	case map[string]parser.Token:
+		for asset := range typed {
+			assets = append(assets, asset)
+		}
+	case map[string]parser.Erc1155Token:
+		for asset := range typed {
+			assets = append(assets, asset)
+		}
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+// sortedTokenIds returns the ERC-1155 token IDs in ascending order
+func sortedTokenIds(tokenIds map[int64]string) []int64 {
+	ids := make([]int64, 0, len(tokenIds))
+	for id := range tokenIds {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
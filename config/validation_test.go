@@ -0,0 +1,346 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	validationEvmPrivateKey = "bb9282e2ba6cb4b8e8b5a7ae8e5c1c4b1d6bbe2b1dc8ad05db6e1a2db09b1b51"
+	validationRouter        = "0x0000000000000000000000000000000000000001"
+	validationEvmToken      = "0x0000000000000000000000000000000000000002"
+	validationWrappedToken  = "0x0000000000000000000000000000000000000003"
+)
+
+func validParsedConfig(t *testing.T) parser.Config {
+	operatorKey, err := hedera.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return parser.Config{
+		Node: parser.Node{
+			Clients: parser.Clients{
+				Evm: map[uint64]parser.Evm{
+					80001: {
+						BlockConfirmations: 5,
+						NodeUrl:            "http://localhost:8545",
+						PrivateKey:         validationEvmPrivateKey,
+					},
+				},
+				Hedera: parser.Hedera{
+					Operator: parser.Operator{
+						AccountId:  "0.0.1001",
+						PrivateKey: operatorKey.String(),
+					},
+					Network: "testnet",
+				},
+				MirrorNode: parser.MirrorNode{ApiAddress: "http://localhost:5551/api/v1/"},
+			},
+		},
+		Bridge: parser.Bridge{
+			TopicId: "0.0.2001",
+			Networks: map[uint64]*parser.Network{
+				0: {
+					Name:          "Hedera",
+					BridgeAccount: "0.0.3001",
+					PayerAccount:  "0.0.3002",
+					Members:       []string{"0.0.3003"},
+					Tokens: parser.Tokens{
+						Fungible: map[string]parser.Token{
+							"HBAR": {FeePercentage: 10000, MinAmount: "100", Networks: map[uint64]string{80001: validationWrappedToken}},
+						},
+						Nft: map[string]parser.Token{
+							"0.0.4001": {Fee: 1000, Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000004"}},
+						},
+					},
+				},
+				80001: {
+					Name:                  "Mumbai",
+					RouterContractAddress: validationRouter,
+					Tokens: parser.Tokens{
+						Fungible: map[string]parser.Token{
+							validationEvmToken: {Networks: map[uint64]string{0: "0.0.4002"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	assert.Empty(t, Validate(validParsedConfig(t)))
+}
+
+func Test_Validate_ReportsAllProblems(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.TopicId = "topic"
+	parsed.Bridge.Networks[0].Members = nil
+	parsed.Bridge.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{FeePercentage: 100001, Networks: map[uint64]string{80001: validationWrappedToken}}
+	parsed.Node.Clients.Hedera.Operator.AccountId = ""
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 4)
+}
+
+func Test_Validate_UnknownChainInTokenNetworks(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{Networks: map[uint64]string{5: validationWrappedToken}}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "bridge.networks.0.tokens.fungible.HBAR.networks.5")
+}
+
+func Test_Validate_MissingWrappedAsset(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{Networks: map[uint64]string{80001: ""}}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "has no wrapped asset configured")
+}
+
+func Test_Validate_DuplicateWrappedAsset(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Fungible["0.0.4003"] = parser.Token{Networks: map[uint64]string{80001: validationWrappedToken}}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "is configured for both")
+}
+
+func Test_Validate_NetworkWithoutClient(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Clients.Evm = nil
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "bridge.networks.80001 has no corresponding client")
+}
+
+func Test_Validate_ClientWithoutNetwork(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Clients.Evm[5] = parsed.Node.Clients.Evm[80001]
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "node.clients.evm.5 has no corresponding EVM network")
+}
+
+func Test_Validate_NftWithoutFee(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Nft["0.0.4001"] = parser.Token{Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000004"}}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "fee [0] must be positive")
+}
+
+func Test_Validate_EvmNativeNft(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[80001].Tokens.Nft = map[string]parser.Token{
+		"0x0000000000000000000000000000000000000005": {Networks: map[uint64]string{0: "0.0.4005"}},
+	}
+
+	assert.Empty(t, Validate(parsed))
+}
+
+func Test_Validate_EvmNativeNftWrappedOnEvm(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Clients.Evm[5] = parsed.Node.Clients.Evm[80001]
+	parsed.Bridge.Networks[5] = &parser.Network{
+		Name:                  "Goerli",
+		RouterContractAddress: "0x0000000000000000000000000000000000000006",
+	}
+	parsed.Bridge.Networks[80001].Tokens.Nft = map[string]parser.Token{
+		"0x0000000000000000000000000000000000000005": {Networks: map[uint64]string{5: "0x0000000000000000000000000000000000000007"}},
+	}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "EVM native NFTs can be wrapped only on Hedera")
+}
+
+func Test_Validate_Erc1155(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[80001].Tokens.Erc1155 = map[string]parser.Erc1155Token{
+		"0x0000000000000000000000000000000000000005": {TokenIds: map[int64]string{1: "0.0.5001", 2: "0.0.5002"}},
+	}
+
+	assert.Empty(t, Validate(parsed))
+}
+
+func Test_Validate_Erc1155Problems(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Erc1155 = map[string]parser.Erc1155Token{
+		"0.0.4010": {TokenIds: map[int64]string{1: "0.0.5001"}},
+	}
+	parsed.Bridge.Networks[80001].Tokens.Erc1155 = map[string]parser.Erc1155Token{
+		"0x0000000000000000000000000000000000000005": {TokenIds: map[int64]string{1: "0.0.4002", 2: "", 3: "0xinvalid"}},
+	}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 4)
+	assert.Contains(t, problems[0].Error(), "ERC-1155 tokens can be native only on EVM networks")
+	assert.Contains(t, problems[1].Error(), "token_ids.2 has no wrapped asset configured")
+	assert.Contains(t, problems[2].Error(), "token_ids.3: [0xinvalid] is not a valid token ID")
+	assert.Contains(t, problems[3].Error(), "wrapped asset [0.0.4002] on network 0 is configured for both")
+}
+
+func Test_Validate_Limits(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Bridge.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{
+		FeePercentage: 10000,
+		MaxAmount:     "1000",
+		HourlyLimit:   "0",
+		DailyLimit:    "limit",
+		Networks:      map[uint64]string{80001: validationWrappedToken},
+	}
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 2)
+	assert.Contains(t, problems[0].Error(), "bridge.networks.0.tokens.fungible.HBAR.hourly_limit [0] is not a positive amount")
+	assert.Contains(t, problems[1].Error(), "bridge.networks.0.tokens.fungible.HBAR.daily_limit [limit] is not a positive amount")
+}
+
+func Test_Validate_KeystoreWithoutPassphrase(t *testing.T) {
+	parsed := validParsedConfig(t)
+	evm := parsed.Node.Clients.Evm[80001]
+	evm.PrivateKey = ""
+	evm.Keystore = "evm.json"
+	parsed.Node.Clients.Evm[80001] = evm
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "passphrase cannot be read")
+}
+
+func Test_Validate_NegativeTolerance(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Reconciliation.Tolerance = -1
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "node.reconciliation.tolerance [-1] cannot be negative")
+}
+
+func Test_Validate_NegativeRecoveryMaxAge(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Recovery.MaxAge = -1
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "node.recovery.max_age [-1ns] cannot be negative")
+}
+
+func Test_Validate_BackfillWindowShorterThanInterval(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Backfill.Interval = 600
+	parsed.Node.Backfill.Window = 300
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "node.backfill.window [300ns] cannot be shorter than node.backfill.interval [600ns]")
+}
+
+func Test_Validate_MirrorNodePageSize(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Clients.MirrorNode.PageSize = 101
+	parsed.Node.Clients.MirrorNode.MaxPages = -1
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 2)
+	assert.Contains(t, problems[0].Error(), "node.clients.mirror_node.page_size [101] must be between 0 and 100")
+	assert.Contains(t, problems[1].Error(), "node.clients.mirror_node.max_pages [-1] cannot be negative")
+}
+
+func Test_Validate_MirrorNodeResilience(t *testing.T) {
+	parsed := validParsedConfig(t)
+	parsed.Node.Clients.MirrorNode.MaxRetries = -1
+	parsed.Node.Clients.MirrorNode.RateLimit = -0.5
+	parsed.Node.Clients.MirrorNode.CircuitBreakerThreshold = 5
+	parsed.Node.Clients.MirrorNode.CircuitBreakerCooldown = 0
+
+	problems := Validate(parsed)
+
+	assert.Len(t, problems, 3)
+	assert.Contains(t, problems[0].Error(), "node.clients.mirror_node.max_retries [-1] cannot be negative")
+	assert.Contains(t, problems[1].Error(), "node.clients.mirror_node.rate_limit [-0.5] cannot be negative")
+	assert.Contains(t, problems[2].Error(), "node.clients.mirror_node.circuit_breaker_cooldown [0] must be positive, if the circuit breaker is enabled")
+}
+
+func Test_ReadConfig_MissingFile(t *testing.T) {
+	_, err := ReadConfig("non-existing-path/bridge.yml", "node.yml")
+
+	assert.NotNil(t, err)
+}
+
+func Test_ValidateReload(t *testing.T) {
+	active := validParsedConfig(t).Bridge
+	updated := validParsedConfig(t).Bridge
+	updated.Networks[0].Tokens.Fungible["0.0.4003"] = parser.Token{FeePercentage: 5000, Networks: map[uint64]string{80001: "0x0000000000000000000000000000000000000005"}}
+	updated.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{FeePercentage: 20000, Networks: map[uint64]string{80001: validationWrappedToken}}
+
+	assert.Empty(t, ValidateReload(active, updated))
+}
+
+func Test_ValidateReload_RequiresRestart(t *testing.T) {
+	active := validParsedConfig(t).Bridge
+	updated := validParsedConfig(t).Bridge
+	updated.TopicId = "0.0.2002"
+	updated.Networks[80001].RouterContractAddress = "0x0000000000000000000000000000000000000009"
+	updated.Networks[5] = &parser.Network{Name: "Goerli"}
+
+	problems := ValidateReload(active, updated)
+
+	assert.Len(t, problems, 3)
+	assert.Equal(t, "bridge.topic_id cannot change without a restart", problems[0].Error())
+	assert.Equal(t, "bridge.networks.5 cannot be added without a restart", problems[1].Error())
+	assert.Equal(t, "bridge.networks.80001 can change only its tokens without a restart", problems[2].Error())
+}
+
+func Test_ValidateReload_InvalidTokens(t *testing.T) {
+	active := validParsedConfig(t).Bridge
+	updated := validParsedConfig(t).Bridge
+	updated.Networks[0].Tokens.Fungible["HBAR"] = parser.Token{FeePercentage: -1, Networks: map[uint64]string{80001: validationWrappedToken}}
+
+	problems := ValidateReload(active, updated)
+
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Error(), "fee_percentage [-1]")
+}
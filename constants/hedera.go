@@ -21,25 +21,40 @@ const (
 	HederaNetworkId = uint64(0)
 )
 
+// Fee percentages are expressed in thousandths of a percent, e.g. 10000 stands for 10%
+const (
+	FeeMinPercentage = 0
+	FeeMaxPercentage = 100000
+)
+
 // Handler topics
 const (
-	HederaFeeTransfer               = "HEDERA_FEE_TRANSFER"            // WEVM -> NH
-	HederaTransferMessageSubmission = "HEDERA_TRANSFER_MSG_SUBMISSION" // NH -> WEVM
-	HederaBurnMessageSubmission     = "BURN_TOPIC_MSG_SUBMISSION"      // WH -> NEVM
-	HederaMintHtsTransfer           = "HEDERA_MINT_HTS_TRANSFER"       // NEVM -> WH
-	HederaNativeNftTransfer         = "HEDERA_NATIVE_NFT_TRANSFER"     // NH NFT -> WEVM
-	HederaNftTransfer               = "HEDERA_NFT_TRANSFER"            // WEVM NFT -> NH
-	TopicMessageSubmission          = "TOPIC_MSG_SUBMISSION"           // WEVM -> WEVM
-	TopicMessageValidation          = "TOPIC_MSG_VALIDATION"           // Messages coming from HCS Topic submission
+	HederaFeeTransfer                  = "HEDERA_FEE_TRANSFER"               // WEVM -> NH
+	HederaTransferMessageSubmission    = "HEDERA_TRANSFER_MSG_SUBMISSION"    // NH -> WEVM
+	HederaBurnMessageSubmission        = "BURN_TOPIC_MSG_SUBMISSION"         // WH -> NEVM
+	HederaMintHtsTransfer              = "HEDERA_MINT_HTS_TRANSFER"          // NEVM -> WH
+	HederaNativeNftTransfer            = "HEDERA_NATIVE_NFT_TRANSFER"        // NH NFT -> WEVM
+	HederaNftTransfer                  = "HEDERA_NFT_TRANSFER"               // WEVM NFT -> NH
+	HederaMintNftTransfer              = "HEDERA_MINT_NFT_TRANSFER"          // NEVM NFT -> WH
+	HederaBurnNftMessageSubmission     = "BURN_NFT_TOPIC_MSG_SUBMISSION"     // WH NFT -> NEVM
+	HederaMintErc1155Transfer          = "HEDERA_MINT_ERC1155_TRANSFER"      // NEVM ERC-1155 -> WH
+	HederaBurnErc1155MessageSubmission = "BURN_ERC1155_TOPIC_MSG_SUBMISSION" // WH ERC-1155 -> NEVM
+	TopicMessageSubmission             = "TOPIC_MSG_SUBMISSION"              // WEVM -> WEVM
+	WrappedFeeMessageSubmission        = "WRAPPED_FEE_MSG_SUBMISSION"        // WEVM -> WEVM, native on Hedera
+	TopicMessageValidation             = "TOPIC_MSG_VALIDATION"              // Messages coming from HCS Topic submission
 )
 
 // Read-only handler topics
 const (
 	ReadOnlyHederaFeeTransfer       = "READ_ONLY_HEDERA_FEE_TRANSFER"        // NH -> WEVM
 	ReadOnlyHederaTransfer          = "READ_ONLY_HEDERA_NATIVE_TRANSFER"     // WEVM -> NH
-	ReadOnlyHederaBurn              = "READ_ONLY_HEDERA_BURN"                // WH -> NEVM
+	ReadOnlyHederaBurn              = "READ_ONLY_HEDERA_BURN"                // WH -> NEVM, WH NFT -> NEVM
 	ReadOnlyHederaMintHtsTransfer   = "READ_ONLY_HEDERA_MINT_HTS_TRANSFER"   // NEVM -> WH
 	ReadOnlyTransferSave            = "READ_ONLY_SAVE_TRANSFER"              // WEVM -> WEVM
+	ReadOnlyWrappedFeeTransfer      = "READ_ONLY_WRAPPED_FEE_TRANSFER"       // WEVM -> WEVM, native on Hedera
 	ReadOnlyHederaNativeNftTransfer = "READ_ONLY_HEDERA_NFT_TRANSFER"        // NH NFT -> WEVM
 	ReadOnlyHederaUnlockNftTransfer = "READ_ONLY_HEDERA_UNLOCK_NFT_TRANSFER" // WEVM NFT -> NH
+	ReadOnlyHederaMintNftTransfer   = "READ_ONLY_HEDERA_MINT_NFT_TRANSFER"   // NEVM NFT -> WH
+	ReadOnlyHederaMintErc1155       = "READ_ONLY_HEDERA_MINT_ERC1155"        // NEVM ERC-1155 -> WH
+	ReadOnlyHederaBurnErc1155       = "READ_ONLY_HEDERA_BURN_ERC1155"        // WH ERC-1155 -> NEVM
 )
@@ -55,6 +55,53 @@ const (
 	FeeTransferredHelp         = "Fee transferred to the bridge account."
 	UserGetHisTokensNameSuffix = "user_get_his_tokens"
 	UserGetHisTokensHelp       = "The user get his tokens after bridging."
+
+	// Handler Metrics //
+
+	HandlerMetricsNamePrefix    = "handler_"
+	HandlerQueueDepthNameSuffix = "_queue_depth"
+	HandlerQueueDepthHelp       = "Messages waiting for a free worker of the handler."
+	HandlerInFlightNameSuffix   = "_in_flight"
+	HandlerInFlightHelp         = "Messages currently being processed by the handler."
+	HandlerDurationNameSuffix   = "_duration_seconds"
+	HandlerDurationHelp         = "Time taken by the handler to process a message."
+
+	// EVM RPC Metrics //
+
+	EvmRpcMetricsNamePrefix     = "evm_rpc_"
+	EvmRpcMetricLabelKey        = "endpoint"
+	EvmRpcHealthyNameSuffix     = "_healthy"
+	EvmRpcHealthyHelp           = "Whether the EVM JSON RPC endpoint is reachable and in sync."
+	EvmRpcLatencyNameSuffix     = "_latency_seconds"
+	EvmRpcLatencyHelp           = "Moving average of the response time of the EVM JSON RPC endpoint."
+	EvmRpcBlockNumberNameSuffix = "_block_number"
+	EvmRpcBlockNumberHelp       = "Latest block number reported by the EVM JSON RPC endpoint."
+	EvmRpcErrorsNameSuffix      = "_errors_total"
+	EvmRpcErrorsHelp            = "Failed calls to the EVM JSON RPC endpoint."
+
+	// Screening Metrics //
+
+	ScreeningHitsName = "screening_hits_total"
+	ScreeningHitsHelp = "Senders and receivers of transfers found on the screening list."
+
+	// Supply Reconciliation Metrics //
+
+	SupplyDivergedNamePrefix = "supply_diverged_"
+	SupplyDivergedHelp       = "Whether the locked balance of the native asset diverges from the supply of its wrapped assets beyond the tolerance."
+
+	// Mirror Node Metrics //
+
+	MirrorNodeCircuitOpenName = "mirror_node_circuit_open"
+	MirrorNodeCircuitOpenHelp = "Whether the circuit breaker of the mirror node client is open and requests are rejected."
+	MirrorNodeRetriesName     = "mirror_node_retries_total"
+	MirrorNodeRetriesHelp     = "Requests to the mirror node retried after a transient error."
+	MirrorNodeErrorsName      = "mirror_node_errors_total"
+	MirrorNodeErrorsHelp      = "Requests to the mirror node failed with a timeout, a connection error, rate limiting or a server error."
+
+	// Backfill Metrics //
+
+	BackfillMissingName = "backfill_missing_total"
+	BackfillMissingHelp = "Transfers and topic messages found by the backfill, which the watchers did not process."
 )
 
 var (
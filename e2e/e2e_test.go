@@ -17,6 +17,7 @@
 package e2e
 
 import (
+	"context"
 	"database/sql"
 	"encoding/base64"
 	"encoding/hex"
@@ -747,7 +748,7 @@ func Test_Hedera_Native_EVM_NFT_Transfer(t *testing.T) {
 	validatorsFee := setupEnv.Clients.Distributor.ValidAmount(transferFee)
 
 	// Step 1 - Get Token Metadata
-	nftData, err := setupEnv.Clients.MirrorNode.GetNft(nftToken, serialNumber)
+	nftData, err := setupEnv.Clients.MirrorNode.GetNft(context.Background(), nftToken, serialNumber)
 	if err != nil {
 		t.Fatalf("Failed to get mirror node nft. Error [%s]", err)
 	}
@@ -1121,7 +1122,7 @@ func validateSubmittedScheduledTx(setupEnv *setup.Setup, asset string, expectedT
 func validateScheduledMintTx(setupEnv *setup.Setup, account hedera.AccountID, asset string, expectedTransfers []model.Transfer, t *testing.T) (transactionID, scheduleID string) {
 	timeLeft := 180
 	for {
-		response, err := setupEnv.Clients.MirrorNode.GetAccountTokenMintTransactionsAfterTimestamp(account, now.UnixNano())
+		response, err := setupEnv.Clients.MirrorNode.GetAccountTokenMintTransactionsAfterTimestamp(context.Background(), account, now.UnixNano())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1151,7 +1152,7 @@ func validateScheduledMintTx(setupEnv *setup.Setup, account hedera.AccountID, as
 func validateScheduledBurnTx(setupEnv *setup.Setup, account hedera.AccountID, asset string, expectedTransfers []model.Transfer, t *testing.T) (transactionID, scheduleID string) {
 	timeLeft := 180
 	for {
-		response, err := setupEnv.Clients.MirrorNode.GetAccountTokenBurnTransactionsAfterTimestamp(account, now.UnixNano())
+		response, err := setupEnv.Clients.MirrorNode.GetAccountTokenBurnTransactionsAfterTimestamp(context.Background(), account, now.UnixNano())
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1183,7 +1184,7 @@ func validateScheduledNftTransfer(setupEnv *setup.Setup, expectedTransactionID,
 	timeLeft := 180
 
 	for {
-		response, err := setupEnv.Clients.MirrorNode.GetNftTransactions(token, serialNum)
+		response, err := setupEnv.Clients.MirrorNode.GetNftTransactions(context.Background(), token, serialNum)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1193,13 +1194,13 @@ func validateScheduledNftTransfer(setupEnv *setup.Setup, expectedTransactionID,
 				nftTransfer.ReceiverAccountID == receiver.String() &&
 				nftTransfer.SenderAccountID == setupEnv.BridgeAccount.String() {
 
-				scheduledTx, err := setupEnv.Clients.MirrorNode.GetScheduledTransaction(nftTransfer.TransactionID)
+				scheduledTx, err := setupEnv.Clients.MirrorNode.GetScheduledTransaction(context.Background(), nftTransfer.TransactionID)
 				if err != nil {
 					t.Fatalf("Failed to retrieve scheduled transaction [%s]. Error: [%s]", nftTransfer.TransactionID, err)
 				}
 				for _, tx := range scheduledTx.Transactions {
 					if tx.Result == hedera.StatusSuccess.String() {
-						schedule, err := setupEnv.Clients.MirrorNode.GetSchedule(tx.EntityId)
+						schedule, err := setupEnv.Clients.MirrorNode.GetSchedule(context.Background(), tx.EntityId)
 						if err != nil {
 							t.Fatalf("[%s] - Failed to get scheduled entity [%s]. Error: [%s]", expectedTransactionID, scheduleID, err)
 						}
@@ -1227,7 +1228,7 @@ func validateScheduledNftTransfer(setupEnv *setup.Setup, expectedTransactionID,
 func listenForTx(response *model.Response, mirrorNode *mirror_node.Client, expectedTransfers []model.Transfer, asset string, t *testing.T) (string, string) {
 	for _, transaction := range response.Transactions {
 		if transaction.Scheduled == true {
-			scheduleCreateTx, err := mirrorNode.GetTransaction(transaction.TransactionID)
+			scheduleCreateTx, err := mirrorNode.GetTransaction(context.Background(), transaction.TransactionID)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1268,7 +1269,7 @@ func listenForTx(response *model.Response, mirrorNode *mirror_node.Client, expec
 func validateScheduledTx(setupEnv *setup.Setup, account hedera.AccountID, asset string, expectedTransfers []model.Transfer, t *testing.T) (transactionID, scheduleID string) {
 	timeLeft := 180
 	for {
-		response, err := setupEnv.Clients.MirrorNode.GetAccountCreditTransactionsAfterTimestamp(account, now.UnixNano())
+		response, err := setupEnv.Clients.MirrorNode.GetAccountCreditTransactionsAfterTimestamp(context.Background(), account, now.UnixNano())
 		if err != nil {
 			t.Fatal(err)
 		}
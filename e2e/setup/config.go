@@ -187,7 +187,7 @@ func newClients(config Config) (*clients, error) {
 
 	EVM := make(map[uint64]EVMUtils)
 	for chainId, conf := range config.EVM {
-		evmClient := evm.NewClient(conf)
+		evmClient := evm.NewClient(chainId, conf, nil)
 		routerContractAddress := common.HexToAddress(config.Bridge.Networks[chainId].RouterContractAddress)
 		routerInstance, err := router.NewRouter(routerContractAddress, evmClient)
 
@@ -211,14 +211,14 @@ func newClients(config Config) (*clients, error) {
 
 	validatorClient := e2eClients.NewValidatorClient(config.ValidatorUrl)
 
-	mirrorNode := mirror_node.NewClient(config.Hedera.MirrorNode.ApiAddress, config.Hedera.MirrorNode.PollingInterval)
+	mirrorNode := mirror_node.NewClient(config.Hedera.MirrorNode, nil)
 
 	return &clients{
 		Hedera:          hederaClient,
 		EVM:             EVM,
 		ValidatorClient: validatorClient,
 		MirrorNode:      mirrorNode,
-		FeeCalculator:   fee.New(config.FeePercentages),
+		FeeCalculator:   fee.New(config.AssetMappings),
 		Distributor:     distributor.New(config.Hedera.Members),
 	}, nil
 }
@@ -1,6 +1,6 @@
 module github.com/limechain/hedera-eth-bridge-validator
 
-go 1.13
+go 1.16
 
 require (
 	github.com/caarlos0/env/v6 v6.4.0
@@ -9,6 +9,7 @@ require (
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/render v1.0.1
 	github.com/golang/protobuf v1.5.2
+	github.com/google/uuid v1.1.5
 	github.com/hashgraph/hedera-sdk-go/v2 v2.6.0
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.0.0
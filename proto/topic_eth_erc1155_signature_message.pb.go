@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.13.0
+// source: topic_eth_erc1155_signature_message.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TopicEthErc1155SignatureMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChainId uint64   `protobuf:"varint,1,opt,name=sourceChainId,proto3" json:"sourceChainId,omitempty"` // ID of the chain from which the user has initiated the bridge transfer
+	TargetChainId uint64   `protobuf:"varint,2,opt,name=targetChainId,proto3" json:"targetChainId,omitempty"` // ID of the chain to which the user wants to bridge
+	TransferID    string   `protobuf:"bytes,3,opt,name=transferID,proto3" json:"transferID,omitempty"`        // (EVM - transaction hash + index of the event in the block) / (Hedera - transaction ID)
+	Asset         string   `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`                  // The ERC-1155 contract of the bridged tokens
+	TokenIds      []uint64 `protobuf:"varint,5,rep,packed,name=tokenIds,proto3" json:"tokenIds,omitempty"`    // The token IDs of the batch
+	Amounts       []string `protobuf:"bytes,6,rep,name=amounts,proto3" json:"amounts,omitempty"`              // The amounts of the token IDs at the same position
+	Recipient     string   `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`          // ID / Address of the receiver
+	Signature     string   `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`          // The signature of the validator
+}
+
+func (x *TopicEthErc1155SignatureMessage) Reset() {
+	*x = TopicEthErc1155SignatureMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topic_eth_erc1155_signature_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicEthErc1155SignatureMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicEthErc1155SignatureMessage) ProtoMessage() {}
+
+func (x *TopicEthErc1155SignatureMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_topic_eth_erc1155_signature_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicEthErc1155SignatureMessage.ProtoReflect.Descriptor instead.
+func (*TopicEthErc1155SignatureMessage) Descriptor() ([]byte, []int) {
+	return file_topic_eth_erc1155_signature_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetSourceChainId() uint64 {
+	if x != nil {
+		return x.SourceChainId
+	}
+	return 0
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetTargetChainId() uint64 {
+	if x != nil {
+		return x.TargetChainId
+	}
+	return 0
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetTransferID() string {
+	if x != nil {
+		return x.TransferID
+	}
+	return ""
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetTokenIds() []uint64 {
+	if x != nil {
+		return x.TokenIds
+	}
+	return nil
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *TopicEthErc1155SignatureMessage) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+var File_topic_eth_erc1155_signature_message_proto protoreflect.FileDescriptor
+
+var file_topic_eth_erc1155_signature_message_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x63, 0x31,
+	0x31, 0x35, 0x35, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x1f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x74, 0x68, 0x45,
+	0x72, 0x63, 0x31, 0x31, 0x35, 0x35, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6d, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x68, 0x65, 0x64, 0x65, 0x72, 0x61, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_topic_eth_erc1155_signature_message_proto_rawDescOnce sync.Once
+	file_topic_eth_erc1155_signature_message_proto_rawDescData = file_topic_eth_erc1155_signature_message_proto_rawDesc
+)
+
+func file_topic_eth_erc1155_signature_message_proto_rawDescGZIP() []byte {
+	file_topic_eth_erc1155_signature_message_proto_rawDescOnce.Do(func() {
+		file_topic_eth_erc1155_signature_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_topic_eth_erc1155_signature_message_proto_rawDescData)
+	})
+	return file_topic_eth_erc1155_signature_message_proto_rawDescData
+}
+
+var file_topic_eth_erc1155_signature_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_topic_eth_erc1155_signature_message_proto_goTypes = []interface{}{
+	(*TopicEthErc1155SignatureMessage)(nil), // 0: proto.TopicEthErc1155SignatureMessage
+}
+var file_topic_eth_erc1155_signature_message_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_topic_eth_erc1155_signature_message_proto_init() }
+func file_topic_eth_erc1155_signature_message_proto_init() {
+	if File_topic_eth_erc1155_signature_message_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_topic_eth_erc1155_signature_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicEthErc1155SignatureMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_topic_eth_erc1155_signature_message_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_topic_eth_erc1155_signature_message_proto_goTypes,
+		DependencyIndexes: file_topic_eth_erc1155_signature_message_proto_depIdxs,
+		MessageInfos:      file_topic_eth_erc1155_signature_message_proto_msgTypes,
+	}.Build()
+	File_topic_eth_erc1155_signature_message_proto = out.File
+	file_topic_eth_erc1155_signature_message_proto_rawDesc = nil
+	file_topic_eth_erc1155_signature_message_proto_goTypes = nil
+	file_topic_eth_erc1155_signature_message_proto_depIdxs = nil
+}
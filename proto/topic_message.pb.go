@@ -28,6 +28,7 @@ type TopicMessage struct {
 	// Types that are assignable to Message:
 	//	*TopicMessage_FungibleSignatureMessage
 	//	*TopicMessage_NftSignatureMessage
+	//	*TopicMessage_Erc1155SignatureMessage
 	Message isTopicMessage_Message `protobuf_oneof:"message"`
 }
 
@@ -84,6 +85,13 @@ func (x *TopicMessage) GetNftSignatureMessage() *TopicEthNftSignatureMessage {
 	return nil
 }
 
+func (x *TopicMessage) GetErc1155SignatureMessage() *TopicEthErc1155SignatureMessage {
+	if x, ok := x.GetMessage().(*TopicMessage_Erc1155SignatureMessage); ok {
+		return x.Erc1155SignatureMessage
+	}
+	return nil
+}
+
 type isTopicMessage_Message interface {
 	isTopicMessage_Message()
 }
@@ -96,10 +104,17 @@ type TopicMessage_NftSignatureMessage struct {
 	NftSignatureMessage *TopicEthNftSignatureMessage `protobuf:"bytes,2,opt,name=nftSignatureMessage,proto3,oneof"`
 }
 
+type TopicMessage_Erc1155SignatureMessage struct {
+	// Numbers 3 to 7 are skipped, as they are taken by the length-delimited fields of the legacy TopicEthSignatureMessage
+	Erc1155SignatureMessage *TopicEthErc1155SignatureMessage `protobuf:"bytes,8,opt,name=erc1155SignatureMessage,proto3,oneof"`
+}
+
 func (*TopicMessage_FungibleSignatureMessage) isTopicMessage_Message() {}
 
 func (*TopicMessage_NftSignatureMessage) isTopicMessage_Message() {}
 
+func (*TopicMessage_Erc1155SignatureMessage) isTopicMessage_Message() {}
+
 var File_topic_message_proto protoreflect.FileDescriptor
 
 var file_topic_message_proto_rawDesc = []byte{
@@ -109,24 +124,33 @@ var file_topic_message_proto_rawDesc = []byte{
 	0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x25, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x6e, 0x66, 0x74, 0x5f, 0x73,
 	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x18, 0x66, 0x75, 0x6e, 0x67, 0x69,
-	0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x18, 0x66, 0x75,
-	0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x6e, 0x66, 0x74, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x45, 0x74, 0x68, 0x4e, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6e, 0x66, 0x74, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x68, 0x65, 0x64, 0x65, 0x72, 0x61, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x65, 0x74,
+	0x68, 0x5f, 0x65, 0x72, 0x63, 0x31, 0x31, 0x35, 0x35, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x5d, 0x0a, 0x18, 0x66, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x45, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x18, 0x66, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x56, 0x0a, 0x13, 0x6e, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x74, 0x68, 0x4e,
+	0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6e, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x65, 0x72, 0x63,
+	0x31, 0x31, 0x35, 0x35, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x45, 0x74, 0x68, 0x45, 0x72, 0x63, 0x31, 0x31,
+	0x35, 0x35, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x17, 0x65, 0x72, 0x63, 0x31, 0x31, 0x35, 0x35, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x68, 0x65, 0x64, 0x65, 0x72, 0x61, 0x2d, 0x65, 0x74, 0x68, 0x2d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -143,18 +167,20 @@ func file_topic_message_proto_rawDescGZIP() []byte {
 
 var file_topic_message_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_topic_message_proto_goTypes = []interface{}{
-	(*TopicMessage)(nil),                // 0: proto.TopicMessage
-	(*TopicEthSignatureMessage)(nil),    // 1: proto.TopicEthSignatureMessage
-	(*TopicEthNftSignatureMessage)(nil), // 2: proto.TopicEthNftSignatureMessage
+	(*TopicMessage)(nil),                    // 0: proto.TopicMessage
+	(*TopicEthSignatureMessage)(nil),        // 1: proto.TopicEthSignatureMessage
+	(*TopicEthNftSignatureMessage)(nil),     // 2: proto.TopicEthNftSignatureMessage
+	(*TopicEthErc1155SignatureMessage)(nil), // 3: proto.TopicEthErc1155SignatureMessage
 }
 var file_topic_message_proto_depIdxs = []int32{
 	1, // 0: proto.TopicMessage.fungibleSignatureMessage:type_name -> proto.TopicEthSignatureMessage
 	2, // 1: proto.TopicMessage.nftSignatureMessage:type_name -> proto.TopicEthNftSignatureMessage
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: proto.TopicMessage.erc1155SignatureMessage:type_name -> proto.TopicEthErc1155SignatureMessage
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_topic_message_proto_init() }
@@ -164,6 +190,7 @@ func file_topic_message_proto_init() {
 	}
 	file_topic_eth_signature_message_proto_init()
 	file_topic_eth_nft_signature_message_proto_init()
+	file_topic_eth_erc1155_signature_message_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_topic_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopicMessage); i {
@@ -181,6 +208,7 @@ func file_topic_message_proto_init() {
 	file_topic_message_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*TopicMessage_FungibleSignatureMessage)(nil),
 		(*TopicMessage_NftSignatureMessage)(nil),
+		(*TopicMessage_Erc1155SignatureMessage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
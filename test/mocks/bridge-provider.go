@@ -109,6 +109,58 @@ func (m *MockBridgeContract) ParseBurnERC721Log(log types.Log) (*router.RouterBu
 	return args.Get(0).(*router.RouterBurnERC721), args.Get(1).(error)
 }
 
+func (m *MockBridgeContract) ParseLockERC721Log(log types.Log) (*router.RouterLockERC721, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterLockERC721), nil
+	}
+	return args.Get(0).(*router.RouterLockERC721), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) ParseUnlockERC721Log(log types.Log) (*router.RouterUnlockERC721, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterUnlockERC721), nil
+	}
+	return args.Get(0).(*router.RouterUnlockERC721), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) ParseLockERC1155Log(log types.Log) (*router.RouterLockERC1155, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterLockERC1155), nil
+	}
+	return args.Get(0).(*router.RouterLockERC1155), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) ParseUnlockERC1155Log(log types.Log) (*router.RouterUnlockERC1155, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterUnlockERC1155), nil
+	}
+	return args.Get(0).(*router.RouterUnlockERC1155), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) TokenURI(asset string, tokenId *big.Int) (string, error) {
+	args := m.Called(asset, tokenId)
+	if args[1] == nil {
+		return args.String(0), nil
+	}
+	return args.String(0), args.Get(1).(error)
+}
+
 func (m *MockBridgeContract) IsMember(address string) bool {
 	panic("implement me")
 }
@@ -121,6 +173,14 @@ func (m *MockBridgeContract) HasValidSignaturesLength(signaturesLength *big.Int)
 	return args.Get(0).(bool), nil
 }
 
+func (m *MockBridgeContract) IsHashUsed(hash [32]byte) (bool, error) {
+	args := m.Called(hash)
+	if args.Get(1) == nil {
+		return args.Get(0).(bool), nil
+	}
+	return args.Get(0).(bool), args.Get(1).(error)
+}
+
 func (m *MockBridgeContract) WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *router.RouterBurn) (event.Subscription, error) {
 	args := m.Called(opts, sink)
 	if args[0] == nil && args[1] == nil {
@@ -29,3 +29,11 @@ func (m *MockDatabase) GetConnection() *gorm.DB {
 	args := m.Called()
 	return args.Get(0).(*gorm.DB)
 }
+
+func (m *MockDatabase) Close() error {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
@@ -50,8 +50,8 @@ func (m *MockEVMClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery)
 	return args.Get(0).([]types.Log), args.Get(1).(error)
 }
 
-func (m *MockEVMClient) RetryBlockNumber() (uint64, error) {
-	args := m.Called()
+func (m *MockEVMClient) RetryBlockNumber(ctx context.Context) (uint64, error) {
+	args := m.Called(ctx)
 
 	if args.Get(1) == nil {
 		return args.Get(0).(uint64), nil
@@ -60,8 +60,8 @@ func (m *MockEVMClient) RetryBlockNumber() (uint64, error) {
 	return args.Get(0).(uint64), args.Get(1).(error)
 }
 
-func (m *MockEVMClient) RetryFilterLogs(q ethereum.FilterQuery) ([]types.Log, error) {
-	args := m.Called(q)
+func (m *MockEVMClient) RetryFilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	args := m.Called(ctx, q)
 
 	if args.Get(1) == nil {
 		return args.Get(0).([]types.Log), nil
@@ -95,6 +95,24 @@ func (m *MockEVMClient) GetBlockTimestamp(blockNumber *big.Int) uint64 {
 	return args.Get(0).(uint64)
 }
 
+func (m *MockEVMClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	args := m.Called(ctx, number)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(*types.Header), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockEVMClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	args := m.Called(ctx, txHash)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(*types.Receipt), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
 func (m *MockEVMClient) ValidateContractDeployedAt(contractAddress string) (*common.Address, error) {
 	args := m.Called(contractAddress)
 
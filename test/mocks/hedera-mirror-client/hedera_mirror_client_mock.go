@@ -17,6 +17,7 @@
 package hedera_mirror_client
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/stretchr/testify/mock"
@@ -26,36 +27,51 @@ type MockHederaMirrorClient struct {
 	mock.Mock
 }
 
-func (m *MockHederaMirrorClient) GetNft(tokenID string, serialNum int64) (*model.Nft, error) {
+func (m *MockHederaMirrorClient) GetNft(ctx context.Context, tokenID string, serialNum int64) (*model.Nft, error) {
 	panic("implement me")
 }
 
-func (m *MockHederaMirrorClient) GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountTokenMintTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	panic("implement me")
 }
 
-func (m *MockHederaMirrorClient) GetAccountTokenBurnTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountTokenBurnTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	panic("implement me")
 }
 
-func (m *MockHederaMirrorClient) GetAccountDebitTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
-	panic("implement me")
+func (m *MockHederaMirrorClient) GetAccountDebitTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
+	args := m.Called(accountId, from)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(*model.Response), nil
+	}
+	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetAccountCreditTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountCreditTransactionsAfterTimestampString(ctx context.Context, accountId hedera.AccountID, from string) (*model.Response, error) {
 	panic("implement me")
 }
 
-func (m *MockHederaMirrorClient) GetScheduledTransaction(transactionID string) (*model.Response, error) {
-	panic("implement me")
+func (m *MockHederaMirrorClient) GetScheduledTransaction(ctx context.Context, transactionID string) (*model.Response, error) {
+	args := m.Called(transactionID)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(*model.Response), nil
+	}
+	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetSchedule(scheduleID string) (*model.Schedule, error) {
-	panic("implement me")
+func (m *MockHederaMirrorClient) GetSchedule(ctx context.Context, scheduleID string) (*model.Schedule, error) {
+	args := m.Called(scheduleID)
+
+	if args.Get(1) == nil {
+		return args.Get(0).(*model.Schedule), nil
+	}
+	return args.Get(0).(*model.Schedule), args.Get(1).(error)
 }
 
 // GetSuccessfulTransaction gets the success transaction by transaction id or returns an error
-func (m *MockHederaMirrorClient) GetSuccessfulTransaction(transactionID string) (model.Transaction, error) {
+func (m *MockHederaMirrorClient) GetSuccessfulTransaction(ctx context.Context, transactionID string) (model.Transaction, error) {
 	args := m.Called(transactionID)
 
 	if args.Get(1) == nil {
@@ -64,11 +80,11 @@ func (m *MockHederaMirrorClient) GetSuccessfulTransaction(transactionID string)
 	return model.Transaction{}, args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetNftTransactions(tokenID string, serialNum int64) (model.NftTransactionsResponse, error) {
+func (m *MockHederaMirrorClient) GetNftTransactions(ctx context.Context, tokenID string, serialNum int64) (model.NftTransactionsResponse, error) {
 	panic("implement me")
 }
 
-func (m *MockHederaMirrorClient) GetAccountTokenBurnTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountTokenBurnTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error) {
 	args := m.Called(accountId, from)
 
 	if args.Get(1) == nil {
@@ -77,7 +93,7 @@ func (m *MockHederaMirrorClient) GetAccountTokenBurnTransactionsAfterTimestamp(a
 	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetAccountTokenMintTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountTokenMintTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, from int64) (*model.Response, error) {
 	args := m.Called(accountId, from)
 
 	if args.Get(1) == nil {
@@ -86,7 +102,7 @@ func (m *MockHederaMirrorClient) GetAccountTokenMintTransactionsAfterTimestamp(a
 	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]model.Transaction, error) {
+func (m *MockHederaMirrorClient) GetAccountCreditTransactionsBetween(ctx context.Context, accountId hedera.AccountID, from, to int64) ([]model.Transaction, error) {
 	args := m.Called(accountId, from, to)
 
 	if args.Get(1) == nil {
@@ -95,7 +111,7 @@ func (m *MockHederaMirrorClient) GetAccountCreditTransactionsBetween(accountId h
 	return args.Get(0).([]model.Transaction), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]model.Message, error) {
+func (m *MockHederaMirrorClient) GetMessagesForTopicBetween(ctx context.Context, topicId hedera.TopicID, from, to int64) ([]model.Message, error) {
 	args := m.Called(topicId, from, to)
 
 	if args.Get(1) == nil {
@@ -104,7 +120,7 @@ func (m *MockHederaMirrorClient) GetMessagesForTopicBetween(topicId hedera.Topic
 	return args.Get(0).([]model.Message), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetMessagesAfterTimestamp(topicId hedera.TopicID, from int64) ([]model.Message, error) {
+func (m *MockHederaMirrorClient) GetMessagesAfterTimestamp(ctx context.Context, topicId hedera.TopicID, from int64) ([]model.Message, error) {
 	args := m.Called(topicId, from)
 
 	if args.Get(1) == nil {
@@ -113,11 +129,11 @@ func (m *MockHederaMirrorClient) GetMessagesAfterTimestamp(topicId hedera.TopicI
 	return args.Get(0).([]model.Message), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) WaitForTransaction(txId string, onSuccess, onFailure func()) {
+func (m *MockHederaMirrorClient) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
 	m.Called(txId, onSuccess, onFailure)
 }
 
-func (m *MockHederaMirrorClient) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, milestoneTimestamp int64) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetAccountCreditTransactionsAfterTimestamp(ctx context.Context, accountId hedera.AccountID, milestoneTimestamp int64) (*model.Response, error) {
 	args := m.Called(accountId, milestoneTimestamp)
 
 	if args.Get(1) == nil {
@@ -126,7 +142,7 @@ func (m *MockHederaMirrorClient) GetAccountCreditTransactionsAfterTimestamp(acco
 	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetStateProof(transactionID string) ([]byte, error) {
+func (m *MockHederaMirrorClient) GetStateProof(ctx context.Context, transactionID string) ([]byte, error) {
 	args := m.Called(transactionID)
 
 	if args.Get(1) == nil {
@@ -136,12 +152,12 @@ func (m *MockHederaMirrorClient) GetStateProof(transactionID string) ([]byte, er
 
 }
 
-func (m *MockHederaMirrorClient) AccountExists(accountID hedera.AccountID) bool {
+func (m *MockHederaMirrorClient) AccountExists(ctx context.Context, accountID hedera.AccountID) bool {
 	args := m.Called(accountID)
 	return args.Get(0).(bool)
 }
 
-func (m *MockHederaMirrorClient) GetAccount(accountID string) (*model.AccountsResponse, error) {
+func (m *MockHederaMirrorClient) GetAccount(ctx context.Context, accountID string) (*model.AccountsResponse, error) {
 	args := m.Called(accountID)
 
 	if args.Get(1) == nil {
@@ -150,7 +166,7 @@ func (m *MockHederaMirrorClient) GetAccount(accountID string) (*model.AccountsRe
 	return args.Get(0).(*model.AccountsResponse), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) GetToken(tokenID string) (*model.TokenResponse, error) {
+func (m *MockHederaMirrorClient) GetToken(ctx context.Context, tokenID string) (*model.TokenResponse, error) {
 	args := m.Called(tokenID)
 
 	if args.Get(1) == nil {
@@ -159,12 +175,12 @@ func (m *MockHederaMirrorClient) GetToken(tokenID string) (*model.TokenResponse,
 	return args.Get(0).(*model.TokenResponse), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) TopicExists(topicID hedera.TopicID) bool {
+func (m *MockHederaMirrorClient) TopicExists(ctx context.Context, topicID hedera.TopicID) bool {
 	args := m.Called(topicID)
 	return args.Get(0).(bool)
 }
 
-func (m *MockHederaMirrorClient) GetTransaction(transactionID string) (*model.Response, error) {
+func (m *MockHederaMirrorClient) GetTransaction(ctx context.Context, transactionID string) (*model.Response, error) {
 	args := m.Called(transactionID)
 
 	if args.Get(1) == nil {
@@ -173,6 +189,6 @@ func (m *MockHederaMirrorClient) GetTransaction(transactionID string) (*model.Re
 	return args.Get(0).(*model.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirrorClient) WaitForScheduledTransaction(txId string, onSuccess, onFailure func()) {
+func (m *MockHederaMirrorClient) WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
 	m.Called(txId /*, onSuccess, onFailure*/)
 }
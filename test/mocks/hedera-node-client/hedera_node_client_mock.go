@@ -99,3 +99,19 @@ func (m *MockHederaNodeClient) SubmitScheduledTokenBurnTransaction(id hedera.Tok
 	}
 	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
 }
+
+func (m *MockHederaNodeClient) SubmitScheduledNftMintTransaction(tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(tokenID, metadata, payerAccountID, memo)
+	if args.Get(1) == nil {
+		return args.Get(0).(*hedera.TransactionResponse), nil
+	}
+	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
+}
+
+func (m *MockHederaNodeClient) SubmitScheduledNftBurnTransaction(tokenID hedera.TokenID, serialNum int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(tokenID, serialNum, payerAccountID, memo)
+	if args.Get(1) == nil {
+		return args.Get(0).(*hedera.TransactionResponse), nil
+	}
+	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
+}
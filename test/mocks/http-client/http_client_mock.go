@@ -25,8 +25,8 @@ type MockHttpClient struct {
 	mock.Mock
 }
 
-func (m *MockHttpClient) Get(url string) (resp *http.Response, err error) {
-	args := m.Called(url)
+func (m *MockHttpClient) Do(request *http.Request) (resp *http.Response, err error) {
+	args := m.Called(request.URL.String())
 	if args[0] == nil && args[1] == nil {
 		return nil, nil
 	}
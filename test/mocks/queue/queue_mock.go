@@ -17,7 +17,9 @@
 package queue
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -30,6 +32,46 @@ func (m *MockQueue) Channel() chan *queue.Message {
 	return args.Get(0).(chan *queue.Message)
 }
 
-func (m *MockQueue) Push(message *queue.Message) {
+func (m *MockQueue) Push(ctx context.Context, message *queue.Message) error {
+	args := m.Called(message)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockQueue) Commit(ctx context.Context, entityID string, timestampOrBlockNumber int64, messages ...*queue.Message) error {
+	args := m.Called(entityID, timestampOrBlockNumber, messages)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockQueue) Replay(deadLetter *entity.DeadLetter) error {
+	args := m.Called(deadLetter)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockQueue) Resume(message *entity.PausedMessage) error {
+	args := m.Called(message)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
+}
+
+func (m *MockQueue) Ack(message *queue.Message) {
+	m.Called(message)
+}
+
+func (m *MockQueue) Nack(message *queue.Message, err error) {
+	m.Called(message, err)
+}
+
+func (m *MockQueue) Release(message *queue.Message) {
 	m.Called(message)
 }
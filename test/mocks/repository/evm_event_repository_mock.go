@@ -0,0 +1,58 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEvmEventRepository struct {
+	mock.Mock
+}
+
+func (meer *MockEvmEventRepository) Create(event *entity.EvmEvent) error {
+	args := meer.Called(event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (meer *MockEvmEventRepository) GetFromBlock(chainID, blockNumber uint64) ([]*entity.EvmEvent, error) {
+	args := meer.Called(chainID, blockNumber)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.EvmEvent), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (meer *MockEvmEventRepository) MarkOrphaned(transactionHash string, logIndex uint) error {
+	args := meer.Called(transactionHash, logIndex)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (meer *MockEvmEventRepository) GetByTransferID(transferID string) ([]*entity.EvmEvent, error) {
+	args := meer.Called(transferID)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.EvmEvent), nil
+	}
+	return nil, args.Get(1).(error)
+}
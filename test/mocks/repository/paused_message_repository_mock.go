@@ -0,0 +1,50 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPausedMessageRepository struct {
+	mock.Mock
+}
+
+func (mpmr *MockPausedMessageRepository) Create(message *entity.PausedMessage) error {
+	args := mpmr.Called(message)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mpmr *MockPausedMessageRepository) GetAll() ([]*entity.PausedMessage, error) {
+	args := mpmr.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.PausedMessage), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (mpmr *MockPausedMessageRepository) Delete(transferID string) error {
+	args := mpmr.Called(transferID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
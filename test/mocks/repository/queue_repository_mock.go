@@ -0,0 +1,115 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockQueueRepository struct {
+	mock.Mock
+}
+
+func (mqr *MockQueueRepository) Create(messages []*entity.QueueMessage) error {
+	args := mqr.Called(messages)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) CreateWithStatus(entityID string, timestampOrBlockNumber int64, messages []*entity.QueueMessage) error {
+	args := mqr.Called(entityID, timestampOrBlockNumber, messages)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) Lease(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error) {
+	args := mqr.Called(limit, visibilityTimeout)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.QueueMessage), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (mqr *MockQueueRepository) Delete(id uint64) error {
+	args := mqr.Called(id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) Delay(id uint64, delay time.Duration) error {
+	args := mqr.Called(id, delay)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) DeadLetter(id uint64, reason string) error {
+	args := mqr.Called(id, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) Replay(deadLetterID uint64) error {
+	args := mqr.Called(deadLetterID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) Resume(transferID string) error {
+	args := mqr.Called(transferID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) GetAll() ([]*entity.QueueMessage, error) {
+	args := mqr.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.QueueMessage), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (mqr *MockQueueRepository) Release(id uint64) error {
+	args := mqr.Called(id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mqr *MockQueueRepository) ReleaseAll() error {
+	args := mqr.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
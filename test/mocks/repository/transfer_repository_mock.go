@@ -35,7 +35,14 @@ func (m *MockTransferRepository) UpdateStatusFailed(txId string) error {
 }
 
 func (m *MockTransferRepository) GetByTransactionId(txId string) (*entity.Transfer, error) {
-	panic("implement me")
+	args := m.Called(txId)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	if args.Get(0) == nil {
+		return nil, nil
+	}
+	return args.Get(0).(*entity.Transfer), nil
 }
 
 func (m *MockTransferRepository) GetWithFee(txId string) (*entity.Transfer, error) {
@@ -43,7 +50,94 @@ func (m *MockTransferRepository) GetWithFee(txId string) (*entity.Transfer, erro
 }
 
 func (m *MockTransferRepository) GetWithPreloads(txId string) (*entity.Transfer, error) {
-	panic("implement me")
+	args := m.Called(txId)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	if args.Get(0) == nil {
+		return nil, nil
+	}
+	return args.Get(0).(*entity.Transfer), nil
+}
+
+func (m *MockTransferRepository) UpdateStatusRevoked(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) ReinstateRevoked(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusPendingReview(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetUnpaid(nativeChainId uint64, nativeAsset string) ([]*entity.Transfer, error) {
+	args := m.Called(nativeChainId, nativeAsset)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusBlocked(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) ApprovePendingReview(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) RejectPendingReview(txId string) error {
+	args := m.Called(txId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetOutflowSince(nativeChainId uint64, nativeAsset string, since int64) ([]*entity.Transfer, error) {
+	args := m.Called(nativeChainId, nativeAsset, since)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetInitial(since int64) ([]*entity.Transfer, error) {
+	args := m.Called(since)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) GetPage(filter *transfer.Filter, after *transfer.Cursor, limit int) ([]*entity.Transfer, error) {
+	args := m.Called(filter, after, limit)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
 }
 
 func (m *MockTransferRepository) Create(ct *transfer.Transfer) (*entity.Transfer, error) {
@@ -54,6 +148,25 @@ func (m *MockTransferRepository) Create(ct *transfer.Transfer) (*entity.Transfer
 	return nil, args.Get(1).(error)
 }
 
+func (m *MockTransferRepository) UpdateWrappedSerialNumber(txId string, serialNum int64) error {
+	args := m.Called(txId, serialNum)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetByWrappedNft(asset string, serialNum int64) (*entity.Transfer, error) {
+	args := m.Called(asset, serialNum)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	if args.Get(0) == nil {
+		return nil, nil
+	}
+	return args.Get(0).(*entity.Transfer), nil
+}
+
 func (m *MockTransferRepository) UpdateFee(txId, fee string) error {
 	args := m.Called(txId, fee)
 	if args.Get(0) == nil {
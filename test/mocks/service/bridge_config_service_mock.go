@@ -0,0 +1,36 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBridgeConfigService struct {
+	mock.Mock
+}
+
+func (m *MockBridgeConfigService) Active() (parser.Bridge, string) {
+	args := m.Called()
+	return args.Get(0).(parser.Bridge), args.String(1)
+}
+
+func (m *MockBridgeConfigService) Apply(bridge parser.Bridge) error {
+	args := m.Called(bridge)
+	return args.Error(0)
+}
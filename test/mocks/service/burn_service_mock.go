@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
 )
@@ -34,6 +35,10 @@ func (m *MockBurnService) TransactionID(id string) (string, error) {
 	return args[0].(string), args[1].(error)
 }
 
-func (m *MockBurnService) ProcessEvent(event transfer.Transfer) {
-	m.Called(event)
+func (m *MockBurnService) ProcessEvent(ctx context.Context, event transfer.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
@@ -0,0 +1,35 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLimitsService struct {
+	mock.Mock
+}
+
+func (mls *MockLimitsService) Hold(ctx context.Context, tm transfer.Transfer, topic string) (bool, error) {
+	args := mls.Called(tm, topic)
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+	return args.Bool(0), args.Get(1).(error)
+}
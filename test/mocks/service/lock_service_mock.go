@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,6 +26,26 @@ type MockLockService struct {
 	mock.Mock
 }
 
-func (m *MockLockService) ProcessEvent(event transfer.Transfer) {
-	m.Called(event)
+func (m *MockLockService) ProcessEvent(ctx context.Context, event transfer.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockLockService) ProcessNftEvent(ctx context.Context, event transfer.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockLockService) ProcessErc1155Event(ctx context.Context, event transfer.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
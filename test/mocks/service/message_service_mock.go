@@ -42,6 +42,14 @@ func (m *MockMessageService) SignNftMessage(transfer transfer.Transfer) ([]byte,
 	return args[0].([]byte), args[1].(error)
 }
 
+func (m *MockMessageService) SignErc1155Message(transfer transfer.Transfer) ([]byte, error) {
+	args := m.Called(transfer)
+	if args[1] == nil {
+		return args[0].([]byte), nil
+	}
+	return args[0].([]byte), args[1].(error)
+}
+
 // SanityCheckFungibleSignature performs any validation required prior handling the topic message
 // (verifies metadata against the corresponding Transaction record)
 func (m *MockMessageService) SanityCheckFungibleSignature(tm *proto.TopicEthSignatureMessage) (bool, error) {
@@ -62,6 +70,16 @@ func (m *MockMessageService) SanityCheckNftSignature(tm *proto.TopicEthNftSignat
 	return args[0].(bool), args[1].(error)
 }
 
+// SanityCheckErc1155Signature performs any validation required prior handling the topic message
+// (verifies metadata and the ERC-1155 batch against the corresponding Transaction record)
+func (m *MockMessageService) SanityCheckErc1155Signature(tm *proto.TopicEthErc1155SignatureMessage) (bool, error) {
+	args := m.Called(tm)
+	if args[1] == nil {
+		return args[0].(bool), nil
+	}
+	return args[0].(bool), args[1].(error)
+}
+
 // ProcessSignature processes the signature message, verifying and updating all necessary fields in the DB
 func (m *MockMessageService) ProcessSignature(transferID, signature string, targetChainId uint64, timestamp int64, authMsg []byte) error {
 	args := m.Called(transferID, signature, targetChainId, timestamp, authMsg)
@@ -75,6 +75,13 @@ func (mps *MockPrometheusService) DeleteCounter(name string) {
 	_ = mps.Called(name)
 }
 
+// CreateHistogramIfNotExists creates new Histogram Metric and registers it in Prometheus if not exists
+func (mps *MockPrometheusService) CreateHistogramIfNotExists(opts prometheus.HistogramOpts) prometheus.Histogram {
+	args := mps.Called(opts)
+	result := args.Get(0).(prometheus.Histogram)
+	return result
+}
+
 // GetIsMonitoringEnabled returns if the monitoring is enabled
 func (mps *MockPrometheusService) GetIsMonitoringEnabled() bool {
 	args := mps.Called()
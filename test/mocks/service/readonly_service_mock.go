@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -26,14 +27,22 @@ type MockReadOnlyService struct {
 	mock.Mock
 }
 
-func (m *MockReadOnlyService) FindNftTransfer(transferID string, tokenID string, serialNum int64, sender string, receiver string, save func(transactionID string, scheduleID string, status string) error) {
+func (m *MockReadOnlyService) FindNftTransfer(ctx context.Context, transferID string, tokenID string, serialNum int64, sender string, receiver string, save func(transactionID string, scheduleID string, status string) error) error {
 	panic("implement me")
 }
 
-func (m *MockReadOnlyService) FindTransfer(transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, fetch, save)
+func (m *MockReadOnlyService) FindTransfer(ctx context.Context, transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) error {
+	args := m.Called(transferID, fetch, save)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
 }
 
-func (m *MockReadOnlyService) FindAssetTransfer(transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, asset, transfers, fetch, save)
+func (m *MockReadOnlyService) FindAssetTransfer(ctx context.Context, transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error) error {
+	args := m.Called(transferID, asset, transfers, fetch, save)
+	if args[0] == nil {
+		return nil
+	}
+	return args[0].(error)
 }
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -26,18 +27,26 @@ type MockScheduledService struct {
 	mock.Mock
 }
 
-func (mss *MockScheduledService) ExecuteScheduledNftTransferTransaction(id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (mss *MockScheduledService) ExecuteScheduledNftTransferTransaction(ctx context.Context, id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	panic("implement me")
 }
 
-func (mss *MockScheduledService) ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (mss *MockScheduledService) ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	mss.Called(id, asset, amount)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (mss *MockScheduledService) ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	mss.Called(id, asset, amount)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledTransferTransaction(id, nativeAsset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+func (mss *MockScheduledService) ExecuteScheduledNftMintTransaction(ctx context.Context, id, asset string, metadata []byte, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(id, asset, metadata)
+}
+
+func (mss *MockScheduledService) ExecuteScheduledNftBurnTransaction(ctx context.Context, id, asset string, serialNum int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(id, asset, serialNum)
+}
+
+func (mss *MockScheduledService) ExecuteScheduledTransferTransaction(ctx context.Context, id, nativeAsset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
 	mss.Called(id, nativeAsset, transfers)
 }
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransferEventsService struct {
+	mock.Mock
+}
+
+func (m *MockTransferEventsService) Publish(event service.TransferEvent) {
+	m.Called(event)
+}
+
+func (m *MockTransferEventsService) Subscribe(transferID string) (<-chan service.TransferEvent, func()) {
+	args := m.Called(transferID)
+	return args.Get(0).(<-chan service.TransferEvent), args.Get(1).(func())
+}
+
+func (m *MockTransferEventsService) Close() {
+	m.Called()
+}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -52,7 +53,7 @@ func (mts *MockTransferService) UpdateStatusFailed(txId string) error {
 	panic("implement me")
 }
 
-func (mts *MockTransferService) ProcessNativeTransfer(tm transfer.Transfer) error {
+func (mts *MockTransferService) ProcessNativeTransfer(ctx context.Context, tm transfer.Transfer) error {
 	args := mts.Called(tm)
 	if args.Get(0) == nil {
 		return nil
@@ -60,7 +61,7 @@ func (mts *MockTransferService) ProcessNativeTransfer(tm transfer.Transfer) erro
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessNativeNftTransfer(tm transfer.Transfer) error {
+func (mts *MockTransferService) ProcessNativeNftTransfer(ctx context.Context, tm transfer.Transfer) error {
 	args := mts.Called(tm)
 	if args.Get(0) == nil {
 		return nil
@@ -68,7 +69,7 @@ func (mts *MockTransferService) ProcessNativeNftTransfer(tm transfer.Transfer) e
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessWrappedTransfer(tm transfer.Transfer) error {
+func (mts *MockTransferService) ProcessWrappedTransfer(ctx context.Context, tm transfer.Transfer) error {
 	args := mts.Called(tm)
 	if args.Get(0) == nil {
 		return nil
@@ -76,6 +77,30 @@ func (mts *MockTransferService) ProcessWrappedTransfer(tm transfer.Transfer) err
 	return args.Get(0).(error)
 }
 
+func (mts *MockTransferService) ProcessWrappedNftTransfer(ctx context.Context, tm transfer.Transfer) error {
+	args := mts.Called(tm)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mts *MockTransferService) ProcessErc1155Transfer(ctx context.Context, tm transfer.Transfer) error {
+	args := mts.Called(tm)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mts *MockTransferService) LockedNftTokenId(wrappedAsset string, serialNum int64) (int64, error) {
+	args := mts.Called(wrappedAsset, serialNum)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return args.Get(0).(int64), args.Get(1).(error)
+}
+
 func (mts *MockTransferService) SanityCheckTransfer(tx model.Transaction) (uint64, string, error) {
 	args := mts.Called(tx)
 	if args.Get(2) == nil {
@@ -106,3 +131,21 @@ func (mts *MockTransferService) TransferData(txId string) (interface{}, error) {
 
 	return args.Get(0).(service.TransferData), args.Get(0).(error)
 }
+
+func (mts *MockTransferService) TransfersPage(filter transfer.Filter, cursor string, limit int) (*service.TransfersPage, error) {
+	args := mts.Called(filter, cursor, limit)
+	if args.Get(1) == nil {
+		return args.Get(0).(*service.TransfersPage), nil
+	}
+
+	return nil, args.Get(1).(error)
+}
+
+func (mts *MockTransferService) TransferTimeline(txId string) (*service.TransferTimeline, error) {
+	args := mts.Called(txId)
+	if args.Get(1) == nil {
+		return args.Get(0).(*service.TransferTimeline), nil
+	}
+
+	return nil, args.Get(1).(error)
+}
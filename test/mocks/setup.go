@@ -36,12 +36,26 @@ var MScheduledService *service.MockScheduledService
 var MFeeService *service.MockFeeService
 var MBurnService *service.MockBurnService
 var MLockService *service.MockLockService
+var MLimitsService *service.MockLimitsService
+var MPauseService *service.MockPauseService
+var MScreeningService *service.MockScreeningService
+var MTransferEventsService *service.MockTransferEventsService
+var MBridgeConfigService *service.MockBridgeConfigService
 var MBridgeContractService *MockBridgeContract
 var MTransferRepository *repository.MockTransferRepository
 var MMessageRepository *repository.MockMessageRepository
 var MFeeRepository *repository.MockFeeRepository
 var MScheduleRepository *repository.MockScheduleRepository
 var MStatusRepository *repository.MockStatusRepository
+var MQueueRepository *repository.MockQueueRepository
+var MDeadLetterRepository *repository.MockDeadLetterRepository
+var MReviewRepository *repository.MockReviewRepository
+var MPauseRepository *repository.MockPauseRepository
+var MPausedMessageRepository *repository.MockPausedMessageRepository
+var MScreeningHitRepository *repository.MockScreeningHitRepository
+var MSupplySnapshotRepository *repository.MockSupplySnapshotRepository
+var MEvmEventRepository *repository.MockEvmEventRepository
+var MEvmBlockRepository *repository.MockEvmBlockRepository
 var MHederaMirrorClient *hedera_mirror_client.MockHederaMirrorClient
 var MHederaNodeClient *hedera_node_client.MockHederaNodeClient
 var MEVMCoreClient *evm_client.MockEVMCoreClient
@@ -51,6 +65,7 @@ var MEVMClient *evm_client.MockEVMClient
 var MSignerService *service.MockSignerService
 var MDatabase *database.MockDatabase
 var MQueue *queue.MockQueue
+var MPusher *queue.MockPusher
 var MPrometheusService *service.MockPrometheusService
 
 func Setup() {
@@ -62,12 +77,26 @@ func Setup() {
 	MFeeService = &service.MockFeeService{}
 	MSignerService = &service.MockSignerService{}
 	MLockService = &service.MockLockService{}
+	MLimitsService = &service.MockLimitsService{}
+	MPauseService = &service.MockPauseService{}
+	MScreeningService = &service.MockScreeningService{}
 	MBurnService = &service.MockBurnService{}
+	MTransferEventsService = &service.MockTransferEventsService{}
+	MBridgeConfigService = &service.MockBridgeConfigService{}
 	MTransferRepository = &repository.MockTransferRepository{}
 	MFeeRepository = &repository.MockFeeRepository{}
 	MMessageRepository = &repository.MockMessageRepository{}
 	MScheduleRepository = &repository.MockScheduleRepository{}
 	MStatusRepository = &repository.MockStatusRepository{}
+	MQueueRepository = &repository.MockQueueRepository{}
+	MDeadLetterRepository = &repository.MockDeadLetterRepository{}
+	MReviewRepository = &repository.MockReviewRepository{}
+	MPauseRepository = &repository.MockPauseRepository{}
+	MPausedMessageRepository = &repository.MockPausedMessageRepository{}
+	MScreeningHitRepository = &repository.MockScreeningHitRepository{}
+	MSupplySnapshotRepository = &repository.MockSupplySnapshotRepository{}
+	MEvmEventRepository = &repository.MockEvmEventRepository{}
+	MEvmBlockRepository = &repository.MockEvmBlockRepository{}
 	MDistributorService = &service.MockDistrubutorService{}
 	MReadOnlyService = &service.MockReadOnlyService{}
 	MMessageService = &service.MockMessageService{}
@@ -77,5 +106,6 @@ func Setup() {
 	MEVMCoreClient = &evm_client.MockEVMCoreClient{}
 	MHTTPClient = &http_client.MockHttpClient{}
 	MQueue = &queue.MockQueue{}
+	MPusher = &queue.MockPusher{}
 	MPrometheusService = &service.MockPrometheusService{}
 }
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote_signer
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"net/http"
+	"strings"
+)
+
+const (
+	publicKeysPath = "/api/v1/secp256k1/publicKeys"
+	signPath       = "/api/v1/secp256k1/sign/"
+)
+
+// Server is a local stand-in for a remote signing service, holding the given keys in memory.
+// It implements the API expected by the remote signer of the validator, signing the given digests as is.
+type Server struct {
+	keys map[string]*ecdsa.PrivateKey
+}
+
+func NewServer(keys ...*ecdsa.PrivateKey) *Server {
+	s := &Server{keys: make(map[string]*ecdsa.PrivateKey)}
+	for _, key := range keys {
+		s.keys[hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))] = key
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/upcheck":
+		w.Write([]byte("OK"))
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		s.publicKeys(w)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, signPath):
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, signPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) publicKeys(w http.ResponseWriter) {
+	publicKeys := make([]string, 0, len(s.keys))
+	for publicKey := range s.keys {
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(publicKeys)
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request, publicKey string) {
+	key, ok := s.keys[publicKey]
+	if !ok {
+		http.Error(w, "Public Key not found", http.StatusNotFound)
+		return
+	}
+
+	var request struct {
+		Digest string `json:"digest"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	digest, err := hexutil.Decode(request.Digest)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	signature, err := crypto.Sign(digest, key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte(hexutil.Encode(signature)))
+}